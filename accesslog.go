@@ -0,0 +1,137 @@
+package main
+
+import (
+	"bufio"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"net"
+	"net/http"
+	"os"
+	"regexp"
+	"time"
+
+	"github.com/gorilla/context"
+	"github.com/sirupsen/logrus"
+)
+
+// One JSON line per HTTP request and per closed tunnel
+var accessLog = &logrus.Logger{
+	Out:       os.Stdout,
+	Formatter: &logrus.JSONFormatter{},
+	Hooks:     make(logrus.LevelHooks),
+	Level:     logrus.InfoLevel,
+}
+
+type contextKey int
+
+const requestIDKey contextKey = 0
+
+// Probes which would drown the access log
+var quietPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+// Request IDs set by a load balancer are kept if they look sane
+var validRequestID = regexp.MustCompile("^[A-Za-z0-9._-]{1,64}$")
+
+func newRequestID() string {
+	b := make([]byte, 8)
+	rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+// Returns the correlation ID of the request
+func requestID(r *http.Request) string {
+	id, _ := context.Get(r, requestIDKey).(string)
+	return id
+}
+
+// Returns a logger carrying the correlation ID of the request
+func requestLogger(r *http.Request) *logrus.Entry {
+	return log.WithField("request_id", requestID(r))
+}
+
+// Records the status and size of a response. Keeps Hijack working for the
+// websocket upgrade.
+type accessLogWriter struct {
+	http.ResponseWriter
+	status int
+	bytes  int64
+}
+
+func (w *accessLogWriter) WriteHeader(status int) {
+	w.status = status
+	w.ResponseWriter.WriteHeader(status)
+}
+
+func (w *accessLogWriter) Write(b []byte) (int, error) {
+	if w.status == 0 {
+		w.status = http.StatusOK
+	}
+	n, err := w.ResponseWriter.Write(b)
+	w.bytes += int64(n)
+	return n, err
+}
+
+func (w *accessLogWriter) Hijack() (net.Conn, *bufio.ReadWriter, error) {
+	hijacker, ok := w.ResponseWriter.(http.Hijacker)
+	if !ok {
+		return nil, nil, errors.New("response writer does not support hijacking")
+	}
+	if w.status == 0 {
+		w.status = http.StatusSwitchingProtocols
+	}
+	return hijacker.Hijack()
+}
+
+func (w *accessLogWriter) Flush() {
+	if flusher, ok := w.ResponseWriter.(http.Flusher); ok {
+		flusher.Flush()
+	}
+}
+
+// Assigns every request a correlation ID, returned in X-Request-Id, and
+// writes an access log line once the request is done. For websockets that
+// is when the tunnel closes.
+func withAccessLog(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id := r.Header.Get("X-Request-Id")
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+		context.Set(r, requestIDKey, id)
+		w.Header().Set("X-Request-Id", id)
+
+		if quietPaths[r.URL.Path] {
+			handler.ServeHTTP(w, r)
+			return
+		}
+
+		start := time.Now()
+		writer := &accessLogWriter{ResponseWriter: w}
+		handler.ServeHTTP(writer, r)
+
+		if writer.status == 0 {
+			writer.status = http.StatusOK
+		}
+
+		accessLog.WithFields(logrus.Fields{
+			"type":        "http",
+			"request_id":  id,
+			"remote_addr": r.RemoteAddr,
+			"method":      r.Method,
+			"path":        r.URL.Path,
+			"status":      writer.status,
+			"bytes":       writer.bytes,
+			"duration_ms": time.Since(start).Nanoseconds() / int64(time.Millisecond),
+			"user_agent":  r.UserAgent(),
+		}).Info("request")
+	})
+}
+
+func init() {
+	accessLog.Hooks.Add(redactHook{})
+}
@@ -0,0 +1,47 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAccessLog(t *testing.T) {
+	var out bytes.Buffer
+	defer accessLog.SetOutput(accessLog.Out)
+	accessLog.SetOutput(&out)
+
+	var id string
+	handler := withAccessLog(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		id = requestID(r)
+		http.Error(w, "nope", http.StatusNotFound)
+	}))
+
+	r := httptest.NewRequest("GET", "/console?token=secret", nil)
+	r.Header.Set("X-Request-Id", "lb-1234")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if id != "lb-1234" {
+		t.Error("Expected the request ID from the load balancer Got:", id)
+	}
+
+	var line map[string]interface{}
+	if err := json.Unmarshal(out.Bytes(), &line); err != nil {
+		t.Fatal("Expected a JSON access log line Got:", out.String())
+	}
+	if line["request_id"] != "lb-1234" || line["status"] != float64(404) || line["path"] != "/console" {
+		t.Error("Unexpected access log line:", line)
+	}
+	if w.Header().Get("X-Request-Id") != "lb-1234" {
+		t.Error("Expected the request ID in the response")
+	}
+
+	out.Reset()
+	handler.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest("GET", "/healthz", nil))
+	if out.Len() != 0 {
+		t.Error("Health checks should not be logged Got:", out.String())
+	}
+}
@@ -0,0 +1,252 @@
+package main
+
+import (
+	"crypto/subtle"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"github.com/sirupsen/logrus"
+)
+
+type AdminSession struct {
+	ID            string     `json:"id"`
+	VmUuid        string     `json:"vmUuid"`
+	XenHost       string     `json:"xenHost"`
+	ClientTag     string     `json:"clientTag"`
+	Protocol      string     `json:"protocol"`
+	ViewOnly      bool       `json:"viewOnly"`
+	Connected     bool       `json:"connected"`
+	ClientAddress string     `json:"clientAddress,omitempty"`
+	StartTime     *time.Time `json:"startTime,omitempty"`
+	BytesToClient int64      `json:"bytesToClient"`
+	BytesToServer int64      `json:"bytesToServer"`
+
+	CompressionRatio float64 `json:"compressionRatio,omitempty"`
+}
+
+type terminateResult struct {
+	Terminated []string `json:"terminated"`
+}
+
+// Routes of the admin listener. The probes and /metrics are served without
+// a token so load balancers and Prometheus can use them:
+//
+//	GET    /healthz               the process is up
+//	GET    /readyz                the proxy can take new consoles
+//	GET    /metrics               Prometheus metrics
+//
+// the admin API needs the token:
+//
+//	GET    /sessions              list sessions, filtered by ?vm= and ?host=
+//	DELETE /sessions/<id>         terminate one session
+//	DELETE /sessions?vm=&host=    terminate the sessions of a VM and/or xenserver
+//	POST   /sessions/<id>/keys    type key combos and text into the console of a session
+//	GET    /log                   current log configuration
+//	PUT    /log                   change fields of the log configuration
+//	POST   /screenshot            PNG of the console of the tunnel in the body
+func newAdminHandler(token string) http.Handler {
+	api := http.NewServeMux()
+	api.HandleFunc("/sessions", handleAdminSessions)
+	api.HandleFunc("/sessions/", handleAdminSession)
+	api.HandleFunc("/log", handleAdminLog)
+	api.HandleFunc("/screenshot", handleAdminScreenshot)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", handleHealthz)
+	mux.HandleFunc("/readyz", handleReadyz)
+	mux.Handle("/metrics", promhttp.Handler())
+	if token != "" {
+		mux.Handle("/", requireAdminToken(token, api))
+	}
+	return mux
+}
+
+// Every admin request needs "Authorization: Bearer <token>"
+func requireAdminToken(token string, handler http.Handler) http.Handler {
+	expected := []byte("Bearer " + token)
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		given := []byte(r.Header.Get("Authorization"))
+		if subtle.ConstantTimeCompare(given, expected) != 1 {
+
+			log.WithFields(logrus.Fields{
+				"remotehost": r.RemoteAddr,
+				"url":        r.URL.Path,
+			}).Warn("Unauthorized admin request")
+
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			http.Error(w, "Unauthorized", http.StatusUnauthorized)
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+func handleAdminSessions(w http.ResponseWriter, r *http.Request) {
+	vm := r.URL.Query().Get("vm")
+	host := r.URL.Query().Get("host")
+
+	switch r.Method {
+	case "GET":
+		writeJson(w, http.StatusOK, listAdminSessions(vm, host))
+
+	case "DELETE":
+		if vm == "" && host == "" {
+			http.Error(w, "vm or host is required", http.StatusBadRequest)
+			return
+		}
+
+		var ids []string
+		for _, session := range listAdminSessions(vm, host) {
+			ids = append(ids, session.ID)
+		}
+		writeJson(w, http.StatusOK, terminateResult{Terminated: terminateSessions(ids)})
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func handleAdminSession(w http.ResponseWriter, r *http.Request) {
+	id := strings.TrimPrefix(r.URL.Path, "/sessions/")
+	if strings.HasSuffix(id, "/keys") {
+		handleAdminSessionKeys(w, r, strings.TrimSuffix(id, "/keys"))
+		return
+	}
+
+	if r.Method != "DELETE" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	terminated := terminateSessions([]string{id})
+	if len(terminated) == 0 {
+		http.Error(w, "Unable to find session", http.StatusNotFound)
+		return
+	}
+
+	writeJson(w, http.StatusOK, terminateResult{Terminated: terminated})
+}
+
+// Fields missing from a PUT keep their current value. The change is not
+// written back to the config file.
+func handleAdminLog(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "GET":
+		writeJson(w, http.StatusOK, getLogConfig())
+
+	case "PUT":
+		config := getLogConfig()
+		if err := json.NewDecoder(r.Body).Decode(&config); err != nil {
+			http.Error(w, "Invalid log configuration: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if err := applyLogConfig(config); err != nil {
+			http.Error(w, "Invalid log configuration: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		log.WithFields(logrus.Fields{
+			"level":  config.Level,
+			"format": config.Format,
+			"output": config.Output,
+		}).Info("Log configuration changed by an administrator")
+
+		writeJson(w, http.StatusOK, config)
+
+	default:
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+// Lists sessions sorted by ID, empty filters match everything
+func listAdminSessions(vm, host string) []AdminSession {
+	sessions := []AdminSession{}
+
+	for id, session := range SessionMap.List() {
+		info := AdminSession{
+			ID:        id,
+			VmUuid:    session.ConsoleUuid(),
+			XenHost:   session.XenHost(),
+			ClientTag: session.ClientTag,
+			Protocol:  session.Protocol,
+			ViewOnly:  session.ViewOnly,
+		}
+
+		if info.Protocol == "" {
+			info.Protocol = protocolRfb
+		}
+
+		if (vm != "" && info.VmUuid != vm) || (host != "" && info.XenHost != host) {
+			continue
+		}
+
+		if proxy := SessionMap.Proxy(id); proxy != nil {
+			startTime := proxy.startTime
+			info.Connected = true
+			info.ClientAddress = proxy.clientAddr
+			info.StartTime = &startTime
+			info.BytesToClient, info.BytesToServer = proxy.BytesTransferred()
+			info.CompressionRatio = proxy.CompressionRatio()
+		}
+
+		sessions = append(sessions, info)
+	}
+
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].ID < sessions[j].ID
+	})
+	return sessions
+}
+
+// Closes the sessions and forgets them so the browser cannot reconnect.
+// Returns the IDs which existed.
+func terminateSessions(ids []string) []string {
+	terminated := []string{}
+
+	for _, id := range ids {
+		if SessionMap.Get(id) == nil {
+			continue
+		}
+
+		if proxy := SessionMap.Proxy(id); proxy != nil {
+			proxy.Stop(causeTerminated)
+		}
+		SessionMap.Delete(id)
+
+		log.WithFields(logrus.Fields{
+			"session_id": id,
+		}).Info("Session terminated by an administrator")
+
+		terminated = append(terminated, id)
+	}
+
+	return terminated
+}
+
+func writeJson(w http.ResponseWriter, code int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(code)
+	json.NewEncoder(w).Encode(body)
+}
+
+func serveAdmin() {
+	if cfg.Admin.Token == "" {
+		log.Warn("No admin token configured, the admin API is disabled")
+	}
+
+	log.WithFields(logrus.Fields{
+		"addr": cfg.Admin.Listen,
+	}).Info("Admin listener listening")
+
+	err := http.ListenAndServe(cfg.Admin.Listen, newAdminHandler(cfg.Admin.Token))
+	log.WithFields(logrus.Fields{
+		"error": err,
+	}).Error("Admin API stopped")
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func adminRequest(handler http.Handler, method, url, token string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest(method, url, nil)
+	if token != "" {
+		r.Header.Set("Authorization", "Bearer "+token)
+	}
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	return w
+}
+
+func TestAdminRequiresToken(t *testing.T) {
+	handler := newAdminHandler("secret")
+
+	for _, token := range []string{"", "wrong"} {
+		if w := adminRequest(handler, "GET", "/sessions", token); w.Code != http.StatusUnauthorized {
+			t.Errorf("Token %q Expected: 401 Got: %d", token, w.Code)
+		}
+	}
+}
+
+func TestAdminProbesAndMetrics(t *testing.T) {
+	//used without the token, also when the admin API is disabled
+	for _, token := range []string{"secret", ""} {
+		handler := newAdminHandler(token)
+		for _, url := range []string{"/healthz", "/metrics"} {
+			if w := adminRequest(handler, "GET", url, ""); w.Code != http.StatusOK {
+				t.Errorf("%s token %q Expected: 200 Got: %d", url, token, w.Code)
+			}
+		}
+		if w := adminRequest(handler, "GET", "/readyz", ""); w.Code == http.StatusUnauthorized || w.Code == http.StatusNotFound {
+			t.Errorf("/readyz token %q Expected a readiness answer Got: %d", token, w.Code)
+		}
+	}
+
+	if w := adminRequest(newAdminHandler(""), "GET", "/sessions", ""); w.Code != http.StatusNotFound {
+		t.Error("Expected the admin API to be disabled Got:", w.Code)
+	}
+}
+
+func TestAdminListAndTerminate(t *testing.T) {
+	handler := newAdminHandler("secret")
+
+	SessionMap.Put("admin-a", &ConsoleSession{
+		ClientTunnelUrl: "https://172.31.0.46/console?uuid=9389b857-7a15-a4eb-63dc-50e09b262838",
+	})
+	SessionMap.Put("admin-b", &ConsoleSession{
+		ClientTunnelUrl: "https://172.31.0.47/console?uuid=11111111-7a15-a4eb-63dc-50e09b262838",
+		ViewOnly:        true,
+	})
+	defer SessionMap.Delete("admin-a")
+	defer SessionMap.Delete("admin-b")
+
+	browser, _, proxies := newTestProxy(t, "admin-a")
+	proxy := <-proxies
+
+	w := adminRequest(handler, "GET", "/sessions?host=172.31.0.46", "secret")
+	var sessions []AdminSession
+	json.NewDecoder(w.Body).Decode(&sessions)
+
+	if len(sessions) != 1 || sessions[0].ID != "admin-a" || !sessions[0].Connected ||
+		sessions[0].VmUuid != "9389b857-7a15-a4eb-63dc-50e09b262838" {
+		t.Fatalf("Expected the connected session on 172.31.0.46 Got: %+v", sessions)
+	}
+
+	if w := adminRequest(handler, "DELETE", "/sessions", "secret"); w.Code != http.StatusBadRequest {
+		t.Error("Expected terminating without a filter to fail Got:", w.Code)
+	}
+
+	w = adminRequest(handler, "DELETE", "/sessions?vm=9389b857-7a15-a4eb-63dc-50e09b262838", "secret")
+	if w.Code != http.StatusOK {
+		t.Fatal("Expected 200 Got:", w.Code)
+	}
+
+	browser.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err := browser.ReadMessage()
+	if !websocket.IsCloseError(err, closeTerminated) {
+		t.Error("Expected the browser to be told Got:", err)
+	}
+	<-proxy.Done()
+
+	if SessionMap.Get("admin-a") != nil || SessionMap.Get("admin-b") == nil {
+		t.Error("Expected only admin-a to be terminated")
+	}
+
+	if w := adminRequest(handler, "DELETE", "/sessions/admin-a", "secret"); w.Code != http.StatusNotFound {
+		t.Error("Expected 404 for a terminated session Got:", w.Code)
+	}
+}
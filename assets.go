@@ -0,0 +1,135 @@
+package main
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"io/fs"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+//go:embed static
+var embeddedStatic embed.FS
+
+// The browser UI: noVNC, the terminal and their pages. Served from the
+// binary unless [static] dir points at a directory to work on the UI
+// without rebuilding.
+var assets fs.FS
+
+// The templates directory next to [static] dir, empty to use the
+// templates built into the binary
+var templatesDir string
+
+// ETags of embedded assets, which never change while the proxy runs
+var assetTags sync.Map
+
+var assetsFromDisk bool
+
+func init() {
+	assets, _ = fs.Sub(embeddedStatic, "static")
+}
+
+// Serves the UI from dir instead of the binary, an empty dir keeps the
+// embedded files
+func initAssets(dir string) error {
+	if dir == "" {
+		return nil
+	}
+
+	if info, err := os.Stat(dir); err != nil {
+		return err
+	} else if !info.IsDir() {
+		return &fs.PathError{Op: "open", Path: dir, Err: fs.ErrInvalid}
+	}
+
+	assets = os.DirFS(dir)
+	assetsFromDisk = true
+
+	//the pages are edited along with the files they load
+	templates := filepath.Join(filepath.Dir(filepath.Clean(dir)), "templates")
+	if info, err := os.Stat(templates); err == nil && info.IsDir() {
+		templatesDir = templates
+	}
+
+	log.WithFields(logrus.Fields{
+		"dir":       dir,
+		"templates": templatesDir,
+	}).Warn("Serving the UI from disk")
+
+	return nil
+}
+
+func assetTag(name string, data []byte) string {
+	if !assetsFromDisk {
+		if tag, ok := assetTags.Load(name); ok {
+			return tag.(string)
+		}
+	}
+
+	sum := sha256.Sum256(data)
+	tag := `"` + hex.EncodeToString(sum[:16]) + `"`
+
+	if !assetsFromDisk {
+		assetTags.Store(name, tag)
+	}
+	return tag
+}
+
+// Serves one file of the UI. Browsers revalidate everything with the ETag,
+// the files are not versioned and change when the proxy is upgraded.
+func serveAsset(w http.ResponseWriter, r *http.Request, name string) {
+	name = path.Clean(strings.TrimPrefix(name, "/"))
+	if !fs.ValidPath(name) {
+		http.NotFound(w, r)
+		return
+	}
+
+	f, err := assets.Open(name)
+	if err != nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil || info.IsDir() {
+		http.NotFound(w, r)
+		return
+	}
+
+	data, err := ioutil.ReadAll(f)
+	if err != nil {
+		http.Error(w, "Unable to read "+name, http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("ETag", assetTag(name, data))
+	if assetsFromDisk {
+		w.Header().Set("Cache-Control", "no-store")
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+
+	//embedded files have no modification time
+	var modtime time.Time
+	if assetsFromDisk {
+		modtime = info.ModTime()
+	}
+
+	http.ServeContent(w, r, name, modtime, bytes.NewReader(data))
+}
+
+// Files below /static/, directories are not listed
+func handleStatic(w http.ResponseWriter, r *http.Request) {
+	serveAsset(w, r, strings.TrimPrefix(r.URL.Path, "/static/"))
+}
@@ -0,0 +1,104 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func getAsset(url, etag string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("GET", url, nil)
+	if etag != "" {
+		r.Header.Set("If-None-Match", etag)
+	}
+	w := httptest.NewRecorder()
+	handleStatic(w, r)
+	return w
+}
+
+func TestEmbeddedAssets(t *testing.T) {
+	w := getAsset("/static/vnc.html", "")
+	if w.Code != http.StatusOK || !strings.Contains(w.Body.String(), "<html") {
+		t.Fatal("Expected the noVNC page Got:", w.Code)
+	}
+	if !strings.HasPrefix(w.Header().Get("Content-Type"), "text/html") {
+		t.Error("Expected text/html Got:", w.Header().Get("Content-Type"))
+	}
+	if w.Header().Get("Cache-Control") != "no-cache" {
+		t.Error("Expected no-cache Got:", w.Header().Get("Cache-Control"))
+	}
+
+	etag := w.Header().Get("ETag")
+	if etag == "" {
+		t.Fatal("Expected an ETag")
+	}
+	if w := getAsset("/static/vnc.html", etag); w.Code != http.StatusNotModified {
+		t.Error("Expected 304 for a known ETag Got:", w.Code)
+	}
+
+	for _, url := range []string{"/static/", "/static/include", "/static/../main.go", "/static/missing.js"} {
+		if w := getAsset(url, ""); w.Code != http.StatusNotFound {
+			t.Errorf("%s Expected: 404 Got: %d", url, w.Code)
+		}
+	}
+}
+
+func TestAssetsFromDisk(t *testing.T) {
+	embedded, fromDisk, templates := assets, assetsFromDisk, templatesDir
+	defer func() { assets, assetsFromDisk, templatesDir = embedded, fromDisk, templates }()
+
+	dir := t.TempDir()
+	ioutil.WriteFile(filepath.Join(dir, "vnc.html"), []byte("<html>work in progress</html>"), 0644)
+
+	if err := initAssets(dir); err != nil {
+		t.Fatal(err)
+	}
+
+	w := getAsset("/static/vnc.html", "")
+	if w.Code != http.StatusOK || w.Body.String() != "<html>work in progress</html>" {
+		t.Fatal("Expected the page from disk Got:", w.Code, w.Body.String())
+	}
+	if w.Header().Get("Cache-Control") != "no-store" {
+		t.Error("Expected no-store Got:", w.Header().Get("Cache-Control"))
+	}
+
+	if err := initAssets(filepath.Join(dir, "vnc.html")); err == nil {
+		t.Error("Expected a file to be refused as the UI directory")
+	}
+	if err := initAssets(filepath.Join(dir, "missing")); !os.IsNotExist(err) {
+		t.Error("Expected a missing directory to be refused Got:", err)
+	}
+}
+
+func TestTemplatesFromDisk(t *testing.T) {
+	embedded, fromDisk, templates := assets, assetsFromDisk, templatesDir
+	defer func() { assets, assetsFromDisk, templatesDir = embedded, fromDisk, templates }()
+
+	dir := t.TempDir()
+	os.Mkdir(filepath.Join(dir, "static"), 0755)
+	os.Mkdir(filepath.Join(dir, "templates"), 0755)
+	page := filepath.Join(dir, "templates", "error.html")
+	ioutil.WriteFile(page, []byte("<h1>{{.Title}}</h1>"), 0644)
+
+	if err := initAssets(filepath.Join(dir, "static")); err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	writeErrorPage(w, httptest.NewRequest("GET", "/console", nil), errUnknownSession)
+	if w.Body.String() != "<h1>The console session was not found</h1>" {
+		t.Error("Expected the template from disk Got:", w.Body.String())
+	}
+
+	//edits show on the next page
+	ioutil.WriteFile(page, []byte("<p>{{.Title}}</p>"), 0644)
+	w = httptest.NewRecorder()
+	writeErrorPage(w, httptest.NewRequest("GET", "/console", nil), errUnknownSession)
+	if w.Body.String() != "<p>The console session was not found</p>" {
+		t.Error("Expected the edited template Got:", w.Body.String())
+	}
+}
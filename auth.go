@@ -0,0 +1,131 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Decides if a console session may stay open. Authorize returns an error
+// when the answer is unknown (e.g. the management server is unreachable),
+// such sessions are kept open until the next check.
+type Authorizer interface {
+	Authorize(session *ConsoleSession) (bool, error)
+}
+
+// Used when no management server endpoint is configured
+type nopAuthorizer struct{}
+
+func (nopAuthorizer) Authorize(session *ConsoleSession) (bool, error) {
+	return true, nil
+}
+
+// Asks the management server if the ticket of a session is still valid.
+// The endpoint gets the host, port, tag and ticket of the session as a
+// form POST, which keeps the ticket out of access logs, and answers 200
+// with {"authorized": bool}, 401 and 403 are treated as revoked.
+type HttpAuthorizer struct {
+	endpoint string
+	client   *http.Client
+}
+
+type authResponse struct {
+	Authorized bool   `json:"authorized"`
+	Reason     string `json:"reason"`
+}
+
+func NewHttpAuthorizer(endpoint string, timeout time.Duration) *HttpAuthorizer {
+	return &HttpAuthorizer{
+		endpoint: endpoint,
+		client:   &http.Client{Timeout: timeout},
+	}
+}
+
+func (a *HttpAuthorizer) Authorize(session *ConsoleSession) (bool, error) {
+	form := url.Values{}
+	form.Set("host", session.ClientHostAddress)
+	form.Set("port", strconv.Itoa(session.ClientHostPort))
+	form.Set("tag", session.ClientTag)
+	form.Set("ticket", session.Ticket)
+
+	resp, err := a.client.PostForm(a.endpoint, form)
+	if err != nil {
+		return false, err
+	}
+	defer resp.Body.Close()
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return false, nil
+	default:
+		return false, fmt.Errorf("unexpected response from management server: %s", resp.Status)
+	}
+
+	var result authResponse
+	err = json.NewDecoder(resp.Body).Decode(&result)
+	if err != nil {
+		return false, err
+	}
+
+	if !result.Authorized {
+		log.WithFields(logrus.Fields{
+			"tag":    session.ClientTag,
+			"reason": result.Reason,
+		}).Info("Management server revoked console access")
+	}
+
+	return result.Authorized, nil
+}
+
+func newAuthorizer() Authorizer {
+	if cfg.Auth.Url == "" {
+		return nopAuthorizer{}
+	}
+	return NewHttpAuthorizer(cfg.Auth.Url, cfg.Auth.GetTimeout())
+}
+
+// Re-checks every session on each tick and tears down the ones which are
+// no longer authorized
+func reauthorizeSessions(authorizer Authorizer, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for range ticker.C {
+		reauthorize(authorizer)
+	}
+}
+
+func reauthorize(authorizer Authorizer) {
+	for sessionID, session := range SessionMap.List() {
+		authorized, err := authorizer.Authorize(session)
+		if err != nil {
+
+			log.WithFields(logrus.Fields{
+				"session_id": sessionID,
+				"error":      err,
+			}).Warn("Unable to re-authorize session")
+
+			continue
+		}
+
+		if authorized {
+			continue
+		}
+
+		log.WithFields(logrus.Fields{
+			"session_id": sessionID,
+			"tag":        session.ClientTag,
+		}).Info("Session is no longer authorized")
+
+		if proxy := SessionMap.Proxy(sessionID); proxy != nil {
+			proxy.Stop(causeRevoked)
+		}
+		SessionMap.Delete(sessionID)
+	}
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// Stub management server which only accepts the ticket "valid"
+func newStubManagementServer() *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		//the ticket must not end up in access logs
+		if r.Method != "POST" || r.URL.Query().Get("ticket") != "" {
+			http.Error(w, "ticket in the URL", http.StatusBadRequest)
+			return
+		}
+
+		switch r.PostFormValue("ticket") {
+		case "valid":
+			json.NewEncoder(w).Encode(authResponse{Authorized: true})
+		case "forbidden":
+			http.Error(w, "forbidden", http.StatusForbidden)
+		case "broken":
+			http.Error(w, "broken", http.StatusInternalServerError)
+		default:
+			json.NewEncoder(w).Encode(authResponse{Authorized: false, Reason: "expired"})
+		}
+	}))
+}
+
+func TestHttpAuthorizer(t *testing.T) {
+	server := newStubManagementServer()
+	defer server.Close()
+
+	authorizer := NewHttpAuthorizer(server.URL, time.Second)
+
+	tests := []struct {
+		ticket     string
+		authorized bool
+		err        bool
+	}{
+		{"valid", true, false},
+		{"revoked", false, false},
+		{"forbidden", false, false},
+		{"broken", false, true},
+	}
+
+	for _, test := range tests {
+		authorized, err := authorizer.Authorize(&ConsoleSession{Ticket: test.ticket})
+		if authorized != test.authorized || (err != nil) != test.err {
+			t.Errorf("Ticket %s Expected: %v, %v Got: %v, %v", test.ticket, test.authorized, test.err, authorized, err)
+		}
+	}
+}
+
+func TestReauthorizeRemovesRevokedSessions(t *testing.T) {
+	server := newStubManagementServer()
+	defer server.Close()
+
+	SessionMap.Put("valid", &ConsoleSession{Ticket: "valid"})
+	SessionMap.Put("revoked", &ConsoleSession{Ticket: "revoked"})
+	SessionMap.Put("broken", &ConsoleSession{Ticket: "broken"})
+	defer SessionMap.Delete("valid")
+	defer SessionMap.Delete("broken")
+
+	reauthorize(NewHttpAuthorizer(server.URL, time.Second))
+
+	if SessionMap.Get("valid") == nil {
+		t.Error("Valid session was removed")
+	}
+	if SessionMap.Get("broken") == nil {
+		t.Error("Session was removed although the management server failed")
+	}
+	if SessionMap.Get("revoked") != nil {
+		t.Error("Revoked session was not removed")
+	}
+}
+
+func TestConfigRejectsAuthInterval(t *testing.T) {
+	c := Config{Auth: configAuth{Url: "http://172.16.21.148:8080/client/consoleauth"}}
+	if err := c.check(); err == nil {
+		t.Error("Expected an interval of 0 to be refused")
+	}
+
+	c.Auth.Interval = 300
+	if err := c.check(); err != nil {
+		t.Error("Expected a positive interval to be accepted Got:", err)
+	}
+}
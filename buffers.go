@@ -0,0 +1,57 @@
+package main
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// Read buffers of the copy loops towards the browsers are reused across
+// sessions instead of being allocated per console
+var readBuffers sync.Pool
+
+// Write buffers of the browser websockets, handed to the upgrader
+var websocketWriteBuffers sync.Pool
+
+// Returns a buffer of size bytes from the pool
+func getBuffer(size int) *[]byte {
+	if buffer, ok := readBuffers.Get().(*[]byte); ok && cap(*buffer) >= size {
+		*buffer = (*buffer)[:size]
+		return buffer
+	}
+
+	buffer := make([]byte, size)
+	return &buffer
+}
+
+func putBuffer(buffer *[]byte) {
+	readBuffers.Put(buffer)
+}
+
+// Reads what xenserver sent and keeps filling the buffer until it is full
+// or nothing more arrived within delay, so a framebuffer update split over
+// many TLS records goes out as one websocket message. Bytes read before an
+// error are returned along with it.
+func readCoalesced(conn net.Conn, buffer []byte, delay time.Duration) (int, error) {
+	n, err := conn.Read(buffer)
+	if err != nil || delay <= 0 {
+		return n, err
+	}
+
+	conn.SetReadDeadline(time.Now().Add(delay))
+	defer conn.SetReadDeadline(time.Time{})
+
+	for n < len(buffer) {
+		m, err := conn.Read(buffer[n:])
+		n += m
+
+		if netErr, ok := err.(net.Error); ok && netErr.Timeout() {
+			return n, nil
+		}
+		if err != nil {
+			return n, err
+		}
+	}
+
+	return n, nil
+}
@@ -0,0 +1,99 @@
+package main
+
+import (
+	"io"
+	"io/ioutil"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestReadCoalesced(t *testing.T) {
+	conn, backend := net.Pipe()
+	defer conn.Close()
+	defer backend.Close()
+
+	go func() {
+		for _, chunk := range []string{"RFB ", "003.", "008\n"} {
+			backend.Write([]byte(chunk))
+		}
+	}()
+
+	buffer := make([]byte, 64)
+	n, err := readCoalesced(conn, buffer, 50*time.Millisecond)
+	if err != nil || string(buffer[:n]) != "RFB 003.008\n" {
+		t.Fatalf("Expected the chunks in one read Got: %q %v", buffer[:n], err)
+	}
+
+	//the deadline must not outlive the read
+	go func() {
+		time.Sleep(100 * time.Millisecond)
+		backend.Write([]byte("later"))
+	}()
+	n, err = readCoalesced(conn, buffer, 0)
+	if err != nil || string(buffer[:n]) != "later" {
+		t.Fatalf("Expected a read after the flush delay to work Got: %q %v", buffer[:n], err)
+	}
+
+	go func() {
+		backend.Write([]byte("last"))
+		backend.Close()
+	}()
+	n, err = readCoalesced(conn, buffer, 50*time.Millisecond)
+	if err != io.EOF || string(buffer[:n]) != "last" {
+		t.Fatalf("Expected the data read before EOF Got: %q %v", buffer[:n], err)
+	}
+}
+
+// Streams 1 MiB framebuffer updates, written in TLS record sized chunks,
+// from a fake xenserver to a websocket client
+func benchmarkToClient(b *testing.B, readSize, websocketWrite int, flushDelay time.Duration) {
+	const chunk = 16 * 1024
+	frame := make([]byte, 1<<20)
+
+	browser, backend, proxies := newTestProxy(b, "benchmark", withConfig(func(c *Config) {
+		c.Buffers.ReadSize = readSize
+		c.Buffers.WebsocketWrite = websocketWrite
+		c.Buffers.FlushDelay = int(flushDelay / time.Millisecond)
+	}))
+	proxy := <-proxies
+
+	go func() {
+		for i := 0; i < b.N; i++ {
+			for offset := 0; offset < len(frame); offset += chunk {
+				backend.Write(frame[offset : offset+chunk])
+			}
+		}
+	}()
+
+	b.SetBytes(int64(len(frame)))
+	b.ReportAllocs()
+	b.ResetTimer()
+
+	var received int64
+	messages := 0
+	for received < int64(b.N*len(frame)) {
+		_, reader, err := browser.NextReader()
+		if err != nil {
+			b.Fatal(err)
+		}
+		n, _ := io.Copy(ioutil.Discard, reader)
+		received += n
+		messages++
+	}
+
+	b.StopTimer()
+	b.ReportMetric(float64(messages)/float64(b.N), "msgs/op")
+
+	proxy.Stop(causeTerminated)
+	<-proxy.Done()
+}
+
+// The data path before buffers were configurable
+func BenchmarkToClientSmallBuffers(b *testing.B) {
+	benchmarkToClient(b, 1024, 1024, 0)
+}
+
+func BenchmarkToClientDefaultBuffers(b *testing.B) {
+	benchmarkToClient(b, 65536, 65536, 2*time.Millisecond)
+}
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"bytes"
+	"net"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestOffersDeflate(t *testing.T) {
+	cases := map[string]bool{
+		"":                       false,
+		"x-webkit-deflate-frame": false,
+		"permessage-deflate":     true,
+		"foo, permessage-deflate; client_max_window_bits": true,
+	}
+
+	for header, expected := range cases {
+		r := httptest.NewRequest("GET", "/vnc/test", nil)
+		if header != "" {
+			r.Header.Set("Sec-WebSocket-Extensions", header)
+		}
+		if offersDeflate(r) != expected {
+			t.Errorf("Header %q Expected: %v", header, expected)
+		}
+	}
+}
+
+// Counts the bytes the browser reads off the wire
+type readCountingConn struct {
+	net.Conn
+	read int64 // accessed atomically
+}
+
+func (c *readCountingConn) Read(b []byte) (int, error) {
+	n, err := c.Conn.Read(b)
+	atomic.AddInt64(&c.read, int64(n))
+	return n, err
+}
+
+func TestCompressionRatio(t *testing.T) {
+	var wire *readCountingConn
+	dialer := websocket.Dialer{
+		EnableCompression: true,
+		NetDial: func(network, addr string) (net.Conn, error) {
+			conn, err := net.Dial(network, addr)
+			if err != nil {
+				return nil, err
+			}
+			wire = &readCountingConn{Conn: conn}
+			return wire, nil
+		},
+	}
+
+	browser, backend, proxies := newTestProxy(t, "compressed", withDialer(dialer), withConfig(func(c *Config) {
+		c.Compression.Enabled = true
+	}))
+	proxy := <-proxies
+	handshake := atomic.LoadInt64(&wire.read)
+
+	//a blank framebuffer update
+	go backend.Write(make([]byte, 32*1024))
+
+	received := 0
+	for received < 32*1024 {
+		_, data, err := browser.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !bytes.Equal(data, make([]byte, len(data))) {
+			t.Fatal("Expected the browser to get the zeros back")
+		}
+		received += len(data)
+	}
+
+	//what the browser read off the wire, not what the proxy counted
+	if onWire := atomic.LoadInt64(&wire.read) - handshake; onWire <= 0 || onWire > 32*1024/10 {
+		t.Error("Expected the browser to receive the zeros compressed Got:", onWire, "bytes")
+	}
+
+	if ratio := proxy.CompressionRatio(); ratio <= 0 || ratio > 0.1 {
+		t.Error("Expected zeros to compress well Got:", ratio)
+	}
+
+	proxy.Stop(causeTerminated)
+	<-proxy.Done()
+}
@@ -1,16 +1,35 @@
 package main
 
 import (
-	"github.com/Sirupsen/logrus"
+	"errors"
+	"github.com/sirupsen/logrus"
 	"gopkg.in/gcfg.v1"
 	"os"
 	"strconv"
+	"time"
 )
 
 var log = logrus.New()
 
 type Config struct {
-	Server configServer
+	Server      configServer
+	Session     configSession
+	Auth        configAuth
+	Keepalive   configKeepalive
+	Health      configHealth
+	Admin       configAdmin
+	Log         configLog
+	Tracing     configTracing
+	RateLimit   configRateLimit
+	Buffers     configBuffers
+	Compression configCompression
+	Origin      configOrigin
+	Guard       configGuard
+	Limits      configLimits
+	Screenshot  configScreenshot
+	Keys        configKeys
+	Static      configStatic
+	Branding    map[string]*configBranding // by the zone of the token, "default" for the others
 }
 
 type configServer struct {
@@ -18,6 +37,118 @@ type configServer struct {
 	Hostname      string
 	EncryptionKey string
 	EncryptionIv  string
+	DrainTimeout  int // seconds active sessions get to finish on shutdown
+}
+
+type configSession struct {
+	IdleTimeout int // minutes without keyboard/mouse input, 0 disables
+	MaxDuration int // minutes, 0 disables
+	WarnBefore  int // seconds before a timeout the user gets warned
+}
+
+type configAuth struct {
+	Url      string // management server endpoint, empty disables re-authentication
+	Interval int    // seconds between checks
+	Timeout  int    // seconds
+}
+
+type configKeepalive struct {
+	PingInterval int // seconds between websocket pings, 0 disables
+	PongTimeout  int // seconds to wait for a pong before the browser is considered gone
+	TcpKeepalive int // seconds between TCP keepalives to xenserver, negative disables
+}
+
+type configHealth struct {
+	XenHost      []string // xenservers probed by /readyz, may be given multiple times
+	ProbeTimeout int      // seconds
+	FdThreshold  int      // percent of the open file limit in use before /readyz fails
+}
+
+type configAdmin struct {
+	Listen string // admin API, probes and /metrics
+	Token  string // bearer token for the admin API, empty disables it
+}
+
+type configLog struct {
+	Level        string `json:"level"`
+	Format       string `json:"format"` // text or json
+	Output       string `json:"output"` // stdout, stderr, file or syslog
+	File         string `json:"file"`
+	MaxSize      int    `json:"maxSize"`    // megabytes before the file is rotated, 0 disables
+	MaxBackups   int    `json:"maxBackups"` // rotated files kept
+	SyslogSocket string `json:"syslogSocket"`
+	SyslogTag    string `json:"syslogTag"`
+}
+
+type configTracing struct {
+	Endpoint    string // host:port of the OTLP/HTTP collector, empty disables export
+	ServiceName string
+	SampleRatio float64 // share of new traces which are recorded
+}
+
+// Limits on traffic to the browsers, in KiB/s and KiB. A rate of 0
+// disables the limit, a burst of 0 allows one second worth of traffic.
+type configRateLimit struct {
+	SessionRate  int
+	SessionBurst int
+	GlobalRate   int
+	GlobalBurst  int
+}
+
+type configBuffers struct {
+	ReadSize       int // bytes read from xenserver into one websocket message at most
+	WebsocketRead  int // bytes
+	WebsocketWrite int // bytes, larger messages are split into several frames
+	FlushDelay     int // milliseconds to wait for more data from xenserver before sending, 0 disables
+}
+
+type configCompression struct {
+	Enabled bool // permessage-deflate towards browsers which offer it
+	Level   int  // 1 (fastest) to 9 (smallest)
+	MinSize int  // bytes, smaller messages are sent uncompressed
+}
+
+type configOrigin struct {
+	Allow []string // origins which may open consoles besides the proxy and the management server, may be given multiple times
+}
+
+type configGuard struct {
+	Rate          float64  // requests per second per client IP to /console and /vnc/, 0 disables
+	Burst         int      // requests a client can make at once, 0 allows one
+	MaxFailures   int      // bad tokens or session IDs within the failure window before a ban, 0 disables
+	FailureWindow int      // seconds
+	BanDuration   int      // seconds
+	TrustedProxy  []string // addresses or CIDRs whose X-Forwarded-For is believed, may be given multiple times
+}
+
+// Limits on open consoles, 0 disables a limit
+type configLimits struct {
+	MaxSessions  int
+	MaxPerHost   int // per xenserver
+	MaxPerClient int // per client IP
+	QueueTimeout int // seconds a console waits for a free slot, 0 refuses it right away
+}
+
+type configScreenshot struct {
+	Timeout  int // seconds to open the tunnel and read the screen
+	CacheTtl int // seconds a screenshot is served again for the same VM, 0 disables the cache
+}
+
+type configStatic struct {
+	Dir string // serve the UI from this directory instead of the binary, for working on it
+}
+
+// Look of the console pages of one zone
+type configBranding struct {
+	Title     string // after the VM name in the page title
+	Logo      string // URL of an image shown in the status bar, empty for none
+	BarColor  string // CSS color of the status bar
+	TextColor string // CSS color of the status text
+}
+
+type configKeys struct {
+	Delay   int // milliseconds between key events typed through the key API
+	Timeout int // seconds to open a tunnel for keys
 }
 
 func (c *configServer) Addr() string {
@@ -32,10 +163,168 @@ func (c *configServer) SetEncryptionIv(iv string) {
 	c.EncryptionIv = iv
 }
 
+func (c *configServer) GetDrainTimeout() time.Duration {
+	return time.Duration(c.DrainTimeout) * time.Second
+}
+
+func (c *configSession) GetIdleTimeout() time.Duration {
+	return time.Duration(c.IdleTimeout) * time.Minute
+}
+
+func (c *configSession) GetMaxDuration() time.Duration {
+	return time.Duration(c.MaxDuration) * time.Minute
+}
+
+func (c *configSession) GetWarnBefore() time.Duration {
+	return time.Duration(c.WarnBefore) * time.Second
+}
+
+func (c *configAuth) GetInterval() time.Duration {
+	return time.Duration(c.Interval) * time.Second
+}
+
+func (c *configAuth) GetTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Second
+}
+
+func (c *configKeepalive) GetPingInterval() time.Duration {
+	return time.Duration(c.PingInterval) * time.Second
+}
+
+func (c *configKeepalive) GetPongTimeout() time.Duration {
+	return time.Duration(c.PongTimeout) * time.Second
+}
+
+func (c *configKeepalive) GetTcpKeepalive() time.Duration {
+	return time.Duration(c.TcpKeepalive) * time.Second
+}
+
+func (c *configBuffers) GetFlushDelay() time.Duration {
+	return time.Duration(c.FlushDelay) * time.Millisecond
+}
+
+// The limiter refuses everything with a burst of 0
+func (c *configGuard) GetBurst() int {
+	if c.Burst < 1 {
+		return 1
+	}
+	return c.Burst
+}
+
+func (c *configGuard) GetFailureWindow() time.Duration {
+	return time.Duration(c.FailureWindow) * time.Second
+}
+
+func (c *configGuard) GetBanDuration() time.Duration {
+	return time.Duration(c.BanDuration) * time.Second
+}
+
+func (c *configLimits) GetQueueTimeout() time.Duration {
+	return time.Duration(c.QueueTimeout) * time.Second
+}
+
+func (c *configScreenshot) GetTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Second
+}
+
+func (c *configScreenshot) GetCacheTtl() time.Duration {
+	return time.Duration(c.CacheTtl) * time.Second
+}
+
+func (c *configKeys) GetDelay() time.Duration {
+	return time.Duration(c.Delay) * time.Millisecond
+}
+
+func (c *configKeys) GetTimeout() time.Duration {
+	return time.Duration(c.Timeout) * time.Second
+}
+
+func (c *configHealth) GetProbeTimeout() time.Duration {
+	return time.Duration(c.ProbeTimeout) * time.Second
+}
+
 const defaultConfig = `
 	[server]
 	port=9090
 	hostname=0.0.0.0
+	draintimeout=30
+
+	[session]
+	idletimeout=0
+	maxduration=0
+	warnbefore=60
+
+	[auth]
+	url=
+	interval=300
+	timeout=10
+
+	[keepalive]
+	pinginterval=30
+	pongtimeout=15
+	tcpkeepalive=30
+
+	[health]
+	probetimeout=2
+	fdthreshold=90
+
+	[admin]
+	listen=127.0.0.1:9091
+	token=
+
+	[log]
+	level=info
+	format=text
+	output=stdout
+	maxsize=100
+	maxbackups=5
+	syslogtag=go-xen-console-proxy
+
+	[tracing]
+	endpoint=
+	servicename=go-xen-console-proxy
+	sampleratio=1
+
+	[ratelimit]
+	sessionrate=0
+	sessionburst=0
+	globalrate=0
+	globalburst=0
+
+	[buffers]
+	readsize=65536
+	websocketread=4096
+	websocketwrite=65536
+	flushdelay=2
+
+	[compression]
+	enabled=true
+	level=1
+	minsize=256
+
+	[guard]
+	rate=5
+	burst=20
+	maxfailures=10
+	failurewindow=300
+	banduration=900
+
+	[limits]
+	maxsessions=0
+	maxperhost=0
+	maxperclient=0
+	queuetimeout=0
+
+	[screenshot]
+	timeout=10
+	cachettl=30
+
+	[keys]
+	delay=10
+	timeout=10
+
+	[branding "default"]
+	title=Console
 `
 
 func init() {
@@ -46,3 +335,19 @@ func init() {
 		os.Exit(1)
 	}
 }
+
+// Reads a config file on top of the defaults
+func loadConfig(path string) error {
+	if err := gcfg.ReadFileInto(&cfg, path); err != nil {
+		return err
+	}
+	return cfg.check()
+}
+
+// Rejects settings which would fail at runtime
+func (c *Config) check() error {
+	if c.Auth.Url != "" && c.Auth.Interval <= 0 {
+		return errors.New("[auth] interval must be positive when url is set")
+	}
+	return nil
+}
@@ -7,7 +7,7 @@ import (
 	b64 "encoding/base64"
 	"errors"
 
-	"github.com/Sirupsen/logrus"
+	"github.com/sirupsen/logrus"
 )
 
 func encrypt(key_str, iv_str, text string) (string, error) {
@@ -0,0 +1,200 @@
+package main
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+// Why a console could not be opened. The user gets it as an error page
+// or, once the websocket is open, as the reason of its close frame.
+type consoleError struct {
+	kind    string
+	status  int    // HTTP status of the error page
+	code    int    // websocket close code
+	title   string // headline of the page and close reason, at most 123 bytes
+	message string // what the user can do about it
+	err     error  // the underlying failure, logged but never shown
+}
+
+func (e *consoleError) Error() string {
+	if e.err != nil {
+		return e.title + ": " + e.err.Error()
+	}
+	return e.title
+}
+
+func (e *consoleError) Unwrap() error {
+	return e.err
+}
+
+// Errors of the same kind match with errors.Is, whatever caused them
+func (e *consoleError) Is(target error) bool {
+	t, ok := target.(*consoleError)
+	return ok && t.kind == e.kind
+}
+
+// Returns a copy of e caused by err
+func (e *consoleError) wrap(err error) *consoleError {
+	wrapped := *e
+	wrapped.err = err
+	return &wrapped
+}
+
+var (
+	errExpiredToken = &consoleError{
+		kind:    "expired_token",
+		status:  http.StatusGone,
+		code:    closeExpiredToken,
+		title:   "This console link has expired",
+		message: "Open the console again from the management server to get a new link.",
+	}
+	errInvalidToken = &consoleError{
+		kind:    "invalid_token",
+		status:  http.StatusForbidden,
+		code:    closeInvalidToken,
+		title:   "This console link is not valid",
+		message: "Open the console again from the management server.",
+	}
+	errUnknownSession = &consoleError{
+		kind:    "unknown_session",
+		status:  http.StatusNotFound,
+		code:    closeUnknownSession,
+		title:   "The console session was not found",
+		message: "It was closed or the console proxy restarted. Open the console again from the management server.",
+	}
+	errBackendUnreachable = &consoleError{
+		kind:    "backend_unreachable",
+		status:  http.StatusBadGateway,
+		code:    closeBackendUnreachable,
+		title:   "The host of the VM cannot be reached",
+		message: "Reload the page to try again in a moment.",
+	}
+	errHostRefused = &consoleError{
+		kind:    "host_refused",
+		status:  http.StatusBadGateway,
+		code:    closeHostRefused,
+		title:   "The host of the VM refused the console",
+		message: "The VM may have been stopped or moved. Open the console again from the management server.",
+	}
+	errDraining = &consoleError{
+		kind:    "draining",
+		status:  http.StatusServiceUnavailable,
+		code:    closeDraining,
+		title:   "The console proxy is restarting",
+		message: "Reload the page to try again in a moment.",
+	}
+	errRateLimited = &consoleError{
+		kind:    "rate_limited",
+		status:  http.StatusTooManyRequests,
+		code:    closeRateLimited,
+		title:   "Too many requests from your address",
+		message: "Wait a moment and reload the page.",
+	}
+	errBanned = &consoleError{
+		kind:    "banned",
+		status:  http.StatusTooManyRequests,
+		code:    closeBanned,
+		title:   "Your address is blocked for a while",
+		message: "Too many console links from your address were not valid. Try again later.",
+	}
+	errOriginRefused = &consoleError{
+		kind:    "origin_refused",
+		status:  http.StatusForbidden,
+		code:    closeOriginRefused,
+		title:   "The console was opened from another site",
+		message: "Open the console again from the management server.",
+	}
+)
+
+// Returns err as a consoleError, fallback caused by err if it is none
+func asConsoleError(err error, fallback *consoleError) *consoleError {
+	var consoleErr *consoleError
+	if errors.As(err, &consoleErr) {
+		return consoleErr
+	}
+	return fallback.wrap(err)
+}
+
+type errorPage struct {
+	Title    string
+	Message  string
+	Branding *configBranding
+}
+
+// Shows err to the user. The page has no scripts, the status tells
+// clients which do not read it what happened.
+func writeErrorPage(w http.ResponseWriter, r *http.Request, err *consoleError) {
+	branding := brandingFor("")
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; style-src 'self' 'unsafe-inline'; img-src "+imageSources(branding))
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(err.status)
+
+	page := errorPage{Title: err.title, Message: err.message, Branding: branding}
+	templates, renderErr := loadPageTemplates()
+	if renderErr == nil {
+		renderErr = templates.ExecuteTemplate(w, "error.html", page)
+	}
+	if renderErr != nil {
+		requestLogger(r).WithFields(logrus.Fields{
+			"error": renderErr,
+		}).Warn("Unable to render the error page")
+	}
+}
+
+type errorBody struct {
+	Error   string `json:"error"`
+	Message string `json:"message"`
+}
+
+// Reports err to the scripts of the page, which poll the proxy
+func writeJsonError(w http.ResponseWriter, err *consoleError) {
+	writeJson(w, err.status, errorBody{Error: err.kind, Message: err.title})
+}
+
+// Closes the websocket of a console which could not be opened, noVNC and
+// the terminal show the reason
+func closeWithError(wsConn *websocket.Conn, err *consoleError) {
+	mesg := websocket.FormatCloseMessage(err.code, err.title)
+	wsConn.WriteControl(websocket.CloseMessage, mesg, time.Now().Add(time.Second))
+	wsConn.Close()
+}
+
+// Reports err on a /vnc/ request. Browsers hide the response to a failed
+// handshake from scripts, so the websocket is opened to close it with the
+// reason. Other requests get the error page.
+func rejectWebsocket(w http.ResponseWriter, r *http.Request, upgrader *websocket.Upgrader, err *consoleError) {
+	if !websocket.IsWebSocketUpgrade(r) {
+		writeErrorPage(w, r, err)
+		return
+	}
+
+	//a failed upgrade has been answered by the upgrader
+	wsConn, upgradeErr := upgrader.Upgrade(w, r, nil)
+	if upgradeErr != nil {
+		return
+	}
+	closeWithError(wsConn, err)
+}
+
+// Refuses a request before its handler runs: /vnc/ websockets are closed
+// with the reason, the console pages get the error page and the token APIs
+// the title as text
+func refuseRequest(w http.ResponseWriter, r *http.Request, err *consoleError) {
+	switch {
+	case strings.HasPrefix(r.URL.Path, "/vnc/"):
+		upgrader := newUpgrader()
+		rejectWebsocket(w, r, &upgrader, err)
+	case r.URL.Path == "/console" || strings.HasPrefix(r.URL.Path, "/console/"):
+		writeErrorPage(w, r, err)
+	default:
+		http.Error(w, err.title, err.status)
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestConsoleErrorKinds(t *testing.T) {
+	err := errHostRefused.wrap(io.EOF)
+	if !errors.Is(err, errHostRefused) || errors.Is(err, errBackendUnreachable) {
+		t.Error("Expected the kind to match Got:", err)
+	}
+	if !errors.Is(err, io.EOF) {
+		t.Error("Expected the cause to be kept Got:", err)
+	}
+	if errHostRefused.err != nil {
+		t.Error("Expected wrap to leave the kind alone")
+	}
+
+	if consoleErr := asConsoleError(io.EOF, errBackendUnreachable); consoleErr.code != closeBackendUnreachable {
+		t.Error("Expected the fallback Got:", consoleErr)
+	}
+	if consoleErr := asConsoleError(err, errBackendUnreachable); consoleErr.code != closeHostRefused {
+		t.Error("Expected the error itself Got:", consoleErr)
+	}
+
+	kinds := []*consoleError{errExpiredToken, errInvalidToken, errUnknownSession, errBackendUnreachable, errHostRefused,
+		errDraining, errRateLimited, errBanned, errOriginRefused}
+	for _, kind := range kinds {
+		if len(kind.title) > 123 {
+			t.Errorf("%s: the close reason is too long", kind.kind)
+		}
+	}
+}
+
+func TestErrorPage(t *testing.T) {
+	r := httptest.NewRequest("GET", "/console?token=x", nil)
+	w := httptest.NewRecorder()
+	writeErrorPage(w, r, errExpiredToken.wrap(errors.New("secret detail")))
+
+	if w.Code != http.StatusGone {
+		t.Error("Expected 410 Got:", w.Code)
+	}
+	body := w.Body.String()
+	if !strings.Contains(body, "<h1>This console link has expired</h1>") {
+		t.Error("Expected the title on the page Got:", body)
+	}
+	if strings.Contains(body, "secret detail") || strings.Contains(body, "<script") {
+		t.Error("Expected neither the cause nor scripts on the page")
+	}
+	if csp := w.Header().Get("Content-Security-Policy"); !strings.HasPrefix(csp, "default-src 'none'") {
+		t.Error("Expected a policy without scripts Got:", csp)
+	}
+}
+
+func TestNewConsoleConnectionErrors(t *testing.T) {
+	key, iv := cfg.Server.EncryptionKey, cfg.Server.EncryptionIv
+	defer func() { cfg.Server.EncryptionKey, cfg.Server.EncryptionIv = key, iv }()
+	cfg.Server.EncryptionKey = "kV9Ld-X4rKlTQF4ZJwyn9A"
+	cfg.Server.EncryptionIv = "PCb_WQYrUgbahQeqDEkuUw"
+
+	expired, _ := encrypt(cfg.Server.EncryptionKey, cfg.Server.EncryptionIv, `{
+		"clientTunnelUrl": "https://172.31.0.46/console?uuid=d965e329-c32b-2c9c-a33c-66cafe6214c3",
+		"clientTunnelSession": "OpaqueRef:0b4ba2e8-d9a7-4a42-b1a7-5bbb1ba7b0b0",
+		"expires": 1500000000
+	}`)
+	invalid, _ := encrypt(cfg.Server.EncryptionKey, cfg.Server.EncryptionIv, `{"clientTunnelUrl": "https://172.31.0.46/console"}`)
+
+	tests := []struct {
+		url    string
+		status int
+	}{
+		{"/console?token=garbage", http.StatusForbidden},
+		{"/console?token=" + invalid, http.StatusForbidden},
+		{"/console?token=" + expired, http.StatusGone},
+		{"/console?path=missing", http.StatusNotFound},
+	}
+
+	for _, test := range tests {
+		w := httptest.NewRecorder()
+		handleNewConsoleConnection(w, httptest.NewRequest("GET", test.url, nil))
+		if w.Code != test.status || !strings.Contains(w.Body.String(), "<h1>") {
+			t.Errorf("%s Expected: %d Got: %d", test.url, test.status, w.Code)
+		}
+	}
+
+	//the screenshot and key APIs take the token without redeeming it
+	w := httptest.NewRecorder()
+	handleScreenshot(w, httptest.NewRequest("GET", "/screenshot?token="+expired, nil))
+	if w.Code != http.StatusGone {
+		t.Error("Expected the screenshot API to refuse the expired token Got:", w.Code)
+	}
+
+	w = httptest.NewRecorder()
+	handleKeys(w, httptest.NewRequest("POST", "/keys?token="+expired, strings.NewReader(`{"keys": "ctrl-alt-delete"}`)))
+	if w.Code != http.StatusGone {
+		t.Error("Expected the key API to refuse the expired token Got:", w.Code)
+	}
+}
+
+func TestWebsocketUnknownSession(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(handleVncWebsocketProxy))
+	defer server.Close()
+
+	//the browser gets the reason in the close frame
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/vnc/missing", nil)
+	if err != nil {
+		t.Fatal("Expected the websocket to open Got:", err)
+	}
+	defer ws.Close()
+
+	_, _, err = ws.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	if !ok || closeErr.Code != closeUnknownSession || closeErr.Text != errUnknownSession.title {
+		t.Error("Expected the unknown session close code Got:", err)
+	}
+
+	//anything else gets the page
+	resp, err := http.Get(server.URL + "/vnc/missing")
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Error("Expected 404 Got:", resp.StatusCode)
+	}
+}
+
+func TestWebsocketForeignOrigin(t *testing.T) {
+	SessionMap.Put("origin", &ConsoleSession{})
+	defer SessionMap.Delete("origin")
+
+	server := httptest.NewServer(http.HandlerFunc(handleVncWebsocketProxy))
+	defer server.Close()
+
+	//a page of another site learns why, the session stays
+	header := http.Header{"Origin": []string{"https://evil.example.org"}}
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/vnc/origin", header)
+	if err != nil {
+		t.Fatal("Expected the websocket to open Got:", err)
+	}
+	defer ws.Close()
+
+	_, _, err = ws.ReadMessage()
+	if closeErr, ok := err.(*websocket.CloseError); !ok || closeErr.Code != closeOriginRefused {
+		t.Error("Expected the origin refused close code Got:", err)
+	}
+	if SessionMap.Get("origin") == nil {
+		t.Error("Expected the session to be kept")
+	}
+}
+
+func TestSessionStatusUnknown(t *testing.T) {
+	w := httptest.NewRecorder()
+	handleSessionStatus(w, httptest.NewRequest("GET", "/session/missing", nil))
+
+	if w.Code != http.StatusNotFound || w.Header().Get("Content-Type") != "application/json" {
+		t.Error("Expected a JSON 404 Got:", w.Code, w.Header().Get("Content-Type"))
+	}
+	if !strings.Contains(w.Body.String(), `"error":"unknown_session"`) {
+		t.Error("Expected the kind of the error Got:", w.Body.String())
+	}
+}
@@ -0,0 +1,215 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
+)
+
+// Values of the reason label of blockedRequests
+const (
+	blockedRateLimited = "rate_limited"
+	blockedBanned      = "banned"
+)
+
+// Clients without requests for this long are forgotten unless they are
+// still banned or within the failure window
+const guardForget = 10 * time.Minute
+
+// Per client IP request limits and bans after repeated token and session
+// ID failures, so tokens and session IDs cannot be probed cheaply
+type clientGuard struct {
+	lock      sync.Mutex
+	clients   map[string]*guardedClient
+	lastSweep time.Time
+}
+
+type guardedClient struct {
+	limiter      *rate.Limiter // nil if requests are not limited
+	failures     int
+	firstFailure time.Time
+	bannedUntil  time.Time
+	lastSeen     time.Time
+}
+
+var guard = newClientGuard()
+
+func newClientGuard() *clientGuard {
+	return &clientGuard{clients: make(map[string]*guardedClient)}
+}
+
+// Returns why a request of the client is refused and when it may retry,
+// an empty reason if it may go ahead
+func (g *clientGuard) Allow(ip string, now time.Time) (string, time.Duration) {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	client := g.client(ip, now)
+
+	if now.Before(client.bannedUntil) {
+		return blockedBanned, client.bannedUntil.Sub(now)
+	}
+
+	if client.limiter != nil && !client.limiter.AllowN(now, 1) {
+		return blockedRateLimited, time.Duration(float64(time.Second) / float64(client.limiter.Limit()))
+	}
+
+	return "", 0
+}
+
+// Counts a failed token or session ID. Returns true if the client got
+// banned by it.
+func (g *clientGuard) Fail(ip string, now time.Time) bool {
+	if cfg.Guard.MaxFailures <= 0 {
+		return false
+	}
+
+	g.lock.Lock()
+	defer g.lock.Unlock()
+
+	client := g.client(ip, now)
+
+	if client.failures == 0 || now.Sub(client.firstFailure) > cfg.Guard.GetFailureWindow() {
+		client.failures = 0
+		client.firstFailure = now
+	}
+	client.failures++
+
+	if client.failures < cfg.Guard.MaxFailures {
+		return false
+	}
+
+	client.failures = 0
+	client.bannedUntil = now.Add(cfg.Guard.GetBanDuration())
+	return true
+}
+
+// Must be called with the lock held
+func (g *clientGuard) client(ip string, now time.Time) *guardedClient {
+	g.sweep(now)
+
+	client := g.clients[ip]
+	if client == nil {
+		client = &guardedClient{}
+		if cfg.Guard.Rate > 0 {
+			client.limiter = rate.NewLimiter(rate.Limit(cfg.Guard.Rate), cfg.Guard.GetBurst())
+		}
+		g.clients[ip] = client
+	}
+
+	client.lastSeen = now
+	return client
+}
+
+// Forgets clients which went quiet, at most once a minute
+func (g *clientGuard) sweep(now time.Time) {
+	if now.Sub(g.lastSweep) < time.Minute {
+		return
+	}
+	g.lastSweep = now
+
+	forget := guardForget
+	if window := cfg.Guard.GetFailureWindow(); window > forget {
+		forget = window
+	}
+
+	for ip, client := range g.clients {
+		if now.After(client.bannedUntil) && now.Sub(client.lastSeen) > forget {
+			delete(g.clients, ip)
+		}
+	}
+}
+
+// Refuses requests of clients which are banned or over their rate
+func guardRequests(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		ip := clientIP(r)
+
+		reason, retryAfter := guard.Allow(ip, time.Now())
+		if reason != "" {
+			blockedRequests.WithLabelValues(reason).Inc()
+
+			requestLogger(r).WithFields(logrus.Fields{
+				"client_ip": ip,
+				"reason":    reason,
+			}).Debug("Request blocked")
+
+			seconds := int(retryAfter.Seconds() + 0.999)
+			w.Header().Set("Retry-After", strconv.Itoa(seconds))
+			if reason == blockedBanned {
+				refuseRequest(w, r, errBanned)
+			} else {
+				refuseRequest(w, r, errRateLimited)
+			}
+			return
+		}
+
+		handler(w, r)
+	}
+}
+
+// Records a bad token or unknown session ID of the client of r
+func recordFailure(r *http.Request) {
+	ip := clientIP(r)
+	if guard.Fail(ip, time.Now()) {
+		bansTotal.Inc()
+
+		requestLogger(r).WithFields(logrus.Fields{
+			"client_ip": ip,
+			"duration":  cfg.Guard.GetBanDuration().String(),
+		}).Warn("Client banned after repeated failures")
+	}
+}
+
+// Returns the IP of the client. Behind a trusted proxy it is the last
+// address in X-Forwarded-For which was not added by a trusted proxy.
+func clientIP(r *http.Request) string {
+	ip, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		ip = r.RemoteAddr
+	}
+
+	if !isTrustedProxy(ip) {
+		return ip
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header["X-Forwarded-For"], ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		hop := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(hop) == nil {
+			break
+		}
+
+		ip = hop
+		if !isTrustedProxy(hop) {
+			break
+		}
+	}
+
+	return ip
+}
+
+// Entries of trustedproxy are addresses or CIDR ranges
+func isTrustedProxy(ip string) bool {
+	parsed := net.ParseIP(ip)
+	if parsed == nil {
+		return false
+	}
+
+	for _, trusted := range cfg.Guard.TrustedProxy {
+		if _, network, err := net.ParseCIDR(trusted); err == nil {
+			if network.Contains(parsed) {
+				return true
+			}
+		} else if trustedIP := net.ParseIP(trusted); trustedIP != nil && trustedIP.Equal(parsed) {
+			return true
+		}
+	}
+	return false
+}
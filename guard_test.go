@@ -0,0 +1,131 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGuardRateLimit(t *testing.T) {
+	defer func(previous configGuard) { cfg.Guard = previous }(cfg.Guard)
+	cfg.Guard.Rate = 1
+	cfg.Guard.Burst = 2
+
+	g := newClientGuard()
+	now := time.Now()
+
+	for i := 0; i < 2; i++ {
+		if reason, _ := g.Allow("10.0.0.1", now); reason != "" {
+			t.Fatal("Expected the burst to be allowed Got:", reason)
+		}
+	}
+
+	if reason, retry := g.Allow("10.0.0.1", now); reason != blockedRateLimited || retry != time.Second {
+		t.Error("Expected to be rate limited Got:", reason, retry)
+	}
+
+	if reason, _ := g.Allow("10.0.0.2", now); reason != "" {
+		t.Error("Expected other clients to be allowed Got:", reason)
+	}
+
+	if reason, _ := g.Allow("10.0.0.1", now.Add(time.Second)); reason != "" {
+		t.Error("Expected the bucket to refill Got:", reason)
+	}
+}
+
+func TestGuardZeroBurst(t *testing.T) {
+	defer func(previous configGuard) { cfg.Guard = previous }(cfg.Guard)
+	cfg.Guard.Rate = 1
+	cfg.Guard.Burst = 0
+
+	g := newClientGuard()
+	now := time.Now()
+
+	if reason, _ := g.Allow("10.0.0.1", now); reason != "" {
+		t.Error("Expected one request to be allowed Got:", reason)
+	}
+	if reason, _ := g.Allow("10.0.0.1", now); reason != blockedRateLimited {
+		t.Error("Expected the second request to be rate limited Got:", reason)
+	}
+}
+
+func TestGuardBan(t *testing.T) {
+	defer func(previous configGuard) { cfg.Guard = previous }(cfg.Guard)
+	cfg.Guard = configGuard{MaxFailures: 3, FailureWindow: 60, BanDuration: 600}
+
+	g := newClientGuard()
+	now := time.Now()
+
+	g.Fail("10.0.0.1", now)
+	g.Fail("10.0.0.1", now)
+
+	//the first failures fell out of the window
+	later := now.Add(2 * time.Minute)
+	if g.Fail("10.0.0.1", later) {
+		t.Fatal("Expected failures outside the window to be forgotten")
+	}
+	g.Fail("10.0.0.1", later)
+	if !g.Fail("10.0.0.1", later) {
+		t.Fatal("Expected a ban after 3 failures")
+	}
+
+	if reason, retry := g.Allow("10.0.0.1", later); reason != blockedBanned || retry != 10*time.Minute {
+		t.Error("Expected to be banned Got:", reason, retry)
+	}
+
+	if reason, _ := g.Allow("10.0.0.1", later.Add(10*time.Minute+time.Second)); reason != "" {
+		t.Error("Expected the ban to expire Got:", reason)
+	}
+}
+
+func TestGuardRequests(t *testing.T) {
+	defer func(previous configGuard) { cfg.Guard = previous }(cfg.Guard)
+	defer func(previous *clientGuard) { guard = previous }(guard)
+	cfg.Guard = configGuard{MaxFailures: 1, FailureWindow: 60, BanDuration: 60}
+	guard = newClientGuard()
+
+	handler := guardRequests(handleNewConsoleConnection)
+
+	r := httptest.NewRequest("GET", "/console?path=unknown", nil)
+	handler(httptest.NewRecorder(), r)
+
+	w := httptest.NewRecorder()
+	handler(w, r)
+	if w.Code != http.StatusTooManyRequests || w.Header().Get("Retry-After") != "60" {
+		t.Error("Expected the client to be banned Got:", w.Code, w.Header().Get("Retry-After"))
+	}
+	if !strings.Contains(w.Body.String(), "<h1>"+errBanned.title+"</h1>") {
+		t.Error("Expected the error page Got:", w.Body.String())
+	}
+}
+
+func TestClientIP(t *testing.T) {
+	defer func(previous []string) { cfg.Guard.TrustedProxy = previous }(cfg.Guard.TrustedProxy)
+	cfg.Guard.TrustedProxy = []string{"10.0.0.0/8", "192.168.1.1"}
+
+	cases := []struct {
+		remote    string
+		forwarded string
+		expected  string
+	}{
+		{"203.0.113.7:5555", "", "203.0.113.7"},
+		{"203.0.113.7:5555", "198.51.100.1", "203.0.113.7"},
+		{"192.168.1.1:5555", "198.51.100.1", "198.51.100.1"},
+		{"10.1.2.3:5555", "198.51.100.66, 198.51.100.1, 10.0.0.5", "198.51.100.1"},
+		{"10.1.2.3:5555", "garbage", "10.1.2.3"},
+	}
+
+	for _, c := range cases {
+		r := httptest.NewRequest("GET", "/console", nil)
+		r.RemoteAddr = c.remote
+		if c.forwarded != "" {
+			r.Header.Set("X-Forwarded-For", c.forwarded)
+		}
+
+		if ip := clientIP(r); ip != c.expected {
+			t.Errorf("Remote %s Forwarded %q Expected: %s Got: %s", c.remote, c.forwarded, c.expected, ip)
+		}
+	}
+}
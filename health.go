@@ -0,0 +1,124 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"syscall"
+	"time"
+)
+
+// Set once the HTTP listener is up, accessed atomically
+var listening int32
+
+type HealthCheck struct {
+	Ok     bool   `json:"ok"`
+	Detail string `json:"detail,omitempty"`
+}
+
+type ReadyStatus struct {
+	Status string                 `json:"status"`
+	Checks map[string]HealthCheck `json:"checks"`
+}
+
+// The process is up and serving HTTP
+func handleHealthz(w http.ResponseWriter, r *http.Request) {
+	writeJson(w, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+// The proxy can take new consoles
+func handleReadyz(w http.ResponseWriter, r *http.Request) {
+	status := ReadyStatus{Status: "ready", Checks: readinessChecks()}
+	code := http.StatusOK
+
+	for _, check := range status.Checks {
+		if !check.Ok {
+			status.Status = "not ready"
+			code = http.StatusServiceUnavailable
+			break
+		}
+	}
+
+	writeJson(w, code, status)
+}
+
+func readinessChecks() map[string]HealthCheck {
+	checks := map[string]HealthCheck{
+		"encryption_key":   {Ok: cfg.Server.EncryptionKey != "" && cfg.Server.EncryptionIv != ""},
+		"listener":         {Ok: atomic.LoadInt32(&listening) == 1},
+		"draining":         {Ok: !isDraining()},
+		"file_descriptors": checkFileDescriptors(cfg.Health.FdThreshold),
+	}
+
+	if !checks["encryption_key"].Ok {
+		checks["encryption_key"] = HealthCheck{Detail: "the management server has not set the encryption key yet"}
+	}
+
+	if !checks["draining"].Ok {
+		checks["draining"] = HealthCheck{Detail: "shutting down in " + formatRemaining(drainRemaining())}
+	}
+
+	for host, check := range probeXenHosts(cfg.Health.XenHost, cfg.Health.GetProbeTimeout()) {
+		checks["xenserver "+host] = check
+	}
+
+	return checks
+}
+
+// Fails once more than threshold percent of the open file limit is used,
+// every console needs two descriptors
+func checkFileDescriptors(threshold int) HealthCheck {
+	var limit syscall.Rlimit
+	if err := syscall.Getrlimit(syscall.RLIMIT_NOFILE, &limit); err != nil {
+		return HealthCheck{Ok: true, Detail: err.Error()}
+	}
+
+	fds, err := ioutil.ReadDir("/proc/self/fd")
+	if err != nil {
+		return HealthCheck{Ok: true, Detail: err.Error()}
+	}
+
+	used := uint64(len(fds))
+	return HealthCheck{
+		Ok:     used*100 < limit.Cur*uint64(threshold),
+		Detail: fmt.Sprintf("%d of %d in use", used, limit.Cur),
+	}
+}
+
+// Checks in parallel that the xenservers accept connections, on 443 unless
+// the host includes a port
+func probeXenHosts(hosts []string, timeout time.Duration) map[string]HealthCheck {
+	checks := make(map[string]HealthCheck, len(hosts))
+	var lock sync.Mutex
+	var wg sync.WaitGroup
+
+	for _, host := range hosts {
+		wg.Add(1)
+		go func(host string) {
+			defer wg.Done()
+
+			addr := host
+			if _, _, err := net.SplitHostPort(host); err != nil {
+				addr = net.JoinHostPort(host, "443")
+			}
+
+			check := HealthCheck{Ok: true}
+			conn, err := net.DialTimeout("tcp", addr, timeout)
+			if err != nil {
+				check = HealthCheck{Detail: err.Error()}
+			} else {
+				conn.Close()
+			}
+
+			lock.Lock()
+			checks[host] = check
+			lock.Unlock()
+		}(host)
+	}
+
+	wg.Wait()
+	return checks
+}
@@ -0,0 +1,48 @@
+package main
+
+import (
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestReadyz(t *testing.T) {
+	defer func(server configServer, health configHealth) {
+		cfg.Server = server
+		cfg.Health = health
+		atomic.StoreInt32(&listening, 0)
+	}(cfg.Server, cfg.Health)
+
+	xenserver, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer xenserver.Close()
+
+	atomic.StoreInt32(&listening, 1)
+	cfg.Health.XenHost = []string{xenserver.Addr().String()}
+
+	w := httptest.NewRecorder()
+	handleReadyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable {
+		t.Error("Expected 503 without an encryption key Got:", w.Code)
+	}
+
+	cfg.Server.SetEncryptionKey(key)
+	cfg.Server.SetEncryptionIv(iv)
+
+	w = httptest.NewRecorder()
+	handleReadyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	if w.Code != http.StatusOK {
+		t.Error("Expected 200 Got:", w.Code, w.Body.String())
+	}
+
+	var status ReadyStatus
+	json.NewDecoder(w.Body).Decode(&status)
+	if !status.Checks["xenserver "+xenserver.Addr().String()].Ok {
+		t.Error("Expected the xenserver probe to succeed Got:", status.Checks)
+	}
+}
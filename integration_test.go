@@ -0,0 +1,101 @@
+package main
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+)
+
+// Serves the routes of the proxy and redeems a token the way a browser
+// does, up to the tunnel to xenserver which cannot be reached
+func TestConsolePagesEndToEnd(t *testing.T) {
+	key, iv := cfg.Server.EncryptionKey, cfg.Server.EncryptionIv
+	defer func() { cfg.Server.EncryptionKey, cfg.Server.EncryptionIv = key, iv }()
+	cfg.Server.EncryptionKey = "kV9Ld-X4rKlTQF4ZJwyn9A"
+	cfg.Server.EncryptionIv = "PCb_WQYrUgbahQeqDEkuUw"
+
+	mux := http.NewServeMux()
+	registerRoutes(mux)
+	server := httptest.NewServer(mux)
+	defer server.Close()
+
+	//the tunnel host cannot be dialed, the proxy gives up right away
+	token, _ := encrypt(cfg.Server.EncryptionKey, cfg.Server.EncryptionIv, `{
+		"clientTunnelUrl": "https://127.0.0.1:1/console?uuid=d965e329-c32b-2c9c-a33c-66cafe6214c3",
+		"clientTunnelSession": "OpaqueRef:0b4ba2e8-d9a7-4a42-b1a7-5bbb1ba7b0b0",
+		"vmName": "web01"
+	}`)
+
+	client := &http.Client{CheckRedirect: func(*http.Request, []*http.Request) error {
+		return http.ErrUseLastResponse
+	}}
+	resp, err := client.Get(server.URL + "/console?token=" + token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	page := resp.Header.Get("Location")
+	if resp.StatusCode != http.StatusFound || !strings.HasPrefix(page, "/console/") {
+		t.Fatal("Expected a redirect to the console page Got:", resp.StatusCode, page)
+	}
+	sessionID := strings.TrimPrefix(page, "/console/")
+	defer SessionMap.Delete(sessionID)
+
+	body := getBody(t, server.URL+page)
+	if !strings.Contains(body, "<title>web01 - Console</title>") {
+		t.Error("Expected the page of the session")
+	}
+
+	//everything the page and noVNC load has to be served
+	assets := regexp.MustCompile(`(?:src|href)="(/static/[^"]+)"`).FindAllStringSubmatch(body, -1)
+	scripts := regexp.MustCompile(`load_scripts\(\[([^\]]+)\]`).FindStringSubmatch(getBody(t, server.URL+"/static/include/ui.js"))
+	if len(assets) == 0 || len(scripts) < 2 {
+		t.Fatal("Expected the page to load the UI")
+	}
+	for _, name := range regexp.MustCompile(`"([^"]+)"`).FindAllStringSubmatch(scripts[1], -1) {
+		assets = append(assets, []string{"", "/static/include/" + name[1]})
+	}
+	for _, asset := range assets {
+		if resp, err := http.Get(server.URL + asset[1]); err != nil || resp.StatusCode != http.StatusOK {
+			t.Error("Expected the page to find", asset[1])
+		} else {
+			resp.Body.Close()
+		}
+	}
+
+	//the session is kept for another try while xenserver is unreachable
+	url := "ws" + strings.TrimPrefix(server.URL, "http") + "/vnc/" + sessionID
+	for i := 0; i < 2; i++ {
+		dialer := websocket.Dialer{Subprotocols: []string{"binary"}}
+		ws, _, err := dialer.Dial(url, nil)
+		if err != nil {
+			t.Fatal("Expected the websocket to open Got:", err)
+		}
+
+		_, _, err = ws.ReadMessage()
+		if closeErr, ok := err.(*websocket.CloseError); !ok || closeErr.Code != closeBackendUnreachable {
+			t.Error("Expected xenserver to be unreachable Got:", err)
+		}
+		ws.Close()
+	}
+}
+
+func getBody(t *testing.T, url string) string {
+	resp, err := http.Get(url)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		t.Fatal("Expected", url, "Got:", resp.StatusCode)
+	}
+	body, _ := ioutil.ReadAll(resp.Body)
+	return string(body)
+}
@@ -0,0 +1,378 @@
+package main
+
+import (
+	"encoding/binary"
+	"strings"
+)
+
+// The RFB servers of xenserver map keysyms to scancodes as if the guest had
+// a US keyboard. A guest with another layout then types what is printed on
+// the US key at the same position, a German guest gets "y" for "z". For
+// guests with a known layout the proxy rewrites key events from the
+// browser into the US key at the position the guest expects for the
+// character, with shift and AltGr pressed as needed.
+//
+// QEMU extended key events carry the scancode and need no translation,
+// they are passed on as they are.
+
+// Keys of a pc105 keyboard in the order of the layout rows below, named by
+// what they type on a US keyboard. "<" is the extra key left of "z", which
+// the US layout of xenserver maps like "<".
+var usKeyRows = []string{
+	"`1234567890-=",
+	"qwertyuiop[]",
+	"asdfghjkl;'\\",
+	"<zxcvbnm,./",
+}
+
+// A layout lists what each key types without modifiers, with shift and
+// with AltGr. A space is a key which types nothing on that level.
+type keyLayoutRows struct {
+	normal, shift, altgr []string
+}
+
+var usLayout = keyLayoutRows{
+	normal: usKeyRows,
+	shift: []string{
+		"~!@#$%^&*()_+",
+		"QWERTYUIOP{}",
+		"ASDFGHJKL:\"|",
+		">ZXCVBNM<>?",
+	},
+}
+
+var keyLayoutDefinitions = map[string]keyLayoutRows{
+	"de": {
+		normal: []string{
+			"^1234567890ß´",
+			"qwertzuiopü+",
+			"asdfghjklöä#",
+			"<yxcvbnm,.-",
+		},
+		shift: []string{
+			"°!\"§$%&/()=?`",
+			"QWERTZUIOPÜ*",
+			"ASDFGHJKLÖÄ'",
+			">YXCVBNM;:_",
+		},
+		altgr: []string{
+			"  ²³   {[]}\\",
+			"@ €        ~",
+			"",
+			"|      µ",
+		},
+	},
+	"fr": {
+		normal: []string{
+			"²&é\"'(-è_çà)=",
+			"azertyuiop^$",
+			"qsdfghjklmù*",
+			"<wxcvbn,;:!",
+		},
+		shift: []string{
+			" 1234567890°+",
+			"AZERTYUIOP¨£",
+			"QSDFGHJKLM%µ",
+			">WXCVBN?./§",
+		},
+		altgr: []string{
+			"  ~#{[|`\\^@]}",
+			"  €        ¤",
+		},
+	},
+	"uk": {
+		normal: []string{
+			"`1234567890-=",
+			"qwertyuiop[]",
+			"asdfghjkl;'#",
+			"\\zxcvbnm,./",
+		},
+		shift: []string{
+			"¬!\"£$%^&*()_+",
+			"QWERTYUIOP{}",
+			"ASDFGHJKL:@~",
+			"|ZXCVBNM<>?",
+		},
+		altgr: []string{
+			"¦   €",
+		},
+	},
+	"es": {
+		normal: []string{
+			"º1234567890'¡",
+			"qwertyuiop`+",
+			"asdfghjklñ´ç",
+			"<zxcvbnm,.-",
+		},
+		shift: []string{
+			"ª!\"·$%&/()=?¿",
+			"QWERTYUIOP^*",
+			"ASDFGHJKLÑ¨Ç",
+			">ZXCVBNM;:_",
+		},
+		altgr: []string{
+			"\\|@#~€¬",
+			"  €       []",
+			"          {}",
+		},
+	},
+	"it": {
+		normal: []string{
+			"\\1234567890'ì",
+			"qwertyuiopè+",
+			"asdfghjklòàù",
+			"<zxcvbnm,.-",
+		},
+		shift: []string{
+			"|!\"£$%&/()=?^",
+			"QWERTYUIOPé*",
+			"ASDFGHJKLç°§",
+			">ZXCVBNM;:_",
+		},
+		altgr: []string{
+			"",
+			"  €       []",
+			"         @#",
+		},
+	},
+}
+
+// Locales of tokens and the layout they use, US locales need no translation
+var keyLayoutLocales = map[string]string{
+	"de":    "de",
+	"de-de": "de",
+	"de-at": "de",
+	"fr":    "fr",
+	"fr-fr": "fr",
+	"uk":    "uk",
+	"gb":    "uk",
+	"en-gb": "uk",
+	"en-uk": "uk",
+	"es":    "es",
+	"es-es": "es",
+	"it":    "it",
+	"it-it": "it",
+}
+
+// Keysyms browsers send for characters which have a keysym of their own
+// instead of the one derived from the code point
+var legacyKeysyms = map[uint32]rune{
+	0x20ac: '€',
+	0xfe50: '`', // dead_grave
+	0xfe51: '´', // dead_acute
+	0xfe52: '^', // dead_circumflex
+	0xfe53: '~', // dead_tilde
+	0xfe57: '¨', // dead_diaeresis
+}
+
+const (
+	keysymShiftR          = 0xffe2
+	keysymAltR            = 0xffea
+	keysymModeSwitch      = 0xff7e
+	keysymIsoLevel3Shift  = 0xfe03
+	keysymUnicodeFlag     = 0x01000000
+	keysymUnicodeMaxValue = 0x0110ffff
+)
+
+// The US key and modifiers which type a character on the guest
+type keyTarget struct {
+	keysym uint32
+	shift  bool
+	altgr  bool
+}
+
+type keyLayout struct {
+	name    string
+	targets map[rune]keyTarget
+}
+
+var keyLayouts = make(map[string]*keyLayout)
+
+func init() {
+	usTargets := layoutTargets(usLayout)
+
+	for name, rows := range keyLayoutDefinitions {
+		layout := &keyLayout{name: name, targets: make(map[rune]keyTarget)}
+
+		//only characters which the US layout would get wrong
+		for char, target := range layoutTargets(rows) {
+			if usTarget, ok := usTargets[char]; !ok || usTarget != target {
+				layout.targets[char] = target
+			}
+		}
+		keyLayouts[name] = layout
+	}
+}
+
+func layoutTargets(rows keyLayoutRows) map[rune]keyTarget {
+	targets := make(map[rune]keyTarget)
+
+	levels := []struct {
+		rows         []string
+		shift, altgr bool
+	}{
+		//later levels do not replace a character of an earlier one
+		{rows.normal, false, false},
+		{rows.shift, true, false},
+		{rows.altgr, false, true},
+	}
+
+	for _, level := range levels {
+		for i, row := range level.rows {
+			keys := []rune(usKeyRows[i])
+			for j, char := range []rune(row) {
+				if char == ' ' || j >= len(keys) {
+					continue
+				}
+				if _, ok := targets[char]; !ok {
+					targets[char] = keyTarget{uint32(keys[j]), level.shift, level.altgr}
+				}
+			}
+		}
+	}
+
+	return targets
+}
+
+// Returns the layout for the locale of a token, nil if none is needed or
+// the locale is unknown
+func keyLayoutForLocale(locale string) *keyLayout {
+	locale = strings.ToLower(strings.Replace(locale, "_", "-", -1))
+	return keyLayouts[keyLayoutLocales[locale]]
+}
+
+// Returns what the guest needs to type the character of keysym
+func (l *keyLayout) target(keysym uint32) (keyTarget, bool) {
+	char, ok := legacyKeysyms[keysym]
+	switch {
+	case ok:
+	case keysym >= 0x20 && keysym <= 0xff:
+		char = rune(keysym)
+	case keysym&keysymUnicodeFlag != 0 && keysym <= keysymUnicodeMaxValue:
+		char = rune(keysym &^ keysymUnicodeFlag)
+	default:
+		return keyTarget{}, false
+	}
+
+	target, ok := l.targets[char]
+	return target, ok
+}
+
+// Types text on a guest with layout l, holding the modifiers each
+// character needs. Characters the layout does not know are typed as on a
+// US keyboard.
+func (l *keyLayout) textKeys(text string) []keyEvent {
+	var events []keyEvent
+
+	for _, r := range text {
+		target, ok := l.target(runeKeysym(r))
+		if !ok {
+			events = append(events, textKeys(string(r))...)
+			continue
+		}
+
+		if target.shift {
+			events = append(events, keyEvent{true, keysymShift})
+		}
+		if target.altgr {
+			events = append(events, keyEvent{true, keysymAltR})
+		}
+		events = append(events, keyEvent{true, target.keysym}, keyEvent{false, target.keysym})
+		if target.altgr {
+			events = append(events, keyEvent{false, keysymAltR})
+		}
+		if target.shift {
+			events = append(events, keyEvent{false, keysymShift})
+		}
+	}
+
+	return events
+}
+
+// Moves the characters of combos, like the z of ctrl-z, to the keys which
+// type them on a guest with layout l. Named keys are left alone.
+func (l *keyLayout) comboKeys(events []keyEvent) []keyEvent {
+	for i, event := range events {
+		if target, ok := l.target(event.Keysym); ok {
+			events[i].Keysym = target.keysym
+		}
+	}
+	return events
+}
+
+// Rewrites the key events of one browser for a guest layout. Keeps track
+// of the modifiers the browser holds so they can be released or pressed
+// around keys which need a different state on the guest.
+type keyTranslator struct {
+	layout  *keyLayout
+	shift   uint32 // keysym of the shift key held by the browser, 0 if none
+	altgr   bool
+	pressed map[uint32]uint32 // keysym from the browser to the one sent
+}
+
+func newKeyTranslator(layout *keyLayout) *keyTranslator {
+	return &keyTranslator{layout: layout, pressed: make(map[uint32]uint32)}
+}
+
+func keyEventMessage(down bool, keysym uint32) []byte {
+	msg := []byte{rfbKeyEvent, 0, 0, 0, 0, 0, 0, 0}
+	if down {
+		msg[1] = 1
+	}
+	binary.BigEndian.PutUint32(msg[4:], keysym)
+	return msg
+}
+
+// Returns the messages sent to xenserver for a KeyEvent of the browser
+func (t *keyTranslator) Translate(msg []byte) []byte {
+	down := msg[1] != 0
+	keysym := binary.BigEndian.Uint32(msg[4:8])
+
+	switch keysym {
+	case keysymShift, keysymShiftR:
+		if down {
+			t.shift = keysym
+		} else if t.shift == keysym {
+			t.shift = 0
+		}
+		return msg
+
+	case keysymIsoLevel3Shift, keysymModeSwitch, keysymAltR:
+		//the US layout has no AltGr, the guest takes right alt for it
+		t.altgr = down
+		return keyEventMessage(down, keysymAltR)
+	}
+
+	if !down {
+		sent, ok := t.pressed[keysym]
+		if !ok {
+			return msg
+		}
+		delete(t.pressed, keysym)
+		return keyEventMessage(false, sent)
+	}
+
+	target, ok := t.layout.target(keysym)
+	if !ok {
+		return msg
+	}
+	t.pressed[keysym] = target.keysym
+
+	var out, restore []byte
+
+	if target.shift && t.shift == 0 {
+		out = append(out, keyEventMessage(true, keysymShift)...)
+		restore = append(restore, keyEventMessage(false, keysymShift)...)
+	} else if !target.shift && t.shift != 0 {
+		out = append(out, keyEventMessage(false, t.shift)...)
+		restore = append(restore, keyEventMessage(true, t.shift)...)
+	}
+
+	if target.altgr != t.altgr {
+		out = append(out, keyEventMessage(target.altgr, keysymAltR)...)
+		restore = append(restore, keyEventMessage(!target.altgr, keysymAltR)...)
+	}
+
+	out = append(out, keyEventMessage(true, target.keysym)...)
+	return append(out, restore...)
+}
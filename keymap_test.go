@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+)
+
+func keyMessages(events ...keyEvent) []byte {
+	var msgs []byte
+	for _, event := range events {
+		msgs = append(msgs, keyEventMessage(event.Down, event.Keysym)...)
+	}
+	return msgs
+}
+
+func TestKeyLayoutForLocale(t *testing.T) {
+	if layout := keyLayoutForLocale("de_DE"); layout == nil || layout.name != "de" {
+		t.Error("Expected the German layout Got:", layout)
+	}
+
+	for _, locale := range []string{"", "en-us", "xx"} {
+		if layout := keyLayoutForLocale(locale); layout != nil {
+			t.Errorf("Expected no layout for %q Got: %v", locale, layout.name)
+		}
+	}
+}
+
+func TestLocaleFromToken(t *testing.T) {
+	key := "kV9Ld-X4rKlTQF4ZJwyn9A"
+	iv := "PCb_WQYrUgbahQeqDEkuUw"
+	token, _ := encrypt(key, iv, `{"locale":"fr","clientTunnelUrl":"https://172.31.0.46/console"}`)
+
+	session, err := NewConsoleSession(key, iv, token)
+	if err != nil || session.Locale != "fr" {
+		t.Fatal("Expected the locale of the token Got:", session, err)
+	}
+}
+
+func TestTranslateGermanKeys(t *testing.T) {
+	keys := newKeyTranslator(keyLayoutForLocale("de"))
+
+	//z and y are swapped
+	if msg := keys.Translate(keyEventMessage(true, 'z')); !bytes.Equal(msg, keyEventMessage(true, 'y')) {
+		t.Errorf("Expected y to be pressed Got: %v", msg)
+	}
+	if msg := keys.Translate(keyEventMessage(false, 'z')); !bytes.Equal(msg, keyEventMessage(false, 'y')) {
+		t.Errorf("Expected y to be released Got: %v", msg)
+	}
+
+	//letters at the same place are left alone
+	if msg := keys.Translate(keyEventMessage(true, 'a')); !bytes.Equal(msg, keyEventMessage(true, 'a')) {
+		t.Errorf("Expected a Got: %v", msg)
+	}
+
+	//@ is AltGr+q on the guest
+	expected := keyMessages(keyEvent{true, keysymAltR}, keyEvent{true, 'q'}, keyEvent{false, keysymAltR})
+	if msg := keys.Translate(keyEventMessage(true, '@')); !bytes.Equal(msg, expected) {
+		t.Errorf("Expected AltGr+q Got: %v", msg)
+	}
+
+	//_ is shift and the key right of ., which the browser already holds
+	keys.Translate(keyEventMessage(true, keysymShift))
+	if msg := keys.Translate(keyEventMessage(true, '_')); !bytes.Equal(msg, keyMessages(keyEvent{true, '/'})) {
+		t.Errorf("Expected shift+/ Got: %v", msg)
+	}
+	keys.Translate(keyEventMessage(false, keysymShift))
+	if msg := keys.Translate(keyEventMessage(true, '-')); !bytes.Equal(msg, keyEventMessage(true, '/')) {
+		t.Errorf("Expected / Got: %v", msg)
+	}
+}
+
+func TestTranslateReleasesShift(t *testing.T) {
+	keys := newKeyTranslator(keyLayoutForLocale("uk"))
+
+	//# is shift+3 in the browser and its own key on a UK guest
+	keys.Translate(keyEventMessage(true, keysymShift))
+	expected := keyMessages(keyEvent{false, keysymShift}, keyEvent{true, '\\'}, keyEvent{true, keysymShift})
+	if msg := keys.Translate(keyEventMessage(true, '#')); !bytes.Equal(msg, expected) {
+		t.Errorf("Expected # without shift Got: %v", msg)
+	}
+}
+
+func TestFilterRfbTranslatesKeyEvents(t *testing.T) {
+	proxy := &ProxyServer{keys: newKeyTranslator(keyLayoutForLocale("de"))}
+	stream := rfbClientStream{state: rfbStateNormal}
+
+	extended := []byte{rfbQemuClientMessage, rfbQemuExtendedKeyEvent, 0, 1, 0, 0, 0, 'z', 0, 0, 0, 0x15}
+	data := append(append([]byte{}, extended...), keyEventMessage(true, 'z')...)
+
+	expected := append(append([]byte{}, extended...), keyEventMessage(true, 'y')...)
+	if filtered := proxy.filterRfb(&stream, data); !bytes.Equal(filtered, expected) {
+		t.Errorf("Expected: %v Got: %v", expected, filtered)
+	}
+}
+
+func TestLayoutTextKeys(t *testing.T) {
+	expected := []keyEvent{
+		{true, 'y'}, {false, 'y'},
+		{true, keysymAltR}, {true, 'q'}, {false, 'q'}, {false, keysymAltR},
+		{true, 'a'}, {false, 'a'},
+	}
+	if events := keyLayoutForLocale("de").textKeys("z@a"); !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected: %v Got: %v", expected, events)
+	}
+}
+
+func TestLayoutComboKeys(t *testing.T) {
+	expected := []keyEvent{
+		{true, 0xffe3}, {true, 'y'}, {false, 'y'}, {false, 0xffe3},
+		{true, 0xffbf}, {false, 0xffbf},
+	}
+	events, err := keysRequest{Keys: "ctrl-z f2"}.events(keyLayoutForLocale("de"))
+	if err != nil || !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected: %v Got: %v %v", expected, events, err)
+	}
+}
@@ -0,0 +1,423 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+	"unicode/utf8"
+
+	"github.com/sirupsen/logrus"
+)
+
+// X11 keysyms of the keys which can be named in a combo
+var keysymNames = map[string]uint32{
+	"ctrl":      0xffe3,
+	"control":   0xffe3,
+	"shift":     0xffe1,
+	"alt":       0xffe9,
+	"altgr":     0xfe03,
+	"meta":      0xffeb,
+	"super":     0xffeb,
+	"win":       0xffeb,
+	"del":       0xffff,
+	"delete":    0xffff,
+	"esc":       0xff1b,
+	"escape":    0xff1b,
+	"enter":     0xff0d,
+	"return":    0xff0d,
+	"tab":       0xff09,
+	"backspace": 0xff08,
+	"space":     0x0020,
+	"insert":    0xff63,
+	"home":      0xff50,
+	"end":       0xff57,
+	"pageup":    0xff55,
+	"pagedown":  0xff56,
+	"left":      0xff51,
+	"up":        0xff52,
+	"right":     0xff53,
+	"down":      0xff54,
+	"print":     0xff61,
+	"sysrq":     0xff15,
+	"pause":     0xff13,
+	"menu":      0xff67,
+}
+
+// Limits of a key request, typing them takes about a minute at the
+// default delay
+const (
+	maxKeysCombos = 64
+	maxKeysText   = 1024 // characters
+)
+
+const (
+	keysymShift     = 0xffe1
+	keysymReturn    = 0xff0d
+	keysymTab       = 0xff09
+	keysymBackspace = 0xff08
+	keysymF1        = 0xffbe
+)
+
+// Characters typed with shift on a US keyboard. Servers which map keysyms
+// to scancodes need the shift key pressed for them.
+const usShifted = `~!@#$%^&*()_+{}|:"<>?ABCDEFGHIJKLMNOPQRSTUVWXYZ`
+
+type keyEvent struct {
+	Down   bool
+	Keysym uint32
+}
+
+// What the key API types into a console: Keys are combos separated by
+// spaces, like "ctrl-alt-del" or "f12 down enter", Text is typed after them
+type keysRequest struct {
+	Keys string `json:"keys"`
+	Text string `json:"text"`
+}
+
+func keysymForName(name string) (uint32, error) {
+	if utf8.RuneCountInString(name) == 1 {
+		r, _ := utf8.DecodeRuneInString(name)
+		return runeKeysym(r), nil
+	}
+
+	name = strings.ToLower(name)
+	if keysym, ok := keysymNames[name]; ok {
+		return keysym, nil
+	}
+
+	if strings.HasPrefix(name, "f") {
+		if n, err := strconv.Atoi(name[1:]); err == nil && n >= 1 && n <= 12 {
+			return keysymF1 + uint32(n-1), nil
+		}
+	}
+
+	return 0, fmt.Errorf("unknown key %q", name)
+}
+
+// Keysyms of Latin-1 characters are their code points, everything else
+// uses the Unicode keysyms
+func runeKeysym(r rune) uint32 {
+	switch {
+	case r == '\n' || r == '\r':
+		return keysymReturn
+	case r == '\t':
+		return keysymTab
+	case r == '\b':
+		return keysymBackspace
+	case (r >= 0x20 && r <= 0x7e) || (r >= 0xa0 && r <= 0xff):
+		return uint32(r)
+	}
+	return 0x01000000 | uint32(r)
+}
+
+// Presses the keys of each combo in order and releases them in reverse
+func parseKeys(keys string) ([]keyEvent, error) {
+	var events []keyEvent
+
+	for _, combo := range strings.Fields(keys) {
+		names := []string{combo}
+		if utf8.RuneCountInString(combo) > 1 {
+			names = strings.FieldsFunc(combo, func(r rune) bool { return r == '-' || r == '+' })
+		}
+		if len(names) == 0 {
+			return nil, fmt.Errorf("invalid key combo %q", combo)
+		}
+
+		keysyms := make([]uint32, len(names))
+		for i, name := range names {
+			keysym, err := keysymForName(name)
+			if err != nil {
+				return nil, err
+			}
+			keysyms[i] = keysym
+		}
+
+		for _, keysym := range keysyms {
+			events = append(events, keyEvent{true, keysym})
+		}
+		for i := len(keysyms) - 1; i >= 0; i-- {
+			events = append(events, keyEvent{false, keysyms[i]})
+		}
+	}
+
+	return events, nil
+}
+
+// Types text one character at a time, holding shift where a US keyboard
+// needs it
+func textKeys(text string) []keyEvent {
+	var events []keyEvent
+
+	for _, r := range text {
+		keysym := runeKeysym(r)
+		shifted := strings.ContainsRune(usShifted, r)
+
+		if shifted {
+			events = append(events, keyEvent{true, keysymShift})
+		}
+		events = append(events, keyEvent{true, keysym}, keyEvent{false, keysym})
+		if shifted {
+			events = append(events, keyEvent{false, keysymShift})
+		}
+	}
+
+	return events
+}
+
+// Returns the key events of the request for a guest with layout, nil for
+// a US keyboard
+func (k keysRequest) events(layout *keyLayout) ([]keyEvent, error) {
+	events, err := parseKeys(k.Keys)
+	if err != nil {
+		return nil, err
+	}
+	if layout != nil {
+		return append(layout.comboKeys(events), layout.textKeys(k.Text)...), nil
+	}
+	return append(events, textKeys(k.Text)...), nil
+}
+
+// Writes RFB KeyEvent messages, waiting delay between them because some
+// servers drop keys which arrive too quickly
+func sendKeyEvents(w io.Writer, events []keyEvent, delay time.Duration) error {
+	for i, event := range events {
+		if i > 0 && delay > 0 {
+			time.Sleep(delay)
+		}
+		if _, err := w.Write(keyEventMessage(event.Down, event.Keysym)); err != nil {
+			return err
+		}
+	}
+
+	keyEventsTotal.Add(float64(len(events)))
+	return nil
+}
+
+var errConsoleNotReady = errors.New("the console is not ready for keys")
+
+// Writes each key event to xenserver on its own, so browser input goes in
+// between them instead of waiting for the whole request. Events wait while
+// a clipboard longer than rfbMaxClientMessage is passed on.
+type consoleInput struct {
+	proxyserver *ProxyServer
+}
+
+func (c consoleInput) Write(p []byte) (int, error) {
+	proxyserver := c.proxyserver
+
+	for {
+		proxyserver.input.Lock()
+		stream := &proxyserver.stream
+		if proxyserver.text || (stream.state == rfbStateNormal && stream.pending == 0) {
+			break
+		}
+		ready := stream.state == rfbStateNormal
+		proxyserver.input.Unlock()
+
+		if !ready || proxyserver.stopping() {
+			return 0, errConsoleNotReady
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+	defer proxyserver.input.Unlock()
+
+	return proxyserver.tlsConn.Write(p)
+}
+
+// Types into an open console, between messages of the browser. Key
+// requests type one after another.
+func (proxyserver *ProxyServer) TypeKeys(req keysRequest) error {
+	proxyserver.typing.Lock()
+	defer proxyserver.typing.Unlock()
+
+	var err error
+	var sent int
+
+	if proxyserver.text {
+		sent = len(req.Text)
+		_, err = io.WriteString(consoleInput{proxyserver}, req.Text)
+	} else {
+		var layout *keyLayout
+		if proxyserver.keys != nil {
+			layout = proxyserver.keys.layout
+		}
+
+		events, _ := req.events(layout)
+		sent = 8 * len(events)
+		err = sendKeyEvents(consoleInput{proxyserver}, events, cfg.Keys.GetDelay())
+	}
+
+	//before the handshake or after the browser left
+	if err == errConsoleNotReady {
+		return err
+	}
+	if err != nil {
+		proxyserver.Stop(causeXenError(err))
+		return err
+	}
+
+	atomic.AddInt64(&proxyserver.bytesToServer, int64(sent))
+	return nil
+}
+
+// Types into a console through a tunnel of its own
+func typeKeysFresh(session *ConsoleSession, req keysRequest) error {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Keys.GetTimeout())
+	defer cancel()
+
+	ctx, span := tracer.Start(ctx, "console.keys")
+	defer span.End()
+
+	logger := log.WithFields(logrus.Fields{
+		"vm":       session.ConsoleUuid(),
+		"xen_host": session.XenHost(),
+	})
+
+	conn, err := initXenConnection(ctx, session, logger)
+	if err != nil {
+		failSpan(span, "Unable to open the xenserver tunnel", err)
+		return err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	if session.IsText() {
+		_, err = io.WriteString(conn, req.Text)
+		return err
+	}
+
+	if _, err := newRfbClient(conn, session.ClientHostPassword); err != nil {
+		failSpan(span, "RFB handshake failed", err)
+		return err
+	}
+
+	//typing takes as long as it takes, the timeout is for xenserver
+	events, _ := req.events(keyLayoutForLocale(session.Locale))
+	conn.SetDeadline(time.Now().Add(cfg.Keys.GetTimeout() + time.Duration(len(events))*cfg.Keys.GetDelay()))
+
+	if err := sendKeyEvents(conn, events, cfg.Keys.GetDelay()); err != nil {
+		failSpan(span, "Unable to send the keys", err)
+		return err
+	}
+	return nil
+}
+
+// Reads and checks the body of a key request for session
+func readKeysRequest(w http.ResponseWriter, r *http.Request, session *ConsoleSession) (keysRequest, bool) {
+	var req keysRequest
+
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return req, false
+	}
+
+	if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, 64<<10)).Decode(&req); err != nil {
+		http.Error(w, "Invalid key request: "+err.Error(), http.StatusBadRequest)
+		return req, false
+	}
+
+	if req.Keys == "" && req.Text == "" {
+		http.Error(w, "keys or text is required", http.StatusBadRequest)
+		return req, false
+	}
+
+	if len(strings.Fields(req.Keys)) > maxKeysCombos || utf8.RuneCountInString(req.Text) > maxKeysText {
+		http.Error(w, fmt.Sprintf("At most %d combos and %d characters of text can be sent at once", maxKeysCombos, maxKeysText), http.StatusBadRequest)
+		return req, false
+	}
+
+	if session.IsText() {
+		if req.Keys != "" {
+			http.Error(w, "Text consoles only take text", http.StatusBadRequest)
+			return req, false
+		}
+	} else if _, err := parseKeys(req.Keys); err != nil {
+		http.Error(w, "Invalid key request: "+err.Error(), http.StatusBadRequest)
+		return req, false
+	}
+
+	return req, true
+}
+
+// Sends the keys into the open console of sessionID if there is one and
+// through a new tunnel otherwise
+func writeKeys(w http.ResponseWriter, r *http.Request, sessionID string, session *ConsoleSession, req keysRequest) {
+	logger := requestLogger(r).WithFields(logrus.Fields{
+		"vm": session.ConsoleUuid(),
+	})
+
+	var err error
+	if proxy := SessionMap.Proxy(sessionID); proxy != nil {
+		err = proxy.TypeKeys(req)
+	} else {
+		err = typeKeysFresh(session, req)
+	}
+
+	if err == errConsoleNotReady {
+		http.Error(w, "The console is still connecting", http.StatusConflict)
+		return
+	}
+	if err != nil {
+		logger.WithFields(logrus.Fields{
+			"error": err,
+		}).Warn("Unable to send keys")
+
+		http.Error(w, "Unable to send keys", http.StatusBadGateway)
+		return
+	}
+
+	logger.WithFields(logrus.Fields{
+		"session_id": sessionID,
+		"keys":       req.Keys,
+		"characters": utf8.RuneCountInString(req.Text),
+	}).Info("Keys sent to the console")
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// Types into the console a management server token is for, through a new
+// tunnel. The token is not redeemed.
+func handleKeys(w http.ResponseWriter, r *http.Request) {
+	session := sessionFromToken(w, r)
+	if session == nil {
+		return
+	}
+
+	if session.ViewOnly {
+		http.Error(w, "The token is view-only", http.StatusForbidden)
+		return
+	}
+
+	req, ok := readKeysRequest(w, r, session)
+	if !ok {
+		return
+	}
+
+	writeKeys(w, r, "", session, req)
+}
+
+// Types into a session of the admin API, into its open console if the
+// browser is connected
+func handleAdminSessionKeys(w http.ResponseWriter, r *http.Request, id string) {
+	session := SessionMap.Get(id)
+	if session == nil {
+		http.Error(w, "Unable to find session", http.StatusNotFound)
+		return
+	}
+
+	req, ok := readKeysRequest(w, r, session)
+	if !ok {
+		return
+	}
+
+	writeKeys(w, r, id, session, req)
+}
@@ -0,0 +1,128 @@
+package main
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestParseKeys(t *testing.T) {
+	events, err := parseKeys("ctrl-alt-del F12")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []keyEvent{
+		{true, 0xffe3}, {true, 0xffe9}, {true, 0xffff},
+		{false, 0xffff}, {false, 0xffe9}, {false, 0xffe3},
+		{true, 0xffc9}, {false, 0xffc9},
+	}
+	if !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected: %v Got: %v", expected, events)
+	}
+
+	for _, keys := range []string{"ctrl-alt-delet", "f13", "--"} {
+		if _, err := parseKeys(keys); err == nil {
+			t.Errorf("Expected %q to be refused", keys)
+		}
+	}
+}
+
+func TestTextKeys(t *testing.T) {
+	expected := []keyEvent{
+		{true, keysymShift}, {true, 'R'}, {false, 'R'}, {false, keysymShift},
+		{true, 'o'}, {false, 'o'},
+		{true, 0xe9}, {false, 0xe9},
+		{true, 0x010020ac}, {false, 0x010020ac},
+		{true, keysymReturn}, {false, keysymReturn},
+	}
+	if events := textKeys("Roé€\n"); !reflect.DeepEqual(events, expected) {
+		t.Errorf("Expected: %v Got: %v", expected, events)
+	}
+}
+
+func TestSendKeyEvents(t *testing.T) {
+	var buf bytes.Buffer
+	sendKeyEvents(&buf, []keyEvent{{true, 0xffe3}, {false, 0xffe3}}, 0)
+
+	expected := []byte{4, 1, 0, 0, 0, 0, 0xff, 0xe3, 4, 0, 0, 0, 0, 0, 0xff, 0xe3}
+	if !bytes.Equal(buf.Bytes(), expected) {
+		t.Errorf("Expected: %v Got: %v", expected, buf.Bytes())
+	}
+}
+
+func TestProxyTypeKeys(t *testing.T) {
+	SessionMap.Put("keys", &ConsoleSession{})
+	defer SessionMap.Delete("keys")
+
+	browser, backend, proxies := newTestProxy(t, "keys")
+	proxy := <-proxies
+
+	if err := proxy.TypeKeys(keysRequest{Keys: "ctrl-alt-del"}); err != errConsoleNotReady {
+		t.Fatal("Expected keys to wait for the handshake Got:", err)
+	}
+
+	//version, security type None and a shared ClientInit
+	browser.WriteMessage(websocket.BinaryMessage, []byte("RFB 003.008\n\x01\x01"))
+	backend.SetReadDeadline(time.Now().Add(time.Second))
+	if _, err := io.ReadFull(backend, make([]byte, 14)); err != nil {
+		t.Fatal("Expected the handshake Got:", err)
+	}
+
+	errs := make(chan error, 1)
+	go func() { errs <- proxy.TypeKeys(keysRequest{Keys: "ctrl-alt-del"}) }()
+
+	events := make([]byte, 6*8)
+	if _, err := io.ReadFull(backend, events); err != nil {
+		t.Fatal("Expected the key events Got:", err)
+	}
+	if err := <-errs; err != nil {
+		t.Error("Unable to type keys:", err)
+	}
+	if events[0] != rfbKeyEvent || events[1] != 1 || events[7] != 0xe3 || events[40] != rfbKeyEvent || events[41] != 0 {
+		t.Errorf("Expected ctrl-alt-del Got: %v", events)
+	}
+
+	//the browser types between the key events of a request
+	delay := cfg.Keys.Delay
+	defer func() { cfg.Keys.Delay = delay }()
+	cfg.Keys.Delay = 200
+
+	go func() { errs <- proxy.TypeKeys(keysRequest{Keys: "a"}) }()
+	if _, err := io.ReadFull(backend, events[:8]); err != nil || events[7] != 'a' {
+		t.Fatal("Expected the key press Got:", err, events[:8])
+	}
+	browser.WriteMessage(websocket.BinaryMessage, keyEventMessage(true, 'b'))
+	if _, err := io.ReadFull(backend, events[:8]); err != nil || events[7] != 'b' {
+		t.Error("Expected the browser to go first Got:", err, events[:8])
+	}
+	if _, err := io.ReadFull(backend, events[:8]); err != nil || events[1] != 0 {
+		t.Error("Expected the key release Got:", err, events[:8])
+	}
+	if err := <-errs; err != nil {
+		t.Error("Unable to type keys:", err)
+	}
+}
+
+func TestKeysRequestLimits(t *testing.T) {
+	SessionMap.Put("limits", &ConsoleSession{})
+	defer SessionMap.Delete("limits")
+
+	for _, body := range []string{
+		`{"keys": "` + strings.Repeat("a ", maxKeysCombos+1) + `"}`,
+		`{"text": "` + strings.Repeat("é", maxKeysText+1) + `"}`,
+	} {
+		w := httptest.NewRecorder()
+		handleAdminSessionKeys(w, httptest.NewRequest("POST", "/sessions/limits/keys", strings.NewReader(body)), "limits")
+		if w.Code != http.StatusBadRequest {
+			t.Error("Expected the request to be too long Got:", w.Code)
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package main
+
+import (
+	"sync"
+	"time"
+)
+
+// Values of the limit label of limitedSessions
+const (
+	limitTotal  = "total"
+	limitHost   = "host"
+	limitClient = "client"
+)
+
+// A console refused because of a connection limit. The message is sent to
+// the browser as the websocket close reason.
+type limitError struct {
+	limit   string
+	message string
+}
+
+func (e *limitError) Error() string {
+	return e.message
+}
+
+var (
+	errTooManyConsoles       = &limitError{limitTotal, "Too many consoles are open, please try again later"}
+	errTooManyHostConsoles   = &limitError{limitHost, "Too many consoles are open on this host, please try again later"}
+	errTooManyClientConsoles = &limitError{limitClient, "You have too many consoles open, close one and try again"}
+)
+
+// Counts the consoles per xenserver and per client IP. A session holds a
+// single slot, a browser taking over the console from another window
+// shares the slot of the window it replaces.
+type sessionLimiter struct {
+	lock      sync.Mutex
+	total     int
+	perHost   map[string]int
+	perClient map[string]int
+	slots     map[string]*sessionSlot
+	released  chan struct{} // closed and replaced whenever a slot is freed
+}
+
+type sessionSlot struct {
+	host   string
+	client string
+	refs   int
+}
+
+var consoleLimits = newSessionLimiter()
+
+func newSessionLimiter() *sessionLimiter {
+	return &sessionLimiter{
+		perHost:   make(map[string]int),
+		perClient: make(map[string]int),
+		slots:     make(map[string]*sessionSlot),
+		released:  make(chan struct{}),
+	}
+}
+
+// Takes a slot for the session, waiting up to wait for one to become free.
+// The returned function gives the slot back and may be called more than
+// once.
+func (l *sessionLimiter) Acquire(sessionID, host, client string, wait time.Duration) (func(), error) {
+	deadline := time.Now().Add(wait)
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	for {
+		slot := l.slots[sessionID]
+		if slot == nil {
+			if err := l.check(host, client); err != nil {
+				remaining := time.Until(deadline)
+				if remaining <= 0 {
+					return nil, err
+				}
+
+				released := l.released
+				l.lock.Unlock()
+				select {
+				case <-released:
+				case <-time.After(remaining):
+				}
+				l.lock.Lock()
+				continue
+			}
+
+			slot = &sessionSlot{host: host, client: client}
+			l.slots[sessionID] = slot
+			l.total++
+			l.perHost[host]++
+			l.perClient[client]++
+		}
+
+		slot.refs++
+		var once sync.Once
+		return func() { once.Do(func() { l.release(sessionID) }) }, nil
+	}
+}
+
+// Must be called with the lock held, limits of 0 are disabled
+func (l *sessionLimiter) check(host, client string) error {
+	limits := cfg.Limits
+
+	//the narrowest limit first, it tells the user best what to do
+	if limits.MaxPerClient > 0 && l.perClient[client] >= limits.MaxPerClient {
+		return errTooManyClientConsoles
+	}
+	if limits.MaxPerHost > 0 && l.perHost[host] >= limits.MaxPerHost {
+		return errTooManyHostConsoles
+	}
+	if limits.MaxSessions > 0 && l.total >= limits.MaxSessions {
+		return errTooManyConsoles
+	}
+	return nil
+}
+
+func (l *sessionLimiter) release(sessionID string) {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	slot := l.slots[sessionID]
+	if slot == nil {
+		return
+	}
+
+	slot.refs--
+	if slot.refs > 0 {
+		return
+	}
+
+	delete(l.slots, sessionID)
+	l.total--
+	l.perHost[slot.host]--
+	if l.perHost[slot.host] <= 0 {
+		delete(l.perHost, slot.host)
+	}
+	l.perClient[slot.client]--
+	if l.perClient[slot.client] <= 0 {
+		delete(l.perClient, slot.client)
+	}
+
+	close(l.released)
+	l.released = make(chan struct{})
+}
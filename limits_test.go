@@ -0,0 +1,70 @@
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSessionLimits(t *testing.T) {
+	defer func(previous configLimits) { cfg.Limits = previous }(cfg.Limits)
+	cfg.Limits = configLimits{MaxSessions: 3, MaxPerHost: 2, MaxPerClient: 1}
+
+	l := newSessionLimiter()
+
+	releaseA, err := l.Acquire("a", "172.31.0.46", "10.0.0.1", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := l.Acquire("b", "172.31.0.46", "10.0.0.1", 0); err != errTooManyClientConsoles {
+		t.Error("Expected the client limit Got:", err)
+	}
+
+	//taking over the console in another window shares the slot
+	releaseA2, err := l.Acquire("a", "172.31.0.46", "10.0.0.1", 0)
+	if err != nil {
+		t.Error("Expected the same session to get its slot Got:", err)
+	}
+
+	l.Acquire("c", "172.31.0.46", "10.0.0.2", 0)
+	if _, err := l.Acquire("d", "172.31.0.46", "10.0.0.3", 0); err != errTooManyHostConsoles {
+		t.Error("Expected the host limit Got:", err)
+	}
+
+	l.Acquire("e", "172.31.0.47", "10.0.0.4", 0)
+	if _, err := l.Acquire("f", "172.31.0.48", "10.0.0.5", 0); err != errTooManyConsoles {
+		t.Error("Expected the total limit Got:", err)
+	}
+
+	releaseA()
+	releaseA()
+	if _, err := l.Acquire("b", "172.31.0.46", "10.0.0.1", 0); err != errTooManyClientConsoles {
+		t.Error("Expected the slot to be held by the other window Got:", err)
+	}
+
+	releaseA2()
+	if _, err := l.Acquire("b", "172.31.0.46", "10.0.0.1", 0); err != nil {
+		t.Error("Expected the slot to be free Got:", err)
+	}
+}
+
+func TestSessionLimitQueue(t *testing.T) {
+	defer func(previous configLimits) { cfg.Limits = previous }(cfg.Limits)
+	cfg.Limits = configLimits{MaxSessions: 1}
+
+	l := newSessionLimiter()
+	release, _ := l.Acquire("a", "172.31.0.46", "10.0.0.1", 0)
+
+	if _, err := l.Acquire("b", "172.31.0.46", "10.0.0.2", 50*time.Millisecond); err != errTooManyConsoles {
+		t.Fatal("Expected to give up after the queue timeout Got:", err)
+	}
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		release()
+	}()
+
+	if _, err := l.Acquire("b", "172.31.0.46", "10.0.0.2", time.Second); err != nil {
+		t.Error("Expected to get the slot once it was freed Got:", err)
+	}
+}
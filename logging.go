@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/syslog"
+	"os"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	logrus_syslog "github.com/sirupsen/logrus/hooks/syslog"
+)
+
+// Guards the current log configuration and the output which has to be
+// closed when it is replaced
+var logLock sync.Mutex
+var currentLog configLog
+var currentLogOutput io.Closer
+
+// Applies level, format and output to the application log. The access log
+// keeps its JSON format and info level but follows the output.
+func applyLogConfig(c configLog) error {
+	level, err := logrus.ParseLevel(c.Level)
+	if err != nil {
+		return err
+	}
+
+	var formatter logrus.Formatter
+	switch c.Format {
+	case "", "text":
+		formatter = &logrus.TextFormatter{}
+	case "json":
+		formatter = &logrus.JSONFormatter{}
+	default:
+		return fmt.Errorf("unknown log format %q", c.Format)
+	}
+
+	//secrets have to be gone before any other hook sees the entry
+	hooks := make(logrus.LevelHooks)
+	hooks.Add(redactHook{})
+
+	var out, accessOut io.Writer
+	var closer io.Closer
+
+	switch c.Output {
+	case "", "stdout":
+		out, accessOut = os.Stdout, os.Stdout
+	case "stderr":
+		out, accessOut = os.Stderr, os.Stderr
+	case "file":
+		file, err := openRotatingFile(c.File, int64(c.MaxSize)*1024*1024, c.MaxBackups)
+		if err != nil {
+			return err
+		}
+		out, accessOut, closer = file, file, file
+	case "syslog":
+		//an empty socket picks /dev/log, which journald listens on too
+		network := ""
+		if c.SyslogSocket != "" {
+			network = "unixgram"
+		}
+		hook, err := logrus_syslog.NewSyslogHook(network, c.SyslogSocket, syslog.LOG_INFO|syslog.LOG_DAEMON, c.SyslogTag)
+		if err != nil {
+			return err
+		}
+		//the hook writes with the priority of the entry's level
+		hooks.Add(hook)
+		out, accessOut, closer = ioutil.Discard, hook.Writer, hook.Writer
+	default:
+		return fmt.Errorf("unknown log output %q", c.Output)
+	}
+
+	logLock.Lock()
+	defer logLock.Unlock()
+
+	//the setters take the lock of the logger, other goroutines are logging
+	log.SetLevel(level)
+	log.SetFormatter(formatter)
+	log.ReplaceHooks(hooks)
+	log.SetOutput(out)
+	accessLog.SetOutput(accessOut)
+
+	if currentLogOutput != nil {
+		currentLogOutput.Close()
+	}
+	currentLogOutput = closer
+	currentLog = c
+
+	return nil
+}
+
+func getLogConfig() configLog {
+	logLock.Lock()
+	defer logLock.Unlock()
+	return currentLog
+}
+
+// A log file which is rotated to path.1, path.2, ... once it grows beyond
+// maxSize bytes, keeping at most maxBackups old files
+type rotatingFile struct {
+	lock       sync.Mutex
+	path       string
+	maxSize    int64
+	maxBackups int
+	file       *os.File
+	size       int64
+}
+
+func openRotatingFile(path string, maxSize int64, maxBackups int) (*rotatingFile, error) {
+	if path == "" {
+		return nil, fmt.Errorf("no log file configured")
+	}
+
+	f := &rotatingFile{path: path, maxSize: maxSize, maxBackups: maxBackups}
+	if err := f.open(); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+func (f *rotatingFile) open() error {
+	file, err := os.OpenFile(f.path, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0640)
+	if err != nil {
+		return err
+	}
+
+	info, err := file.Stat()
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	f.file = file
+	f.size = info.Size()
+	return nil
+}
+
+func (f *rotatingFile) Write(p []byte) (int, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	//a write racing with a reconfiguration is dropped
+	if f.file == nil {
+		return len(p), nil
+	}
+
+	if f.maxSize > 0 && f.size > 0 && f.size+int64(len(p)) > f.maxSize {
+		if err := f.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := f.file.Write(p)
+	f.size += int64(n)
+	return n, err
+}
+
+func (f *rotatingFile) rotate() error {
+	f.file.Close()
+	f.file = nil
+
+	if f.maxBackups > 0 {
+		os.Remove(fmt.Sprintf("%s.%d", f.path, f.maxBackups))
+		for i := f.maxBackups - 1; i > 0; i-- {
+			os.Rename(fmt.Sprintf("%s.%d", f.path, i), fmt.Sprintf("%s.%d", f.path, i+1))
+		}
+		os.Rename(f.path, f.path+".1")
+	} else {
+		os.Remove(f.path)
+	}
+
+	return f.open()
+}
+
+func (f *rotatingFile) Close() error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if f.file == nil {
+		return nil
+	}
+	err := f.file.Close()
+	f.file = nil
+	return err
+}
@@ -0,0 +1,91 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRotatingFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "logging")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "proxy.log")
+	file, err := openRotatingFile(path, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+
+	for _, line := range []string{"first\n", "second\n", "third\n", "fourth\n"} {
+		if _, err := file.Write([]byte(line)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	expected := map[string]string{
+		path:        "fourth\n",
+		path + ".1": "third\n",
+		path + ".2": "second\n",
+	}
+	for name, content := range expected {
+		data, _ := ioutil.ReadFile(name)
+		if string(data) != content {
+			t.Errorf("File %s Expected: %q Got: %q", name, content, data)
+		}
+	}
+
+	if _, err := os.Stat(path + ".3"); !os.IsNotExist(err) {
+		t.Error("Expected only 2 backups to be kept")
+	}
+}
+
+func TestAdminChangesLogConfig(t *testing.T) {
+	handler := newAdminHandler("secret")
+
+	defer applyLogConfig(cfg.Log)
+
+	r := httptest.NewRequest("PUT", "/log", bytes.NewBufferString(`{"level": "debug", "format": "json", "output": "stderr"}`))
+	r.Header.Set("Authorization", "Bearer secret")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusOK {
+		t.Fatal("Expected 200 Got:", w.Code, w.Body.String())
+	}
+	if log.GetLevel() != logrus.DebugLevel {
+		t.Error("Expected the debug level Got:", log.GetLevel())
+	}
+	if _, ok := log.Formatter.(*logrus.JSONFormatter); !ok {
+		t.Errorf("Expected the JSON formatter Got: %T", log.Formatter)
+	}
+
+	w = adminRequest(handler, "GET", "/log", "secret")
+	var config configLog
+	json.NewDecoder(w.Body).Decode(&config)
+	if config.Output != "stderr" || config.Level != "debug" {
+		t.Errorf("Expected the new configuration Got: %+v", config)
+	}
+
+	r = httptest.NewRequest("PUT", "/log", bytes.NewBufferString(`{"format": "xml"}`))
+	r.Header.Set("Authorization", "Bearer secret")
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+
+	if w.Code != http.StatusBadRequest {
+		t.Error("Expected an unknown format to be rejected Got:", w.Code)
+	}
+	if getLogConfig().Format != "json" {
+		t.Error("Expected a rejected change to keep the configuration")
+	}
+}
@@ -2,17 +2,24 @@ package main
 
 import (
 	"bufio"
+	"context"
 	"crypto/tls"
 	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
+	"net"
 	"net/http"
 	"net/url"
 	"strings"
+	"sync/atomic"
+	"time"
 
-	"github.com/Sirupsen/logrus"
-	"github.com/gorilla/context"
+	gcontext "github.com/gorilla/context"
 	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var (
@@ -27,6 +34,29 @@ type EncryptorSecret struct {
 	Iv  string `json:"base64EncodedIvBytes"`
 }
 
+// Remaining times are in seconds, -1 if the timeout is disabled
+type SessionStatus struct {
+	Connected     bool   `json:"connected"`
+	IdleRemaining int    `json:"idleRemaining"`
+	Remaining     int    `json:"remaining"`
+	Warning       string `json:"warning,omitempty"`
+}
+
+// Upgrader of the browser websockets
+func newUpgrader() websocket.Upgrader {
+	return websocket.Upgrader{
+		ReadBufferSize:    cfg.Buffers.WebsocketRead,
+		WriteBufferSize:   cfg.Buffers.WebsocketWrite,
+		WriteBufferPool:   &websocketWriteBuffers,
+		EnableCompression: cfg.Compression.Enabled,
+		//checkOrigin runs before the upgrade, where a refusal can tell the
+		//browser why and a foreign page cannot end the session
+		CheckOrigin: func(r *http.Request) bool {
+			return true
+		},
+	}
+}
+
 // Given a local session, establish a Websocket <-> HTTPS tunnel to the
 // Xenserver
 func handleVncWebsocketProxy(w http.ResponseWriter, r *http.Request) {
@@ -34,83 +64,198 @@ func handleVncWebsocketProxy(w http.ResponseWriter, r *http.Request) {
 	// XXX: With the current implementation, anyone who has a valid sessionID
 	// can gain access to the VNC
 
-	log.WithFields(logrus.Fields{
+	logger := requestLogger(r)
+
+	ctx, span := startRequestSpan(r, "console.websocket")
+	defer span.End()
+
+	logger.WithFields(logrus.Fields{
 		"url": r.URL.String(),
 	}).Debug("New VNC session")
 
+	upgrader := newUpgrader()
+
 	paths := strings.Split(r.URL.Path, "/")
 
-	if len(paths) < 3 || SessionMap[paths[2]] == nil {
+	var sessionID string
+	if len(paths) >= 3 {
+		sessionID = paths[2]
+	}
+
+	//looked up once, the session can be removed at any time
+	session := SessionMap.Get(sessionID)
+	if session == nil {
 		mesg := "Unable to find session"
+		tokensTotal.WithLabelValues(tokenUnknownSession).Inc()
+		recordFailure(r)
 
-		log.WithFields(logrus.Fields{
+		logger.WithFields(logrus.Fields{
 			"url": r.URL.String(),
 		}).Warn(mesg)
 
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		failSpan(span, mesg, nil)
+		rejectWebsocket(w, r, &upgrader, errUnknownSession)
 		return
 	}
 
-	sessionID := paths[2]
-	session := SessionMap[sessionID]
-
-	log.WithFields(logrus.Fields{
-		"session": session,
-	}).Debug("Found session")
-
-	//if there is a previous session running, close it
-	if session.wsConn != nil || session.tlsConn != nil {
-		session.wsConn.Close()
-		session.tlsConn.Close()
+	//the browser does not carry the trace over from /console, link to it
+	if session.spanContext.IsValid() {
+		span.AddLink(trace.Link{SpanContext: session.spanContext})
 	}
-
-	upgrader := websocket.Upgrader{
-		ReadBufferSize:  1024,
-		WriteBufferSize: 1024,
-		CheckOrigin: func(r *http.Request) bool {
-			return true
-		},
+	span.SetAttributes(
+		attribute.String("correlation_id", session.correlationID),
+		attribute.String("xen_host", session.XenHost()),
+	)
+
+	logger = logger.WithFields(logrus.Fields{
+		"session_id":     sessionID,
+		"correlation_id": session.correlationID,
+	})
+	logger.Debug("Found session")
+
+	//rejected before the upgrade so a foreign page cannot end the session
+	if err := checkOrigin(r); err != nil {
+		logger.WithFields(logrus.Fields{
+			"origin": r.Header.Get("Origin"),
+			"reason": err,
+		}).Warn("Rejected websocket from a foreign origin")
+
+		failSpan(span, "Rejected websocket from a foreign origin", err)
+		rejectWebsocket(w, r, &upgrader, errOriginRefused.wrap(err))
+		return
 	}
 
 	h := http.Header{}
 	h.Set("Sec-WebSocket-Protocol", "binary")
 
-	wsConn, err := upgrader.Upgrade(w, r, h)
+	//counts what goes over the wire to tell the compression ratio
+	writer := &countingResponseWriter{ResponseWriter: w}
+
+	_, upgradeSpan := tracer.Start(ctx, "websocket.upgrade")
+	wsConn, err := upgrader.Upgrade(writer, r, h)
 	if err != nil {
-		delete(SessionMap, sessionID)
+		SessionMap.Delete(sessionID)
+		failSpan(upgradeSpan, "Error upgrading websocket", err)
+		upgradeSpan.End()
+		failSpan(span, "Error upgrading websocket", err)
 
-		log.WithFields(logrus.Fields{
+		//the upgrader has answered the request
+		logger.WithFields(logrus.Fields{
 			"error": err,
 		}).Warn("Error upgrading wesocket")
 
-		http.Error(w, err.Error(), http.StatusInternalServerError)
 		return
 	}
+	upgradeSpan.End()
 
-	xenConn, err := initXenConnection(session)
+	_, limitSpan := tracer.Start(ctx, "console.limits")
+	release, err := consoleLimits.Acquire(sessionID, session.XenHost(), clientIP(r), cfg.Limits.GetQueueTimeout())
+	limitSpan.End()
 	if err != nil {
-		delete(SessionMap, sessionID)
+		limit := err.(*limitError).limit
+		limitedSessions.WithLabelValues(limit).Inc()
+		failSpan(span, "Console limit reached", err)
+
+		logger.WithFields(logrus.Fields{
+			"limit": limit,
+		}).Warn("Console limit reached")
+
+		//the session stays so the user can retry
+		mesg := websocket.FormatCloseMessage(closeTooMany, err.Error())
+		wsConn.WriteControl(websocket.CloseMessage, mesg, time.Now().Add(time.Second))
+		wsConn.Close()
+		return
+	}
 
-		log.WithFields(logrus.Fields{
+	xenConn, err := initXenConnection(ctx, session, logger)
+	if err != nil {
+		consoleErr := asConsoleError(err, errBackendUnreachable)
+
+		//an unreachable xenserver may be back when the user reloads
+		release()
+		if !errors.Is(consoleErr, errBackendUnreachable) {
+			SessionMap.Delete(sessionID)
+		}
+		failSpan(span, "Error initalizing xenserver tunnel", err)
+
+		logger.WithFields(logrus.Fields{
 			"error": err,
 		}).Warn("Error initalizing xenserver tunnel")
 
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		closeWithError(wsConn, consoleErr)
 		return
 	}
 
-	session.wsConn = wsConn
-	session.tlsConn = xenConn
-
 	proxy := NewProxyServer(sessionID, wsConn, xenConn)
+	proxy.clientAddr = r.RemoteAddr
+	proxy.viewOnly = session.ViewOnly
+	proxy.text = session.IsText()
+	proxy.logger = logger
+	if layout := keyLayoutForLocale(session.Locale); layout != nil && !proxy.text {
+		proxy.keys = newKeyTranslator(layout)
+	} else if layout == nil && session.Locale != "" {
+		logger.WithFields(logrus.Fields{
+			"locale": session.Locale,
+		}).Debug("No keyboard layout for the locale, keys are sent as they are")
+	}
+	proxy.session = session
+	proxy.wire = writer.conn
+	proxy.release = release
+
+	if upgrader.EnableCompression && offersDeflate(r) {
+		wsConn.SetCompressionLevel(cfg.Compression.Level)
+		proxy.compressed = true
+	}
+
+	//the span covers opening the console, not the tunnel's lifetime
+	span.End()
+
+	//only one browser can use a console, close the previous one
+	if previous := SessionMap.Attach(sessionID, proxy); previous != nil {
+		previous.Stop(causeReplaced)
+		<-previous.Done()
+	}
+
 	proxy.DoProxy()
 }
 
+// Reports the remaining time of a session, polled by the UI to warn the
+// user before the session is closed
+func handleSessionStatus(w http.ResponseWriter, r *http.Request) {
+
+	sessionID := strings.TrimPrefix(r.URL.Path, "/session/")
+	session := SessionMap.Get(sessionID)
+
+	if session == nil {
+		writeJsonError(w, errUnknownSession)
+		return
+	}
+
+	var status SessionStatus
+	if proxy := SessionMap.Proxy(sessionID); proxy != nil {
+		idle, max := proxy.Remaining()
+
+		status.Connected = true
+		status.IdleRemaining = int(idle.Seconds())
+		status.Remaining = int(max.Seconds())
+		status.Warning = proxy.Warning()
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	json.NewEncoder(w).Encode(status)
+}
+
 // Decypt and get the tunnel URL and xenserver session ID, setup a new local session
-// with all the variables and serve the vnc.html.
+// with all the variables and redirect to its console page.
 func handleNewConsoleConnection(w http.ResponseWriter, r *http.Request) {
 
-	log.WithFields(logrus.Fields{
+	logger := requestLogger(r)
+
+	ctx, span := startRequestSpan(r, "console.open")
+	defer span.End()
+
+	logger.WithFields(logrus.Fields{
 		"remotehost": r.RemoteAddr,
 	}).Debug("New connection")
 
@@ -119,69 +264,97 @@ func handleNewConsoleConnection(w http.ResponseWriter, r *http.Request) {
 	if path == "" {
 
 		token := r.URL.Query().Get("token")
+
+		_, decryptSpan := tracer.Start(ctx, "token.decrypt")
 		consoleSession, err := NewConsoleSession(cfg.Server.EncryptionKey, cfg.Server.EncryptionIv, token)
 
 		if err != nil {
 			mesg := "error creating console session "
-			log.WithFields(logrus.Fields{
+			tokensTotal.WithLabelValues(tokenDecryptFailure).Inc()
+			recordFailure(r)
+			logger.WithFields(logrus.Fields{
 				"error": err,
 			}).Warn(mesg)
 
-			http.Error(w, mesg, http.StatusInternalServerError)
+			failSpan(decryptSpan, mesg, err)
+			decryptSpan.End()
+			failSpan(span, mesg, err)
+			writeErrorPage(w, r, errInvalidToken)
 			return
 		}
+		decryptSpan.End()
 
 		isValid := consoleSession.Validate()
 		if !isValid {
+			tokensTotal.WithLabelValues(tokenInvalid).Inc()
+			recordFailure(r)
 
-			log.WithFields(logrus.Fields{
+			logger.WithFields(logrus.Fields{
 				"session": consoleSession,
 			}).Warn("Error validating session")
 
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			failSpan(span, "Error validating session", nil)
+			writeErrorPage(w, r, errInvalidToken)
+			return
+		}
+
+		if consoleSession.Expired(time.Now()) {
+			tokensTotal.WithLabelValues(tokenExpired).Inc()
+
+			logger.WithFields(logrus.Fields{
+				"expires": consoleSession.Expires,
+			}).Info("Expired token")
+
+			failSpan(span, "Expired token", nil)
+			writeErrorPage(w, r, errExpiredToken)
 			return
 		}
 
 		sessionId := consoleSession.GenerateUuid()
+		tokensTotal.WithLabelValues(tokenRedeemed).Inc()
 
-		log.WithFields(logrus.Fields{
+		//later requests and the tunnel of this session log the ID of the
+		//request which redeemed the token
+		consoleSession.correlationID = requestID(r)
+		consoleSession.spanContext = span.SpanContext()
+		span.SetAttributes(attribute.String("xen_host", consoleSession.XenHost()))
+
+		logger.WithFields(logrus.Fields{
 			"session_id": sessionId,
+			"session":    consoleSession,
 		}).Debug("Starting a new session")
 
-		SessionMap[sessionId] = consoleSession
-		http.Redirect(w, r, "/static/vnc.html?path="+sessionId, http.StatusFound)
+		_, redirectSpan := tracer.Start(ctx, "console.redirect")
+		SessionMap.Put(sessionId, consoleSession)
+		http.Redirect(w, r, "/console/"+sessionId, http.StatusFound)
+		redirectSpan.End()
 
 	} else {
 
-		log.WithFields(logrus.Fields{
+		logger.WithFields(logrus.Fields{
 			"path": path,
 		}).Debug("Got a new session")
 
-		consoleSession := SessionMap[path]
+		consoleSession := SessionMap.Get(path)
 
 		if consoleSession == nil {
+			tokensTotal.WithLabelValues(tokenUnknownSession).Inc()
+			recordFailure(r)
 
-			log.WithFields(logrus.Fields{
+			logger.WithFields(logrus.Fields{
 				"path": path,
 			}).Debug("Unable to find session")
 
-			http.Error(w, "Internal server error", http.StatusInternalServerError)
+			failSpan(span, "Unable to find session", nil)
+			writeErrorPage(w, r, errUnknownSession)
+			return
 		}
 
-		http.ServeFile(w, r, "static/vnc.html")
+		renderConsolePage(w, r, path, consoleSession)
 	}
 
 }
 
-func handleStatic(w http.ResponseWriter, r *http.Request) {
-
-	log.WithFields(logrus.Fields{
-		"path": r.URL.Path[1:],
-	}).Debug("Static file request")
-
-	http.ServeFile(w, r, r.URL.Path[1:])
-}
-
 //We get the encryption key from the console proxy
 func handleSetEncryptorPassword(w http.ResponseWriter, r *http.Request) {
 
@@ -215,16 +388,20 @@ func handleSetEncryptorPassword(w http.ResponseWriter, r *http.Request) {
 	log.Debug("The password was set")
 }
 
-func initXenConnection(session *ConsoleSession) (*tls.Conn, error) {
+func initXenConnection(ctx context.Context, session *ConsoleSession, logger *logrus.Entry) (*tls.Conn, error) {
+
+	ctx, span := tracer.Start(ctx, "xen.connect")
+	defer span.End()
 
 	if session.ClientTunnelSession == "" || session.ClientTunnelUrl == "" {
 		mesg := "Unable to find Tunnel URL or Tunnel Session"
 
-		log.WithFields(logrus.Fields{
+		logger.WithFields(logrus.Fields{
 			"session": session,
 		}).Warn(mesg)
 
-		return nil, errors.New(mesg)
+		failSpan(span, mesg, nil)
+		return nil, errInvalidToken.wrap(errors.New(mesg))
 	}
 
 	//open session to Xenserver
@@ -232,30 +409,76 @@ func initXenConnection(session *ConsoleSession) (*tls.Conn, error) {
 	if err != nil {
 
 		mesg := "Unable to parse session URL"
-		log.WithFields(logrus.Fields{
+		logger.WithFields(logrus.Fields{
 			"tunnel_url": session.ClientTunnelUrl,
 			"error":      err,
 		}).Warn(mesg)
 
-		return nil, errors.New(mesg)
+		failSpan(span, mesg, err)
+		return nil, errInvalidToken.wrap(errors.New(mesg))
 	}
 
 	host := tunnelUrl.Host
 	uri := tunnelUrl.RequestURI()
+	span.SetAttributes(attribute.String("xen_host", host))
+
+	start := time.Now()
+	xenConn, err := openXenTunnel(ctx, session, host, uri, logger)
+	if err != nil {
+		xenConnectFailures.WithLabelValues(host).Inc()
+		failSpan(span, "Unable to open the xenserver tunnel", err)
+		return nil, err
+	}
+
+	xenConnectSeconds.WithLabelValues(host).Observe(time.Since(start).Seconds())
+	return xenConn, nil
+}
+
+// Connects to xenserver and asks it to CONNECT to the console. Fails with
+// errBackendUnreachable or errHostRefused.
+func openXenTunnel(ctx context.Context, session *ConsoleSession, host, uri string, logger *logrus.Entry) (*tls.Conn, error) {
 
 	data := fmt.Sprintf("CONNECT %s HTTP/1.0\r\nHost: %s\r\nCookie: session_id=%s\r\n\r\n",
 		uri, host, session.ClientTunnelSession)
 
-	xenConn, err := tls.Dial("tcp", host+":443", &tls.Config{InsecureSkipVerify: true})
+	//keepalives detect a xenserver which went away while the console is idle
+	dialer := &net.Dialer{KeepAlive: cfg.Keepalive.GetTcpKeepalive()}
+
+	//callers like screenshots bound the whole exchange with the context
+	deadline, _ := ctx.Deadline()
+	dialer.Deadline = deadline
+
+	_, dialSpan := tracer.Start(ctx, "xen.tls_dial")
+	xenConn, err := tls.DialWithDialer(dialer, "tcp", host+":443", &tls.Config{InsecureSkipVerify: true})
+	if err != nil {
+		failSpan(dialSpan, "Failed to connect to Xenserver", err)
+		dialSpan.End()
+
+		logger.WithFields(logrus.Fields{
+			"error":    err,
+			"xen_host": host,
+		}).Warn("Failed to connect to Xenserver")
+
+		return nil, errBackendUnreachable.wrap(err)
+	}
+	dialSpan.End()
+
+	_, connectSpan := tracer.Start(ctx, "xen.xapi_connect")
+	defer connectSpan.End()
+
+	xenConn.SetDeadline(deadline)
+
 	_, err = xenConn.Write([]byte(data))
 	if err != nil {
+		failSpan(connectSpan, "Failed to connect to Xenserver", err)
 
-		log.WithFields(logrus.Fields{
-			"error":   err,
-			"session": session,
+		logger.WithFields(logrus.Fields{
+			"error":    err,
+			"xen_host": host,
 		}).Warn("Failed to connect to Xenserver")
 
-		return nil, err
+		xenConn.Close()
+		return nil, errBackendUnreachable.wrap(err)
 	}
 
 	reader := bufio.NewReader(xenConn)
@@ -265,14 +488,16 @@ func initXenConnection(session *ConsoleSession) (*tls.Conn, error) {
 		l, _, err := reader.ReadLine()
 		line := string(l)
 		if err != nil {
-			log.WithFields(logrus.Fields{
-				"error":   err,
-				"session": session,
+			logger.WithFields(logrus.Fields{
+				"error":    err,
+				"xen_host": host,
 			}).Warn("Error reading data from xenserver")
 
-			return nil, err
+			failSpan(connectSpan, "Error reading data from xenserver", err)
+			xenConn.Close()
+			return nil, errBackendUnreachable.wrap(err)
 		}
-		log.Debug(line)
+		logger.Debug(line)
 		if line == "HTTP/1.1 200 OK" {
 			success = true
 		}
@@ -285,22 +510,98 @@ func initXenConnection(session *ConsoleSession) (*tls.Conn, error) {
 	if !success {
 		mesg := "non 200 response from xenserver https"
 
-		log.Warn(mesg)
-		return nil, errors.New(mesg)
+		logger.Warn(mesg)
+		failSpan(connectSpan, mesg, nil)
+		xenConn.Close()
+		return nil, errHostRefused.wrap(errors.New(mesg))
 	}
 
+	xenConn.SetDeadline(time.Time{})
 	return xenConn, nil
 }
 
+// The endpoints browsers and the management server use
+func registerRoutes(mux *http.ServeMux) {
+	mux.HandleFunc("/console", guardRequests(rejectWhileDraining(handleNewConsoleConnection)))
+	mux.HandleFunc("/console/", guardRequests(rejectWhileDraining(handleConsolePage)))
+	mux.HandleFunc("/setEncryptorPassword", handleSetEncryptorPassword)
+	mux.HandleFunc("/static/", handleStatic)
+	mux.HandleFunc("/vnc/", guardRequests(rejectWhileDraining(handleVncWebsocketProxy)))
+	mux.HandleFunc("/session/", handleSessionStatus)
+	mux.HandleFunc("/screenshot", guardRequests(handleScreenshot))
+	mux.HandleFunc("/keys", guardRequests(handleKeys))
+}
+
 func main() {
 
+	configFile := flag.String("config", "", "path to the configuration file")
+	flag.Parse()
+
+	if *configFile != "" {
+		if err := loadConfig(*configFile); err != nil {
+			log.WithFields(logrus.Fields{
+				"file":  *configFile,
+				"error": err,
+			}).Fatal("Unable to read configuration")
+		}
+	}
+
+	if err := applyLogConfig(cfg.Log); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("Unable to configure logging")
+	}
+
+	if err := initTracing(cfg.Tracing); err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("Unable to set up tracing")
+	}
+
+	if err := initAssets(cfg.Static.Dir); err != nil {
+		log.WithFields(logrus.Fields{
+			"dir":   cfg.Static.Dir,
+			"error": err,
+		}).Fatal("Unable to serve the UI")
+	}
+
+	globalLimiter = newByteLimiter(cfg.RateLimit.GlobalRate, cfg.RateLimit.GlobalBurst)
+
+	if cfg.Auth.Url != "" {
+		go reauthorizeSessions(newAuthorizer(), cfg.Auth.GetInterval())
+	}
+
+	go serveAdmin()
+
 	log.WithFields(logrus.Fields{
 		"addr": cfg.Server.Addr(),
 	}).Info("Listening")
 
-	http.HandleFunc("/console", handleNewConsoleConnection)
-	http.HandleFunc("/setEncryptorPassword", handleSetEncryptorPassword)
-	http.Handle("/static/", http.FileServer(FS(false)))
-	http.HandleFunc("/vnc/", handleVncWebsocketProxy)
-	http.ListenAndServe(cfg.Server.Addr(), context.ClearHandler(http.DefaultServeMux))
+	registerRoutes(http.DefaultServeMux)
+
+	server := &http.Server{
+		Addr:    cfg.Server.Addr(),
+		Handler: gcontext.ClearHandler(withAccessLog(http.DefaultServeMux)),
+	}
+
+	drained := make(chan struct{})
+	go handleSignals(server, drained)
+
+	listener, err := net.Listen("tcp", server.Addr)
+	if err != nil {
+		log.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("Unable to listen")
+	}
+	atomic.StoreInt32(&listening, 1)
+
+	err = server.Serve(listener)
+	if err != http.ErrServerClosed {
+		log.WithFields(logrus.Fields{
+			"error": err,
+		}).Fatal("Unable to listen")
+	}
+
+	<-drained
+	flushTracing()
 }
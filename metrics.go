@@ -0,0 +1,134 @@
+package main
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// Values of the result label of tokensTotal
+const (
+	tokenRedeemed       = "redeemed"
+	tokenDecryptFailure = "decrypt_failure"
+	tokenInvalid        = "invalid"
+	tokenExpired        = "expired"
+	tokenUnknownSession = "unknown_session"
+)
+
+// Values of the limit label of throttledSeconds
+const (
+	limitSession = "session"
+	limitGlobal  = "global"
+)
+
+// Values of the direction label of the traffic counters
+const (
+	toClient = "to_client"
+	toServer = "to_server"
+)
+
+var (
+	activeSessions = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: "console_proxy",
+		Name:      "active_sessions",
+		Help:      "Number of consoles currently proxied.",
+	})
+
+	tokensTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "tokens_total",
+		Help:      "Console tokens and session IDs presented, by result.",
+	}, []string{"result"})
+
+	blockedRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "blocked_requests_total",
+		Help:      "Requests to /console and /vnc/ refused by the client guard, by reason.",
+	}, []string{"reason"})
+
+	bansTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "bans_total",
+		Help:      "Clients banned after repeated token or session ID failures.",
+	})
+
+	limitedSessions = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "limited_sessions_total",
+		Help:      "Consoles refused because of a connection limit, by limit.",
+	}, []string{"limit"})
+
+	xenConnectSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "console_proxy",
+		Name:      "xen_connect_seconds",
+		Help:      "Time to open the tunnel to xenserver, TLS handshake and CONNECT included.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"host"})
+
+	xenConnectFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "xen_connect_failures_total",
+		Help:      "Failed attempts to open a tunnel to xenserver.",
+	}, []string{"host"})
+
+	proxiedBytes = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "bytes_total",
+		Help:      "Bytes proxied between browsers and xenserver.",
+	}, []string{"direction"})
+
+	proxiedMessages = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "messages_total",
+		Help:      "Websocket messages sent to browsers and received from them.",
+	}, []string{"direction"})
+
+	throttledSeconds = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "throttled_seconds_total",
+		Help:      "Time traffic to browsers waited for the session or the global rate limit.",
+	}, []string{"limit"})
+
+	compressionRatio = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "console_proxy",
+		Name:      "compression_ratio",
+		Help:      "Bytes sent to the browser on the wire divided by the bytes proxied, per compressed session.",
+		Buckets:   []float64{0.05, 0.1, 0.2, 0.3, 0.4, 0.5, 0.6, 0.7, 0.8, 0.9, 1},
+	})
+
+	screenshotsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "screenshots_total",
+		Help:      "Screenshot requests by result.",
+	}, []string{"result"})
+
+	keyEventsTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: "console_proxy",
+		Name:      "key_events_total",
+		Help:      "RFB key events sent to consoles through the key API.",
+	})
+
+	sessionDuration = prometheus.NewHistogram(prometheus.HistogramOpts{
+		Namespace: "console_proxy",
+		Name:      "session_duration_seconds",
+		Help:      "How long consoles stayed open.",
+		Buckets:   []float64{10, 30, 60, 300, 600, 1800, 3600, 7200, 14400, 28800},
+	})
+)
+
+func init() {
+	prometheus.MustRegister(
+		activeSessions,
+		tokensTotal,
+		blockedRequests,
+		bansTotal,
+		limitedSessions,
+		xenConnectSeconds,
+		xenConnectFailures,
+		proxiedBytes,
+		proxiedMessages,
+		throttledSeconds,
+		compressionRatio,
+		screenshotsTotal,
+		keyEventsTotal,
+		sessionDuration,
+	)
+}
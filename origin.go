@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// Checks the Origin of a websocket request. Browsers always send it, so a
+// page on another site cannot open a console even if it learned a session
+// ID. Requests without an Origin do not come from a browser and are let
+// through.
+func checkOrigin(r *http.Request) error {
+	origin := r.Header.Get("Origin")
+	if origin == "" {
+		return nil
+	}
+
+	u, err := url.Parse(origin)
+	if err != nil || u.Host == "" {
+		return fmt.Errorf("malformed origin %q", origin)
+	}
+
+	for _, allowed := range allowedOrigins(r) {
+		if originMatches(allowed, u.Host) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("origin %s is not allowed", u.Host)
+}
+
+// The proxy itself, the management server and the configured origins
+func allowedOrigins(r *http.Request) []string {
+	allowed := []string{r.Host}
+
+	if cfg.Auth.Url != "" {
+		if u, err := url.Parse(cfg.Auth.Url); err == nil && u.Hostname() != "" {
+			allowed = append(allowed, u.Hostname())
+		}
+	}
+
+	return append(allowed, cfg.Origin.Allow...)
+}
+
+// Entries are "*", a host which matches any port, host:port or
+// "*.domain" for every subdomain
+func originMatches(allowed, host string) bool {
+	allowed = strings.ToLower(strings.TrimSpace(allowed))
+	host = strings.ToLower(host)
+
+	if allowed == "*" {
+		return true
+	}
+
+	//without a port in the entry any port matches
+	if _, _, err := net.SplitHostPort(allowed); err != nil {
+		if hostname, _, err := net.SplitHostPort(host); err == nil {
+			host = hostname
+		}
+		host = strings.Trim(host, "[]")
+		allowed = strings.Trim(allowed, "[]")
+	}
+
+	if strings.HasPrefix(allowed, "*.") {
+		return strings.HasSuffix(host, allowed[1:])
+	}
+
+	return host == allowed
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestOriginMatches(t *testing.T) {
+	cases := []struct {
+		allowed  string
+		host     string
+		expected bool
+	}{
+		{"*", "evil.example.org", true},
+		{"cloud.example.com", "cloud.example.com", true},
+		{"cloud.example.com", "CLOUD.example.com:8443", true},
+		{"cloud.example.com:443", "cloud.example.com:8443", false},
+		{"cloud.example.com:8443", "cloud.example.com:8443", true},
+		{"*.example.com", "cloud.example.com", true},
+		{"*.example.com", "example.com", false},
+		{"*.example.com", "cloud.example.com.evil.org", false},
+		{"cloud.example.com", "cloud.example.com.evil.org", false},
+	}
+
+	for _, c := range cases {
+		if originMatches(c.allowed, c.host) != c.expected {
+			t.Errorf("Allowed %q Host %q Expected: %v", c.allowed, c.host, c.expected)
+		}
+	}
+}
+
+func TestCheckOrigin(t *testing.T) {
+	defer func(auth string, allow []string) {
+		cfg.Auth.Url, cfg.Origin.Allow = auth, allow
+	}(cfg.Auth.Url, cfg.Origin.Allow)
+
+	cfg.Auth.Url = "http://mgmt.example.com:8080/client/consoleauth"
+	cfg.Origin.Allow = []string{"*.cloud.example.com"}
+
+	cases := map[string]bool{
+		"":                             true,
+		"http://172.31.2.190:9090":     true,
+		"http://mgmt.example.com:8080": true,
+		"https://ui.cloud.example.com": true,
+		"https://evil.example.org":     false,
+		"null":                         false,
+	}
+
+	for origin, expected := range cases {
+		r := httptest.NewRequest("GET", "http://172.31.2.190:9090/vnc/test", nil)
+		if origin != "" {
+			r.Header.Set("Origin", origin)
+		}
+
+		if err := checkOrigin(r); (err == nil) != expected {
+			t.Errorf("Origin %q Expected allowed: %v Got: %v", origin, expected, err)
+		}
+	}
+}
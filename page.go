@@ -0,0 +1,151 @@
+package main
+
+import (
+	"crypto/rand"
+	"embed"
+	"encoding/base64"
+	"html/template"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Console pages are rendered with the session they show, so the browser
+// needs no connection settings and the session ID stays out of the query
+// string.
+//
+//go:embed templates
+var embeddedTemplates embed.FS
+
+var pageTemplates = template.Must(template.ParseFS(embeddedTemplates, "templates/*.html"))
+
+// Returns the templates of the pages, read again for every page while the
+// UI is served from disk
+func loadPageTemplates() (*template.Template, error) {
+	if templatesDir == "" {
+		return pageTemplates, nil
+	}
+	return template.ParseGlob(filepath.Join(templatesDir, "*.html"))
+}
+
+// Handed to the scripts of the page as ConsoleConfig
+type consolePageConfig struct {
+	SessionID     string `json:"sessionId"`
+	WebsocketPath string `json:"websocketPath"` // relative to the page origin, without a leading slash
+	ViewOnly      bool   `json:"viewOnly"`
+	VmName        string `json:"vmName"`
+	Locale        string `json:"locale"`
+	Title         string `json:"title"`
+}
+
+type consolePage struct {
+	Title    string
+	Nonce    string
+	Branding *configBranding
+	Config   consolePageConfig
+}
+
+// Branding for the zone of a session, the "default" section if the zone
+// has none
+func brandingFor(zone string) *configBranding {
+	if branding, ok := cfg.Branding[zone]; ok && zone != "" {
+		return branding
+	}
+	if branding, ok := cfg.Branding["default"]; ok {
+		return branding
+	}
+	return &configBranding{Title: "Console"}
+}
+
+// URL-safe without padding, html/template leaves it alone in the attribute
+func newNonce() string {
+	nonce := make([]byte, 16)
+	rand.Read(nonce)
+	return base64.RawURLEncoding.EncodeToString(nonce)
+}
+
+// Images come from the proxy and the origin of the logo
+func imageSources(branding *configBranding) string {
+	images := "'self' data:"
+	if logo, err := url.Parse(branding.Logo); err == nil && logo.Scheme != "" && logo.Host != "" {
+		images += " " + logo.Scheme + "://" + logo.Host
+	}
+	return images
+}
+
+// Scripts only from the proxy and the inline script of the page,
+// websockets only to the proxy
+func contentSecurityPolicy(r *http.Request, nonce string, branding *configBranding) string {
+	return strings.Join([]string{
+		"default-src 'self'",
+		"script-src 'self' 'nonce-" + nonce + "'",
+		"style-src 'self' 'unsafe-inline'",
+		"img-src " + imageSources(branding),
+		"connect-src 'self' ws://" + r.Host + " wss://" + r.Host,
+		"object-src 'none'",
+		"base-uri 'none'",
+	}, "; ")
+}
+
+// Renders the page which shows the console of session
+func renderConsolePage(w http.ResponseWriter, r *http.Request, sessionID string, session *ConsoleSession) {
+	branding := brandingFor(session.Zone)
+
+	vmName := session.VmName
+	if vmName == "" {
+		vmName = session.ConsoleUuid()
+	}
+
+	page := consolePage{
+		Title:    vmName + " - " + branding.Title,
+		Nonce:    newNonce(),
+		Branding: branding,
+		Config: consolePageConfig{
+			SessionID:     sessionID,
+			WebsocketPath: "vnc/" + url.PathEscape(sessionID),
+			ViewOnly:      session.ViewOnly,
+			VmName:        vmName,
+			Locale:        session.Locale,
+			Title:         branding.Title,
+		},
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.Header().Set("Content-Security-Policy", contentSecurityPolicy(r, page.Nonce, branding))
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Cache-Control", "no-store")
+
+	templates, err := loadPageTemplates()
+	if err == nil {
+		err = templates.ExecuteTemplate(w, session.Page(), page)
+	}
+	if err != nil {
+		requestLogger(r).WithFields(logrus.Fields{
+			"error": err,
+		}).Warn("Unable to render the console page")
+	}
+}
+
+// The console page of a redeemed token, /console/<session ID>
+func handleConsolePage(w http.ResponseWriter, r *http.Request) {
+	sessionID := strings.TrimPrefix(r.URL.Path, "/console/")
+
+	session := SessionMap.Get(sessionID)
+	if session == nil {
+		tokensTotal.WithLabelValues(tokenUnknownSession).Inc()
+		recordFailure(r)
+
+		requestLogger(r).WithFields(logrus.Fields{
+			"path": sessionID,
+		}).Debug("Unable to find session")
+
+		writeErrorPage(w, r, errUnknownSession)
+		return
+	}
+
+	renderConsolePage(w, r, sessionID, session)
+}
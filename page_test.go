@@ -0,0 +1,92 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func getConsolePage(id string) *httptest.ResponseRecorder {
+	r := httptest.NewRequest("GET", "/console/"+id, nil)
+	w := httptest.NewRecorder()
+	handleConsolePage(w, r)
+	return w
+}
+
+func TestConsolePage(t *testing.T) {
+	branding := cfg.Branding
+	defer func() { cfg.Branding = branding }()
+	cfg.Branding = map[string]*configBranding{
+		"default": {Title: "Console"},
+		"zone1":   {Title: "Acme Cloud", Logo: "https://cdn.example.com/logo.png", BarColor: "#336699"},
+	}
+
+	SessionMap.Put("page", &ConsoleSession{
+		ClientTunnelUrl: "https://172.31.0.46/console?uuid=d965e329",
+		VmName:          "web01",
+		Zone:            "zone1",
+		ViewOnly:        true,
+		Locale:          "de",
+	})
+	defer SessionMap.Delete("page")
+
+	w := getConsolePage("page")
+	if w.Code != http.StatusOK {
+		t.Fatal("Expected the console page Got:", w.Code)
+	}
+
+	body := w.Body.String()
+	for _, expected := range []string{
+		"<title>web01 - Acme Cloud</title>",
+		`"sessionId":"page"`,
+		`"websocketPath":"vnc/page"`,
+		`"viewOnly":true`,
+		`"locale":"de"`,
+		`src="https://cdn.example.com/logo.png"`,
+		"background: #336699",
+		"noVNC_canvas",
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected %s in the page", expected)
+		}
+	}
+
+	csp := w.Header().Get("Content-Security-Policy")
+	if !strings.Contains(csp, "img-src 'self' data: https://cdn.example.com") {
+		t.Error("Expected the logo to be allowed Got:", csp)
+	}
+	start := strings.Index(csp, "'nonce-")
+	if start < 0 {
+		t.Fatal("Expected a nonce Got:", csp)
+	}
+	nonce := csp[start+len("'nonce-"):]
+	nonce = nonce[:strings.Index(nonce, "'")]
+	if !strings.Contains(body, `<script nonce="`+nonce+`">`) {
+		t.Error("Expected the script of the page to carry the nonce", nonce)
+	}
+
+	if w.Header().Get("Cache-Control") != "no-store" {
+		t.Error("Expected no-store Got:", w.Header().Get("Cache-Control"))
+	}
+}
+
+func TestTerminalPage(t *testing.T) {
+	SessionMap.Put("terminal", &ConsoleSession{Protocol: protocolVt100, VmName: "serial", Zone: "unknown"})
+	defer SessionMap.Delete("terminal")
+
+	w := getConsolePage("terminal")
+	body := w.Body.String()
+	if w.Code != http.StatusOK || !strings.Contains(body, "terminal_screen") {
+		t.Fatal("Expected the terminal page Got:", w.Code)
+	}
+	if !strings.Contains(body, "<title>serial - Console</title>") {
+		t.Error("Expected the default branding in the title")
+	}
+}
+
+func TestConsolePageUnknownSession(t *testing.T) {
+	if w := getConsolePage("missing"); w.Code != http.StatusNotFound {
+		t.Error("Expected 404 Got:", w.Code)
+	}
+}
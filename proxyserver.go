@@ -1,89 +1,537 @@
 package main
 
 import (
-	"crypto/tls"
+	"context"
+	"io"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
 
-	"github.com/Sirupsen/logrus"
 	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/time/rate"
 )
 
+// Websocket close codes sent to the browser, noVNC shows the reason in its
+// status bar
+const (
+	closeIdleTimeout = 4000
+	closeMaxDuration = 4001
+	closeRevoked     = 4002
+	closeReplaced    = 4003
+	closeTerminated  = 4004
+	closeTooMany     = 4005
+
+	//the console could not be opened, see consoleError
+	closeExpiredToken       = 4006
+	closeInvalidToken       = 4007
+	closeUnknownSession     = 4008
+	closeBackendUnreachable = 4009
+	closeHostRefused        = 4010
+	closeDraining           = 4011
+	closeRateLimited        = 4012
+	closeBanned             = 4013
+	closeOriginRefused      = 4014
+)
+
+// Why a proxy session ended. Code and Reason are sent to the browser in
+// the websocket close frame.
+type DisconnectCause struct {
+	Code   int
+	Reason string
+	Err    error
+}
+
+var (
+	causeIdleTimeout = DisconnectCause{Code: closeIdleTimeout, Reason: "Console session closed due to inactivity"}
+	causeMaxDuration = DisconnectCause{Code: closeMaxDuration, Reason: "Maximum console session duration reached"}
+	causeRevoked     = DisconnectCause{Code: closeRevoked, Reason: "Console access was revoked"}
+	causeReplaced    = DisconnectCause{Code: closeReplaced, Reason: "The console was opened in another window"}
+	causeTerminated  = DisconnectCause{Code: closeTerminated, Reason: "The console was closed by an administrator"}
+)
+
+func causeBrowserError(err error) DisconnectCause {
+	if closeErr, ok := err.(*websocket.CloseError); ok {
+		return DisconnectCause{Code: closeErr.Code, Reason: "Closed by the browser", Err: err}
+	}
+	return DisconnectCause{Code: websocket.CloseGoingAway, Reason: "Browser connection lost", Err: err}
+}
+
+func causeXenError(err error) DisconnectCause {
+	if err == io.EOF {
+		return DisconnectCause{Code: websocket.CloseNormalClosure, Reason: "Xenserver closed the console", Err: err}
+	}
+	return DisconnectCause{Code: websocket.CloseInternalServerErr, Reason: "Connection to xenserver lost", Err: err}
+}
+
 type ProxyServer struct {
-	sessionID string
-	wsConn    *websocket.Conn
-	tlsConn   *tls.Conn
+	sessionID  string
+	wsConn     *websocket.Conn
+	tlsConn    net.Conn
+	clientAddr string
+	viewOnly   bool           // drop keyboard, mouse and clipboard input
+	text       bool           // vt100 console, the stream is not RFB
+	keys       *keyTranslator // rewrites key events for the guest layout, nil for US guests
+	session    *ConsoleSession
+	logger     *logrus.Entry
+	release    func() // gives back the slot taken from consoleLimits
+
+	startTime   time.Time
+	lastInput   int64 // unix nanoseconds, accessed atomically
+	idleTimeout time.Duration
+	maxDuration time.Duration
+	warnBefore  time.Duration
+
+	pingInterval time.Duration
+	pongTimeout  time.Duration
+
+	limiter *rate.Limiter // traffic to the browser, nil if unlimited
+
+	readSize   int
+	flushDelay time.Duration
+
+	// set when permessage-deflate was negotiated
+	compressed      bool
+	compressMinSize int
+	wire            *countingConn
+
+	// browser input and keys typed through the API are written to
+	// xenserver one at a time, at RFB message boundaries
+	input  sync.Mutex
+	stream rfbClientStream
+	typing sync.Mutex // key requests of the API type one after another
+
+	// counters are accessed atomically
+	bytesToClient int64
+	bytesToServer int64
+	throttled     int64 // nanoseconds traffic to the browser waited for a limit
+
+	ctx       context.Context
+	cancel    context.CancelFunc
+	stopOnce  sync.Once
+	cause     DisconnectCause
+	copyLoops sync.WaitGroup
+	done      chan struct{}
 }
 
-func NewProxyServer(sessionID string, wsConn *websocket.Conn, tlsConn *tls.Conn) *ProxyServer {
-	proxyserver := ProxyServer{sessionID, wsConn, tlsConn}
+func NewProxyServer(sessionID string, wsConn *websocket.Conn, tlsConn net.Conn) *ProxyServer {
+	now := time.Now()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	proxyserver := ProxyServer{
+		sessionID:   sessionID,
+		wsConn:      wsConn,
+		tlsConn:     tlsConn,
+		startTime:   now,
+		lastInput:   now.UnixNano(),
+		idleTimeout: cfg.Session.GetIdleTimeout(),
+		maxDuration: cfg.Session.GetMaxDuration(),
+		warnBefore:  cfg.Session.GetWarnBefore(),
+
+		pingInterval: cfg.Keepalive.GetPingInterval(),
+		pongTimeout:  cfg.Keepalive.GetPongTimeout(),
+
+		limiter: newByteLimiter(cfg.RateLimit.SessionRate, cfg.RateLimit.SessionBurst),
+
+		readSize:   cfg.Buffers.ReadSize,
+		flushDelay: cfg.Buffers.GetFlushDelay(),
+
+		compressMinSize: cfg.Compression.MinSize,
+
+		logger: log.WithField("session_id", sessionID),
+
+		ctx:    ctx,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
 	return &proxyserver
 }
 
+// Proxies traffic until either side fails or Stop is called, then tears
+// the session down. Returns once both connections are closed and the copy
+// loops have finished.
 func (proxyserver *ProxyServer) DoProxy() {
+	//the pong handler runs in the reading goroutine, set it up before that starts
+	proxyserver.extendReadDeadline()
+	proxyserver.wsConn.SetPongHandler(func(string) error {
+		proxyserver.extendReadDeadline()
+		return nil
+	})
+
+	activeSessions.Inc()
+
+	proxyserver.copyLoops.Add(2)
 	go proxyserver.wsToTcp()
-	proxyserver.tcpToWs()
+	go proxyserver.tcpToWs()
+	go proxyserver.watchTimeouts()
+	go proxyserver.keepAlive()
+
+	<-proxyserver.ctx.Done()
+	proxyserver.teardown()
 }
 
-func (proxyserver *ProxyServer) tcpToWs() {
-	buffer := make([]byte, 1024)
+// Ends the session. Only the first cause is kept, later calls are no-ops.
+func (proxyserver *ProxyServer) Stop(cause DisconnectCause) {
+	proxyserver.stopOnce.Do(func() {
+		proxyserver.cause = cause
+		proxyserver.cancel()
+	})
+}
+
+// Closed once the session has been torn down
+func (proxyserver *ProxyServer) Done() <-chan struct{} {
+	return proxyserver.done
+}
+
+// Sends the close frame, closes both connections exactly once and waits
+// for the copy loops before reporting the session as finished
+func (proxyserver *ProxyServer) teardown() {
+	cause := proxyserver.cause
+
+	mesg := websocket.FormatCloseMessage(cause.Code, cause.Reason)
+	proxyserver.wsConn.WriteControl(websocket.CloseMessage, mesg, time.Now().Add(time.Second))
+
+	proxyserver.tlsConn.Close()
+	proxyserver.wsConn.Close()
+	proxyserver.copyLoops.Wait()
+
+	SessionMap.Release(proxyserver.sessionID, proxyserver)
+	if proxyserver.release != nil {
+		proxyserver.release()
+	}
+
+	activeSessions.Dec()
+	sessionDuration.Observe(time.Since(proxyserver.startTime).Seconds())
+	if ratio := proxyserver.CompressionRatio(); ratio > 0 {
+		compressionRatio.Observe(ratio)
+	}
+
+	duration := time.Since(proxyserver.startTime)
+	bytesToClient, bytesToServer := proxyserver.BytesTransferred()
+
+	proxyserver.logger.WithFields(logrus.Fields{
+		"code":            cause.Code,
+		"reason":          cause.Reason,
+		"err":             cause.Err,
+		"duration":        duration.String(),
+		"bytes_to_client": bytesToClient,
+		"bytes_to_server": bytesToServer,
+	}).Info("Session closed")
+
+	tunnel := accessLog.WithFields(proxyserver.logger.Data).WithFields(logrus.Fields{
+		"type":            "tunnel",
+		"remote_addr":     proxyserver.clientAddr,
+		"start_time":      proxyserver.startTime.Format(time.RFC3339),
+		"duration_ms":     duration.Nanoseconds() / int64(time.Millisecond),
+		"throttled_ms":    atomic.LoadInt64(&proxyserver.throttled) / int64(time.Millisecond),
+		"compressed":      proxyserver.compressed,
+		"bytes_to_client": bytesToClient,
+		"bytes_to_server": bytesToServer,
+		"close_code":      cause.Code,
+		"close_reason":    cause.Reason,
+	})
+	if proxyserver.session != nil {
+		tunnel = tunnel.WithFields(logrus.Fields{
+			"vm":       proxyserver.session.ConsoleUuid(),
+			"xen_host": proxyserver.session.XenHost(),
+		})
+	}
+	tunnel.Info("tunnel closed")
+
+	close(proxyserver.done)
+}
+
+func (proxyserver *ProxyServer) BytesTransferred() (toClient int64, toServer int64) {
+	return atomic.LoadInt64(&proxyserver.bytesToClient), atomic.LoadInt64(&proxyserver.bytesToServer)
+}
+
+// Returns the bytes sent to the browser on the wire divided by the bytes
+// proxied to it, 0 if the session is not compressed
+func (proxyserver *ProxyServer) CompressionRatio() float64 {
+	toClient, _ := proxyserver.BytesTransferred()
+	if !proxyserver.compressed || proxyserver.wire == nil || toClient == 0 {
+		return 0
+	}
+	return float64(proxyserver.wire.Written()) / float64(toClient)
+}
+
+// Returns the time until the idle timeout and the maximum session duration
+// expire, zero once they have expired. Timeouts which are disabled are
+// reported as negative.
+func (proxyserver *ProxyServer) Remaining() (idle time.Duration, max time.Duration) {
+	now := time.Now()
+	idle, max = -1, -1
+
+	if proxyserver.idleTimeout > 0 {
+		lastInput := time.Unix(0, atomic.LoadInt64(&proxyserver.lastInput))
+		idle = proxyserver.idleTimeout - now.Sub(lastInput)
+		if idle < 0 {
+			idle = 0
+		}
+	}
+
+	if proxyserver.maxDuration > 0 {
+		max = proxyserver.maxDuration - now.Sub(proxyserver.startTime)
+		if max < 0 {
+			max = 0
+		}
+	}
+
+	return idle, max
+}
+
+// Returns a message for the user if the session is about to be closed
+func (proxyserver *ProxyServer) Warning() string {
+	idle, max := proxyserver.Remaining()
+
+	if drain := drainRemaining(); drain >= 0 {
+		return "The console proxy is restarting, the console will be disconnected in " + formatRemaining(drain)
+	}
+
+	if max >= 0 && max <= proxyserver.warnBefore {
+		return "The console session ends in " + formatRemaining(max)
+	}
+
+	if idle >= 0 && idle <= proxyserver.warnBefore {
+		return "The console will be disconnected for inactivity in " + formatRemaining(idle)
+	}
+
+	return ""
+}
+
+func formatRemaining(d time.Duration) string {
+	if d < time.Second {
+		d = time.Second
+	}
+	return d.Round(time.Second).String()
+}
+
+func (proxyserver *ProxyServer) watchTimeouts() {
+	if proxyserver.idleTimeout <= 0 && proxyserver.maxDuration <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
 
 	for {
-		n, err := proxyserver.tlsConn.Read(buffer)
-		if err != nil {
-			log.Printf(err.Error())
+		select {
+		case <-proxyserver.ctx.Done():
+			return
+		case <-ticker.C:
+		}
 
-			log.WithFields(logrus.Fields{
-				"err":         err,
-				"proxyserver": proxyserver,
-			}).Warn("Error reading from TLS")
+		idle, max := proxyserver.Remaining()
 
-			delete(SessionMap, proxyserver.sessionID)
-			proxyserver.tlsConn.Close()
-			proxyserver.wsConn.Close()
-			break
+		if max == 0 {
+			proxyserver.Stop(causeMaxDuration)
+			return
+		}
+
+		if idle == 0 {
+			proxyserver.Stop(causeIdleTimeout)
+			return
+		}
+	}
+}
+
+// Pings the browser and expects something back within the ping interval
+// plus the pong timeout, otherwise the read in wsToTcp fails and the session
+// is torn down. Catches browsers which went away without closing the
+// connection (laptop sleep, NAT timeouts).
+func (proxyserver *ProxyServer) keepAlive() {
+	if proxyserver.pingInterval <= 0 {
+		return
+	}
+
+	ticker := time.NewTicker(proxyserver.pingInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-proxyserver.ctx.Done():
+			return
+		case <-ticker.C:
 		}
 
-		err = proxyserver.wsConn.WriteMessage(websocket.BinaryMessage, buffer[0:n])
+		deadline := time.Now().Add(proxyserver.pongTimeout)
+		err := proxyserver.wsConn.WriteControl(websocket.PingMessage, nil, deadline)
 		if err != nil {
+			proxyserver.Stop(causeBrowserError(err))
+			return
+		}
+	}
+}
+
+func (proxyserver *ProxyServer) extendReadDeadline() {
+	if proxyserver.pingInterval <= 0 {
+		return
+	}
+	deadline := time.Now().Add(proxyserver.pingInterval + proxyserver.pongTimeout)
+	proxyserver.wsConn.SetReadDeadline(deadline)
+}
+
+// Errors caused by the teardown closing the connections are expected and
+// not worth a warning
+func (proxyserver *ProxyServer) stopping() bool {
+	return proxyserver.ctx.Err() != nil
+}
+
+func (proxyserver *ProxyServer) tcpToWs() {
+	defer proxyserver.copyLoops.Done()
+
+	buffer := getBuffer(proxyserver.readSize)
+	defer putBuffer(buffer)
+
+	bytesCounter := proxiedBytes.WithLabelValues(toClient)
+	messagesCounter := proxiedMessages.WithLabelValues(toClient)
 
-			log.WithFields(logrus.Fields{
-				"err":         err,
-				"proxyserver": proxyserver,
-			}).Warn("Error writing to websocket")
+	for {
+		n, readErr := readCoalesced(proxyserver.tlsConn, *buffer, proxyserver.flushDelay)
+
+		//what arrived before xenserver closed the connection still goes out
+		if n > 0 {
+			//only fails once the session is stopping
+			if err := proxyserver.throttle(n); err != nil {
+				return
+			}
+
+			//small messages grow when compressed
+			if proxyserver.compressed {
+				proxyserver.wsConn.EnableWriteCompression(n >= proxyserver.compressMinSize)
+			}
+
+			err := proxyserver.wsConn.WriteMessage(websocket.BinaryMessage, (*buffer)[:n])
+			if err != nil {
+				if !proxyserver.stopping() {
+					proxyserver.logger.WithFields(logrus.Fields{
+						"err": err,
+					}).Warn("Error writing to websocket")
+				}
+
+				proxyserver.Stop(causeBrowserError(err))
+				return
+			}
+
+			atomic.AddInt64(&proxyserver.bytesToClient, int64(n))
+			bytesCounter.Add(float64(n))
+			messagesCounter.Inc()
+		}
+
+		if readErr != nil {
+			if !proxyserver.stopping() {
+				proxyserver.logger.WithFields(logrus.Fields{
+					"err": readErr,
+				}).Warn("Error reading from TLS")
+			}
+
+			proxyserver.Stop(causeXenError(readErr))
+			return
+		}
+	}
+}
+
+// Waits until n bytes may be sent to the browser under the session and the
+// global limit
+func (proxyserver *ProxyServer) throttle(n int) error {
+	waited, err := waitBytes(proxyserver.ctx, proxyserver.limiter, n)
+	if waited > 0 {
+		throttledSeconds.WithLabelValues(limitSession).Add(waited.Seconds())
+		atomic.AddInt64(&proxyserver.throttled, int64(waited))
+	}
+	if err != nil {
+		return err
+	}
+
+	waited, err = waitBytes(proxyserver.ctx, globalLimiter, n)
+	if waited > 0 {
+		throttledSeconds.WithLabelValues(limitGlobal).Add(waited.Seconds())
+		atomic.AddInt64(&proxyserver.throttled, int64(waited))
+	}
+	return err
+}
 
-			delete(SessionMap, proxyserver.sessionID)
-			proxyserver.tlsConn.Close()
-			proxyserver.wsConn.Close()
+// Records user input seen in the RFB stream, drops it for view-only
+// sessions and translates key events for the keyboard layout of the guest.
+// Returns the complete messages which are sent to xenserver, the start of a
+// message split across websocket messages is kept until the rest arrives.
+func (proxyserver *ProxyServer) filterRfb(stream *rfbClientStream, data []byte) []byte {
+	msgs := stream.Write(data)
+	for _, msg := range msgs {
+		if msg.IsUserInput() {
+			atomic.StoreInt64(&proxyserver.lastInput, time.Now().UnixNano())
 			break
 		}
 	}
+
+	if len(msgs) == 1 && !proxyserver.viewOnly && proxyserver.keys == nil {
+		return msgs[0].Data
+	}
+
+	var filtered []byte
+	for _, msg := range msgs {
+		switch {
+		case proxyserver.viewOnly && (msg.IsUserInput() || msg.Type == rfbClientCutText):
+		case msg.Type == rfbKeyEvent && proxyserver.keys != nil:
+			filtered = append(filtered, proxyserver.keys.Translate(msg.Data)...)
+		default:
+			filtered = append(filtered, msg.Data...)
+		}
+	}
+	return filtered
 }
 
 func (proxyserver *ProxyServer) wsToTcp() {
+	defer proxyserver.copyLoops.Done()
+
+	bytesCounter := proxiedBytes.WithLabelValues(toServer)
+	messagesCounter := proxiedMessages.WithLabelValues(toServer)
+
 	for {
 		_, data, err := proxyserver.wsConn.ReadMessage()
 		if err != nil {
-			log.WithFields(logrus.Fields{
-				"err":         err,
-				"proxyserver": proxyserver,
-			}).Warn("Error reading from websocket")
-
-			delete(SessionMap, proxyserver.sessionID)
-			proxyserver.wsConn.Close()
-			proxyserver.tlsConn.Close()
-			break
+			if !proxyserver.stopping() {
+				proxyserver.logger.WithFields(logrus.Fields{
+					"err": err,
+				}).Warn("Error reading from websocket")
+			}
+
+			proxyserver.Stop(causeBrowserError(err))
+			return
+		}
+
+		proxyserver.extendReadDeadline()
+
+		proxyserver.input.Lock()
+
+		//everything the browser sends to a text console is typed by the user
+		if proxyserver.text {
+			if proxyserver.viewOnly {
+				proxyserver.input.Unlock()
+				continue
+			}
+			atomic.StoreInt64(&proxyserver.lastInput, time.Now().UnixNano())
+		} else if data = proxyserver.filterRfb(&proxyserver.stream, data); len(data) == 0 {
+			proxyserver.input.Unlock()
+			continue
 		}
 
 		_, err = proxyserver.tlsConn.Write(data)
+		proxyserver.input.Unlock()
 		if err != nil {
-			log.WithFields(logrus.Fields{
-				"err":         err,
-				"proxyserver": proxyserver,
-			}).Warn("Error writing to tls")
-
-			delete(SessionMap, proxyserver.sessionID)
-			proxyserver.wsConn.Close()
-			proxyserver.tlsConn.Close()
-			break
+			if !proxyserver.stopping() {
+				proxyserver.logger.WithFields(logrus.Fields{
+					"err": err,
+				}).Warn("Error writing to tls")
+			}
+
+			proxyserver.Stop(causeXenError(err))
+			return
 		}
+
+		atomic.AddInt64(&proxyserver.bytesToServer, int64(len(data)))
+		bytesCounter.Add(float64(len(data)))
+		messagesCounter.Inc()
 	}
 }
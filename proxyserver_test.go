@@ -0,0 +1,201 @@
+package main
+
+import (
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Changes the setup of newTestProxy
+type testProxyOption func(*testProxySetup)
+
+type testProxySetup struct {
+	configs []func(*Config)
+	dialer  websocket.Dialer
+}
+
+// Changes cfg before the proxy is created, it is restored when the test ends
+func withConfig(change func(*Config)) testProxyOption {
+	return func(setup *testProxySetup) {
+		setup.configs = append(setup.configs, change)
+	}
+}
+
+// Connects the browser with dialer instead of websocket.DefaultDialer
+func withDialer(dialer websocket.Dialer) testProxyOption {
+	return func(setup *testProxySetup) {
+		setup.dialer = dialer
+	}
+}
+
+// Runs a ProxyServer between a websocket client and a fake xenserver. The
+// websocket is upgraded from cfg as handleVncWebsocketProxy does, the proxy
+// is sent on the channel once it has been created.
+func newTestProxy(t testing.TB, sessionID string, options ...testProxyOption) (*websocket.Conn, net.Conn, chan *ProxyServer) {
+	setup := testProxySetup{dialer: *websocket.DefaultDialer}
+	for _, option := range options {
+		option(&setup)
+	}
+
+	if len(setup.configs) > 0 {
+		saved := cfg
+		t.Cleanup(func() { cfg = saved })
+		for _, change := range setup.configs {
+			change(&cfg)
+		}
+	}
+
+	xenConn, backend := net.Pipe()
+	proxies := make(chan *ProxyServer, 1)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		upgrader := websocket.Upgrader{
+			ReadBufferSize:    cfg.Buffers.WebsocketRead,
+			WriteBufferSize:   cfg.Buffers.WebsocketWrite,
+			WriteBufferPool:   &websocketWriteBuffers,
+			EnableCompression: cfg.Compression.Enabled,
+		}
+		writer := &countingResponseWriter{ResponseWriter: w}
+		wsConn, err := upgrader.Upgrade(writer, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+
+		proxy := NewProxyServer(sessionID, wsConn, xenConn)
+		if session := SessionMap.Get(sessionID); session != nil {
+			proxy.text = session.IsText()
+		}
+		proxy.wire = writer.conn
+		if upgrader.EnableCompression && offersDeflate(r) {
+			wsConn.SetCompressionLevel(cfg.Compression.Level)
+			proxy.compressed = true
+		}
+		SessionMap.Attach(sessionID, proxy)
+		proxies <- proxy
+		proxy.DoProxy()
+	}))
+	t.Cleanup(server.Close)
+
+	url := "ws" + strings.TrimPrefix(server.URL, "http")
+	browser, _, err := setup.dialer.Dial(url, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { browser.Close() })
+
+	return browser, backend, proxies
+}
+
+func TestProxyCopiesBothDirections(t *testing.T) {
+	SessionMap.Put("copy", &ConsoleSession{})
+	defer SessionMap.Delete("copy")
+
+	browser, backend, _ := newTestProxy(t, "copy")
+
+	go backend.Write([]byte("RFB 003.008\n"))
+	_, data, err := browser.ReadMessage()
+	if err != nil || string(data) != "RFB 003.008\n" {
+		t.Fatalf("Expected the server version Got: %q %v", data, err)
+	}
+
+	browser.WriteMessage(websocket.BinaryMessage, []byte("RFB 003.008\n"))
+	buffer := make([]byte, 12)
+	backend.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := backend.Read(buffer)
+	if err != nil || string(buffer[:n]) != "RFB 003.008\n" {
+		t.Fatalf("Expected the client version Got: %q %v", buffer[:n], err)
+	}
+}
+
+func TestProxyTeardownWhenXenserverCloses(t *testing.T) {
+	SessionMap.Put("teardown", &ConsoleSession{})
+	defer SessionMap.Delete("teardown")
+
+	browser, backend, proxies := newTestProxy(t, "teardown")
+	proxy := <-proxies
+
+	backend.Close()
+
+	browser.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err := browser.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	if !ok || closeErr.Code != websocket.CloseNormalClosure {
+		t.Fatal("Expected a normal close frame Got:", err)
+	}
+
+	select {
+	case <-proxy.Done():
+	case <-time.After(time.Second):
+		t.Fatal("Proxy did not finish")
+	}
+
+	if SessionMap.Get("teardown") != nil {
+		t.Error("Session was not removed")
+	}
+}
+
+func TestProxyStopSendsReason(t *testing.T) {
+	SessionMap.Put("stop", &ConsoleSession{})
+	defer SessionMap.Delete("stop")
+
+	browser, _, proxies := newTestProxy(t, "stop")
+	proxy := <-proxies
+
+	proxy.Stop(causeRevoked)
+	proxy.Stop(causeIdleTimeout)
+
+	browser.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err := browser.ReadMessage()
+	closeErr, ok := err.(*websocket.CloseError)
+	if !ok || closeErr.Code != closeRevoked || closeErr.Text != causeRevoked.Reason {
+		t.Fatal("Expected the first stop reason Got:", err)
+	}
+
+	<-proxy.Done()
+}
+
+func TestReplacedProxyKeepsSession(t *testing.T) {
+	SessionMap.Put("replaced", &ConsoleSession{})
+	defer SessionMap.Delete("replaced")
+
+	_, _, proxies := newTestProxy(t, "replaced")
+	first := <-proxies
+
+	second := NewProxyServer("replaced", nil, nil)
+	SessionMap.Attach("replaced", second)
+	first.Stop(causeReplaced)
+	<-first.Done()
+
+	if SessionMap.Proxy("replaced") != second {
+		t.Error("Finished proxy removed the session of its replacement")
+	}
+}
+
+func TestTextConsoleInput(t *testing.T) {
+	SessionMap.Put("text", &ConsoleSession{Protocol: protocolVt100})
+	defer SessionMap.Delete("text")
+
+	browser, backend, proxies := newTestProxy(t, "text")
+	proxy := <-proxies
+
+	before := atomic.LoadInt64(&proxy.lastInput)
+	browser.WriteMessage(websocket.BinaryMessage, []byte("root\r"))
+
+	buffer := make([]byte, 16)
+	backend.SetReadDeadline(time.Now().Add(time.Second))
+	n, err := backend.Read(buffer)
+	if err != nil || string(buffer[:n]) != "root\r" {
+		t.Fatalf("Expected the keystrokes Got: %q %v", buffer[:n], err)
+	}
+
+	if atomic.LoadInt64(&proxy.lastInput) == before {
+		t.Error("Expected typing to count as user input")
+	}
+}
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+// Shared by all sessions so together they cannot saturate the uplink, nil
+// when there is no global limit
+var globalLimiter *rate.Limiter
+
+// Returns a token bucket refilled with rateKiB KiB/s holding up to
+// burstKiB KiB, nil if the rate is not limited. Without a burst one
+// second of traffic can be sent at once.
+func newByteLimiter(rateKiB, burstKiB int) *rate.Limiter {
+	if rateKiB <= 0 {
+		return nil
+	}
+
+	burst := burstKiB * 1024
+	if burst <= 0 {
+		burst = rateKiB * 1024
+	}
+	return rate.NewLimiter(rate.Limit(rateKiB*1024), burst)
+}
+
+// Blocks until n bytes may be sent and returns how long that took. Reads
+// larger than the bucket are let through a bucket at a time.
+func waitBytes(ctx context.Context, limiter *rate.Limiter, n int) (time.Duration, error) {
+	if limiter == nil {
+		return 0, nil
+	}
+
+	start := time.Now()
+	for n > 0 {
+		chunk := n
+		if chunk > limiter.Burst() {
+			chunk = limiter.Burst()
+		}
+
+		if err := limiter.WaitN(ctx, chunk); err != nil {
+			return time.Since(start), err
+		}
+		n -= chunk
+	}
+	return time.Since(start), nil
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestNewByteLimiter(t *testing.T) {
+	if newByteLimiter(0, 64) != nil {
+		t.Error("Expected no limiter without a rate")
+	}
+
+	if limiter := newByteLimiter(100, 0); limiter.Burst() != 100*1024 {
+		t.Error("Expected a burst of one second Got:", limiter.Burst())
+	}
+}
+
+func TestWaitBytes(t *testing.T) {
+	if waited, err := waitBytes(context.Background(), nil, 1<<20); waited != 0 || err != nil {
+		t.Error("Expected no wait without a limiter Got:", waited, err)
+	}
+
+	//1 KiB of burst, the remaining 2 KiB take 200ms at 10 KiB/s
+	limiter := newByteLimiter(10, 1)
+	waited, err := waitBytes(context.Background(), limiter, 3*1024)
+	if err != nil || waited < 150*time.Millisecond {
+		t.Error("Expected to be throttled for about 200ms Got:", waited, err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := waitBytes(ctx, limiter, 1024); err == nil {
+		t.Error("Expected a cancelled wait to fail")
+	}
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"net/url"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+)
+
+const redacted = "[REDACTED]"
+
+// Field names whose values are never logged
+var secretFields = map[string]bool{
+	"password":    true,
+	"ticket":      true,
+	"token":       true,
+	"secret":      true,
+	"secret_json": true,
+	"cookie":      true,
+	"key":         true,
+	"iv":          true,
+}
+
+// Query parameters which carry secrets in logged URLs
+var secretParams = []string{"token", "secret", "ticket"}
+
+// Scrubs secrets from every entry before it is formatted: known secret
+// fields, secrets in URLs and whole console sessions, which carry the VNC
+// password and the xenserver session cookie
+type redactHook struct{}
+
+func (redactHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (redactHook) Fire(entry *logrus.Entry) error {
+	for name, value := range entry.Data {
+		entry.Data[name] = redactField(name, value)
+	}
+	return nil
+}
+
+func redactField(name string, value interface{}) interface{} {
+	if secretFields[strings.ToLower(name)] {
+		return redacted
+	}
+
+	switch v := value.(type) {
+	case *ConsoleSession:
+		if v == nil {
+			return nil
+		}
+		return v.LogFields()
+	case ConsoleSession:
+		return v.LogFields()
+	case *url.URL:
+		if v == nil {
+			return nil
+		}
+		return redactUrl(v.String())
+	case string:
+		if strings.Contains(v, "?") {
+			return redactUrl(v)
+		}
+	}
+
+	return value
+}
+
+func redactUrl(raw string) string {
+	u, err := url.Parse(raw)
+	if err != nil || u.RawQuery == "" {
+		return raw
+	}
+
+	query := u.Query()
+	changed := false
+	for _, param := range secretParams {
+		if _, ok := query[param]; ok {
+			query.Set(param, redacted)
+			changed = true
+		}
+	}
+
+	if !changed {
+		return raw
+	}
+	u.RawQuery = query.Encode()
+	return u.String()
+}
+
+// The parts of a session which are safe to log
+func (s *ConsoleSession) LogFields() logrus.Fields {
+	return logrus.Fields{
+		"client_host": s.ClientHostAddress,
+		"client_tag":  s.ClientTag,
+		"vm":          s.ConsoleUuid(),
+		"xen_host":    s.XenHost(),
+		"view_only":   s.ViewOnly,
+	}
+}
+
+func init() {
+	log.Hooks.Add(redactHook{})
+}
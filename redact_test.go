@@ -0,0 +1,43 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+)
+
+func TestRedactHook(t *testing.T) {
+	var out bytes.Buffer
+	logger := &logrus.Logger{
+		Out:       &out,
+		Formatter: &logrus.JSONFormatter{},
+		Hooks:     make(logrus.LevelHooks),
+		Level:     logrus.InfoLevel,
+	}
+	logger.Hooks.Add(redactHook{})
+
+	session := &ConsoleSession{
+		ClientHostPassword:  "n7t8eu4O_rrOHOLICneCrA",
+		Ticket:              "lVnfsfYS2I4mJ6JYiL2OlKY9hUE=",
+		ClientTunnelUrl:     "https://172.31.0.46/console?uuid=9389b857-7a15-a4eb-63dc-50e09b262838",
+		ClientTunnelSession: "OpaqueRef:d965e329-c32b-2c9c-a33c-66cafe6214c3",
+	}
+
+	logger.WithFields(logrus.Fields{
+		"session": session,
+		"ticket":  session.Ticket,
+		"url":     "/console?token=cDiJpVXbkMSG_GiyISA5WIfiy8UzKzRKV73b4UIpnne",
+	}).Info("test")
+
+	for _, secret := range []string{session.ClientHostPassword, session.Ticket, "OpaqueRef", "cDiJpVXbkMSG"} {
+		if strings.Contains(out.String(), secret) {
+			t.Errorf("Secret %s was logged: %s", secret, out.String())
+		}
+	}
+
+	if !strings.Contains(out.String(), "9389b857-7a15-a4eb-63dc-50e09b262838") {
+		t.Error("Expected the VM UUID to be logged Got:", out.String())
+	}
+}
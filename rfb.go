@@ -0,0 +1,244 @@
+package main
+
+import (
+	"encoding/binary"
+)
+
+// RFB client to server message types (RFC 6143 section 7.5 plus the
+// extensions noVNC and QEMU use)
+const (
+	rfbSetPixelFormat           = 0
+	rfbSetEncodings             = 2
+	rfbFramebufferUpdateRequest = 3
+	rfbKeyEvent                 = 4
+	rfbPointerEvent             = 5
+	rfbClientCutText            = 6
+	rfbEnableContinuousUpdates  = 150
+	rfbClientFence              = 248
+	rfbXvp                      = 250
+	rfbSetDesktopSize           = 251
+	rfbQemuClientMessage        = 255
+
+	// Pseudo types for bytes which are not regular client messages
+	rfbHandshake = -1
+	rfbUnknown   = -2
+)
+
+// QEMU client message subtypes
+const (
+	rfbQemuExtendedKeyEvent = 0
+	rfbQemuAudio            = 1
+)
+
+const (
+	rfbStateVersion = iota
+	rfbStateSecurity
+	rfbStateVncAuth
+	rfbStateClientInit
+	rfbStateNormal
+	rfbStatePassthrough
+)
+
+type rfbClientMessage struct {
+	Type int
+	Data []byte
+}
+
+// Messages longer than this, which only ClientCutText can be, are passed
+// on as they arrive instead of being held until they are complete
+const rfbMaxClientMessage = 1 << 20
+
+// Splits the browser -> xenserver byte stream into RFB messages. noVNC
+// does not guarantee that one websocket message carries exactly one RFB
+// message, so partial messages are kept until the rest arrives. If the
+// stream contains something we do not understand, everything from there on
+// is returned as rfbUnknown.
+type rfbClientStream struct {
+	state int
+	buf   []byte
+
+	// bytes of a message longer than rfbMaxClientMessage still to come,
+	// they are returned in pieces of that type
+	pending     int
+	pendingType int
+}
+
+func (s *rfbClientStream) Write(data []byte) []rfbClientMessage {
+	var msgs []rfbClientMessage
+
+	if s.state == rfbStatePassthrough {
+		return append(msgs, rfbClientMessage{rfbUnknown, data})
+	}
+
+	s.buf = append(s.buf, data...)
+
+	for len(s.buf) > 0 {
+		if s.pending > 0 {
+			n := s.pending
+			if n > len(s.buf) {
+				n = len(s.buf)
+			}
+			msgs = append(msgs, rfbClientMessage{s.pendingType, s.buf[:n:n]})
+			s.buf = s.buf[n:]
+			s.pending -= n
+			continue
+		}
+
+		msgType, n := s.next()
+		if n == 0 {
+			break
+		}
+
+		if n < 0 {
+			s.state = rfbStatePassthrough
+			msgs = append(msgs, rfbClientMessage{rfbUnknown, s.buf})
+			s.buf = nil
+			break
+		}
+
+		if n > len(s.buf) {
+			s.pending, s.pendingType = n, msgType
+			continue
+		}
+
+		msgs = append(msgs, rfbClientMessage{msgType, s.buf[:n:n]})
+		s.buf = s.buf[n:]
+	}
+
+	if len(s.buf) == 0 {
+		s.buf = nil
+	}
+
+	return msgs
+}
+
+// Returns the type and length of the message at the start of the buffer,
+// zero if more data is needed and a negative length if the message is not
+// understood. Only messages longer than rfbMaxClientMessage are returned
+// before they are complete.
+func (s *rfbClientStream) next() (int, int) {
+	buf := s.buf
+
+	switch s.state {
+	case rfbStateVersion:
+		if len(buf) < 12 {
+			return rfbHandshake, 0
+		}
+		if string(buf[:4]) != "RFB " {
+			return rfbUnknown, -1
+		}
+		//3.3 has no security type selection, assume no authentication
+		if string(buf[4:11]) == "003.003" {
+			s.state = rfbStateClientInit
+		} else {
+			s.state = rfbStateSecurity
+		}
+		return rfbHandshake, 12
+
+	case rfbStateSecurity:
+		switch buf[0] {
+		case 1:
+			s.state = rfbStateClientInit
+		case 2:
+			s.state = rfbStateVncAuth
+		default:
+			return rfbUnknown, -1
+		}
+		return rfbHandshake, 1
+
+	case rfbStateVncAuth:
+		if len(buf) < 16 {
+			return rfbHandshake, 0
+		}
+		s.state = rfbStateClientInit
+		return rfbHandshake, 16
+
+	case rfbStateClientInit:
+		s.state = rfbStateNormal
+		return rfbHandshake, 1
+	}
+
+	msgType := int(buf[0])
+	n := rfbClientMessageLen(buf)
+	if n > 0 && len(buf) < n && n <= rfbMaxClientMessage {
+		return msgType, 0
+	}
+
+	return msgType, n
+}
+
+// Returns the length of the client message at the start of buf, zero if
+// more data is needed to tell and -1 for unknown messages.
+func rfbClientMessageLen(buf []byte) int {
+	switch buf[0] {
+	case rfbSetPixelFormat:
+		return 20
+	case rfbSetEncodings:
+		if len(buf) < 4 {
+			return 0
+		}
+		return 4 + 4*int(binary.BigEndian.Uint16(buf[2:4]))
+	case rfbFramebufferUpdateRequest:
+		return 10
+	case rfbKeyEvent:
+		return 8
+	case rfbPointerEvent:
+		return 6
+	case rfbClientCutText:
+		if len(buf) < 8 {
+			return 0
+		}
+		//the extended clipboard extension uses a negative length
+		length := int32(binary.BigEndian.Uint32(buf[4:8]))
+		if length < 0 {
+			length = -length
+		}
+		return 8 + int(length)
+	case rfbEnableContinuousUpdates:
+		return 10
+	case rfbClientFence:
+		if len(buf) < 9 {
+			return 0
+		}
+		return 9 + int(buf[8])
+	case rfbXvp:
+		return 4
+	case rfbSetDesktopSize:
+		if len(buf) < 8 {
+			return 0
+		}
+		return 8 + 16*int(buf[6])
+	case rfbQemuClientMessage:
+		if len(buf) < 2 {
+			return 0
+		}
+		switch buf[1] {
+		case rfbQemuExtendedKeyEvent:
+			return 12
+		case rfbQemuAudio:
+			if len(buf) < 4 {
+				return 0
+			}
+			//set format is the only audio operation with a payload
+			if binary.BigEndian.Uint16(buf[2:4]) == 2 {
+				return 10
+			}
+			return 4
+		}
+	}
+
+	return -1
+}
+
+// Returns true for messages which are caused by the user interacting with
+// the console
+func (m rfbClientMessage) IsUserInput() bool {
+	switch m.Type {
+	case rfbKeyEvent, rfbPointerEvent, rfbUnknown:
+		return true
+	case rfbQemuClientMessage:
+		return len(m.Data) > 1 && m.Data[1] == rfbQemuExtendedKeyEvent
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package main
+
+import "testing"
+
+func TestClientStreamHandshake(t *testing.T) {
+	var stream rfbClientStream
+
+	msgs := stream.Write([]byte("RFB 003.008\n\x01\x01"))
+	if len(msgs) != 3 {
+		t.Fatalf("Expected 3 handshake messages Got: %d", len(msgs))
+	}
+
+	for _, msg := range msgs {
+		if msg.Type != rfbHandshake || msg.IsUserInput() {
+			t.Error("Expected a handshake message Got:", msg.Type)
+		}
+	}
+}
+
+func TestClientStreamSplitMessages(t *testing.T) {
+	stream := rfbClientStream{state: rfbStateNormal}
+
+	//a pointer event followed by the first half of a key event
+	msgs := stream.Write([]byte{5, 0, 0, 10, 0, 20, 4, 1})
+	if len(msgs) != 1 || msgs[0].Type != rfbPointerEvent {
+		t.Fatalf("Expected a pointer event Got: %v", msgs)
+	}
+
+	msgs = stream.Write([]byte{0, 0, 0, 0, 0xff, 0x0d, 3, 0, 0, 0, 0, 0, 0, 4, 0, 3})
+	if len(msgs) != 2 {
+		t.Fatalf("Expected 2 messages Got: %v", msgs)
+	}
+	if msgs[0].Type != rfbKeyEvent || len(msgs[0].Data) != 8 || !msgs[0].IsUserInput() {
+		t.Error("Expected a key event Got:", msgs[0])
+	}
+	if msgs[1].Type != rfbFramebufferUpdateRequest || msgs[1].IsUserInput() {
+		t.Error("Expected a framebuffer update request Got:", msgs[1])
+	}
+}
+
+func TestClientStreamUnknownMessage(t *testing.T) {
+	stream := rfbClientStream{state: rfbStateNormal}
+
+	msgs := stream.Write([]byte{3, 0, 0, 0, 0, 0, 0, 4, 0, 3, 42, 1, 2})
+	if len(msgs) != 2 || msgs[1].Type != rfbUnknown || len(msgs[1].Data) != 3 {
+		t.Fatalf("Expected an unknown message Got: %v", msgs)
+	}
+
+	msgs = stream.Write([]byte{3, 0})
+	if len(msgs) != 1 || msgs[0].Type != rfbUnknown {
+		t.Error("Expected the stream to pass everything through Got:", msgs)
+	}
+}
+
+func TestClientStreamLargeCutText(t *testing.T) {
+	stream := rfbClientStream{state: rfbStateNormal}
+
+	//a cut text claiming 2 GiB is passed on as it arrives
+	msgs := stream.Write([]byte{6, 0, 0, 0, 0x7f, 0xff, 0xff, 0xff, 'a', 'b'})
+	if len(msgs) != 1 || msgs[0].Type != rfbClientCutText || len(msgs[0].Data) != 10 {
+		t.Fatalf("Expected the start of the cut text Got: %v", msgs)
+	}
+
+	msgs = stream.Write(make([]byte, 4096))
+	if len(msgs) != 1 || msgs[0].Type != rfbClientCutText || len(msgs[0].Data) != 4096 || stream.buf != nil {
+		t.Error("Expected the cut text to be passed on without being held Got:", len(msgs))
+	}
+
+	//the stream is parsed again after the last byte
+	stream.pending = 2
+	msgs = stream.Write([]byte{'y', 'z', 5, 0, 0, 10, 0, 20})
+	if len(msgs) != 2 || len(msgs[0].Data) != 2 || msgs[1].Type != rfbPointerEvent {
+		t.Error("Expected the rest of the cut text and a pointer event Got:", msgs)
+	}
+}
@@ -0,0 +1,582 @@
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"crypto/des"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"image"
+	"io"
+	"io/ioutil"
+	"net"
+)
+
+// Encodings the RFB client asks for
+const (
+	rfbEncodingRaw     = 0
+	rfbEncodingHextile = 5
+	rfbEncodingZrle    = 16
+)
+
+// Server to client message types
+const (
+	rfbFramebufferUpdate   = 0
+	rfbSetColourMapEntries = 1
+	rfbBell                = 2
+	rfbServerCutText       = 3
+)
+
+// Hextile subencoding flags
+const (
+	hextileRaw                 = 1
+	hextileBackgroundSpecified = 2
+	hextileForegroundSpecified = 4
+	hextileAnySubrects         = 8
+	hextileSubrectsColoured    = 16
+)
+
+// A minimal RFB client, enough to take a picture of the screen. Pixels are
+// requested as 32 bit little endian true colour, so a pixel on the wire is
+// blue, green, red, padding.
+type rfbClient struct {
+	conn   net.Conn
+	r      *bufio.Reader
+	width  int
+	height int
+
+	// ZRLE uses one zlib stream for the whole connection
+	zrleData   bytes.Buffer
+	zrleReader io.ReadCloser
+}
+
+// Runs the handshake, authenticating with password if the server asks for
+// VNC authentication
+func newRfbClient(conn net.Conn, password string) (*rfbClient, error) {
+	c := &rfbClient{conn: conn, r: bufio.NewReader(conn)}
+
+	version := make([]byte, 12)
+	if _, err := io.ReadFull(c.r, version); err != nil {
+		return nil, err
+	}
+	if string(version[:4]) != "RFB " {
+		return nil, fmt.Errorf("not an RFB server: %q", version)
+	}
+
+	minor := 8
+	switch string(version[4:11]) {
+	case "003.003":
+		minor = 3
+	case "003.007":
+		minor = 7
+	}
+	if _, err := fmt.Fprintf(conn, "RFB 003.%03d\n", minor); err != nil {
+		return nil, err
+	}
+
+	if err := c.security(minor, password); err != nil {
+		return nil, err
+	}
+
+	//shared, other viewers stay connected
+	if _, err := conn.Write([]byte{1}); err != nil {
+		return nil, err
+	}
+
+	var init struct {
+		Width, Height uint16
+		PixelFormat   [16]byte
+		NameLength    uint32
+	}
+	if err := binary.Read(c.r, binary.BigEndian, &init); err != nil {
+		return nil, err
+	}
+	if _, err := io.CopyN(ioutil.Discard, c.r, int64(init.NameLength)); err != nil {
+		return nil, err
+	}
+	c.width, c.height = int(init.Width), int(init.Height)
+
+	return c, c.setup()
+}
+
+func (c *rfbClient) security(minor int, password string) error {
+	var securityType uint32
+
+	if minor == 3 {
+		if err := binary.Read(c.r, binary.BigEndian, &securityType); err != nil {
+			return err
+		}
+	} else {
+		count, err := c.r.ReadByte()
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return c.failure()
+		}
+		types := make([]byte, count)
+		if _, err := io.ReadFull(c.r, types); err != nil {
+			return err
+		}
+
+		for _, t := range types {
+			if t == 1 || (t == 2 && securityType != 1) {
+				securityType = uint32(t)
+			}
+		}
+		if securityType == 0 {
+			return fmt.Errorf("no supported RFB security type in %v", types)
+		}
+		if _, err := c.conn.Write([]byte{byte(securityType)}); err != nil {
+			return err
+		}
+	}
+
+	switch securityType {
+	case 0:
+		return c.failure()
+	case 1:
+		//3.3 and 3.7 send no result without authentication
+		if minor < 8 {
+			return nil
+		}
+	case 2:
+		if err := c.vncAuth(password); err != nil {
+			return err
+		}
+	default:
+		return fmt.Errorf("no supported RFB security type")
+	}
+
+	var result uint32
+	if err := binary.Read(c.r, binary.BigEndian, &result); err != nil {
+		return err
+	}
+	if result != 0 {
+		if minor < 8 {
+			return errors.New("RFB authentication failed")
+		}
+		return c.failure()
+	}
+	return nil
+}
+
+// Reads the reason the server sends along with a failure
+func (c *rfbClient) failure() error {
+	var length uint32
+	if err := binary.Read(c.r, binary.BigEndian, &length); err != nil {
+		return err
+	}
+	reason := make([]byte, length)
+	if _, err := io.ReadFull(c.r, reason); err != nil {
+		return err
+	}
+	return fmt.Errorf("RFB server refused the connection: %s", reason)
+}
+
+// Encrypts the challenge with DES, keyed with the password whose bits are
+// mirrored in every byte
+func (c *rfbClient) vncAuth(password string) error {
+	challenge := make([]byte, 16)
+	if _, err := io.ReadFull(c.r, challenge); err != nil {
+		return err
+	}
+
+	key := make([]byte, 8)
+	copy(key, password)
+	for i, b := range key {
+		var mirrored byte
+		for bit := uint(0); bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				mirrored |= 1 << (7 - bit)
+			}
+		}
+		key[i] = mirrored
+	}
+
+	block, err := des.NewCipher(key)
+	if err != nil {
+		return err
+	}
+	response := make([]byte, 16)
+	block.Encrypt(response[:8], challenge[:8])
+	block.Encrypt(response[8:], challenge[8:])
+
+	_, err = c.conn.Write(response)
+	return err
+}
+
+// Asks for 32 bit true colour pixels and the encodings we can decode
+func (c *rfbClient) setup() error {
+	var msg bytes.Buffer
+
+	msg.Write([]byte{rfbSetPixelFormat, 0, 0, 0})
+	//bpp, depth, big endian, true colour, max red/green/blue, shifts
+	msg.Write([]byte{32, 24, 0, 1, 0, 255, 0, 255, 0, 255, 16, 8, 0, 0, 0, 0})
+
+	encodings := []int32{rfbEncodingZrle, rfbEncodingHextile, rfbEncodingRaw}
+	msg.Write([]byte{rfbSetEncodings, 0})
+	binary.Write(&msg, binary.BigEndian, uint16(len(encodings)))
+	binary.Write(&msg, binary.BigEndian, encodings)
+
+	_, err := c.conn.Write(msg.Bytes())
+	return err
+}
+
+// Requests the whole screen and decodes the first update
+func (c *rfbClient) Capture() (*image.RGBA, error) {
+	request := []byte{rfbFramebufferUpdateRequest, 0, 0, 0, 0, 0}
+	request = append(request, byte(c.width>>8), byte(c.width), byte(c.height>>8), byte(c.height))
+	if _, err := c.conn.Write(request); err != nil {
+		return nil, err
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, c.width, c.height))
+
+	for {
+		msgType, err := c.r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		switch msgType {
+		case rfbFramebufferUpdate:
+			return img, c.readUpdate(img)
+		case rfbSetColourMapEntries:
+			var header struct {
+				Padding    byte
+				FirstColor uint16
+				Count      uint16
+			}
+			if err := binary.Read(c.r, binary.BigEndian, &header); err != nil {
+				return nil, err
+			}
+			if _, err := io.CopyN(ioutil.Discard, c.r, 6*int64(header.Count)); err != nil {
+				return nil, err
+			}
+		case rfbBell:
+		case rfbServerCutText:
+			var header struct {
+				Padding [3]byte
+				Length  uint32
+			}
+			if err := binary.Read(c.r, binary.BigEndian, &header); err != nil {
+				return nil, err
+			}
+			if _, err := io.CopyN(ioutil.Discard, c.r, int64(header.Length)); err != nil {
+				return nil, err
+			}
+		default:
+			return nil, fmt.Errorf("unknown RFB server message %d", msgType)
+		}
+	}
+}
+
+func (c *rfbClient) readUpdate(img *image.RGBA) error {
+	var header struct {
+		Padding byte
+		Rects   uint16
+	}
+	if err := binary.Read(c.r, binary.BigEndian, &header); err != nil {
+		return err
+	}
+
+	for i := 0; i < int(header.Rects); i++ {
+		var rect struct {
+			X, Y, Width, Height uint16
+			Encoding            int32
+		}
+		if err := binary.Read(c.r, binary.BigEndian, &rect); err != nil {
+			return err
+		}
+
+		r := image.Rect(int(rect.X), int(rect.Y), int(rect.X)+int(rect.Width), int(rect.Y)+int(rect.Height))
+		if !r.In(img.Rect) {
+			return fmt.Errorf("rectangle %v outside of the screen", r)
+		}
+
+		var err error
+		switch rect.Encoding {
+		case rfbEncodingRaw:
+			err = c.readRaw(img, r)
+		case rfbEncodingHextile:
+			err = c.readHextile(img, r)
+		case rfbEncodingZrle:
+			err = c.readZrle(img, r)
+		default:
+			err = fmt.Errorf("unsupported RFB encoding %d", rect.Encoding)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func setPixel(img *image.RGBA, x, y int, b, g, r byte) {
+	offset := img.PixOffset(x, y)
+	img.Pix[offset] = r
+	img.Pix[offset+1] = g
+	img.Pix[offset+2] = b
+	img.Pix[offset+3] = 255
+}
+
+func fillRect(img *image.RGBA, rect image.Rectangle, pixel []byte) {
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		for x := rect.Min.X; x < rect.Max.X; x++ {
+			setPixel(img, x, y, pixel[0], pixel[1], pixel[2])
+		}
+	}
+}
+
+func (c *rfbClient) readRaw(img *image.RGBA, rect image.Rectangle) error {
+	row := make([]byte, 4*rect.Dx())
+	for y := rect.Min.Y; y < rect.Max.Y; y++ {
+		if _, err := io.ReadFull(c.r, row); err != nil {
+			return err
+		}
+		for x := 0; x < rect.Dx(); x++ {
+			setPixel(img, rect.Min.X+x, y, row[4*x], row[4*x+1], row[4*x+2])
+		}
+	}
+	return nil
+}
+
+// 16x16 tiles, background and foreground carry over from tile to tile
+func (c *rfbClient) readHextile(img *image.RGBA, rect image.Rectangle) error {
+	background := make([]byte, 4)
+	foreground := make([]byte, 4)
+
+	for ty := rect.Min.Y; ty < rect.Max.Y; ty += 16 {
+		for tx := rect.Min.X; tx < rect.Max.X; tx += 16 {
+			tile := image.Rect(tx, ty, tx+16, ty+16).Intersect(rect)
+
+			flags, err := c.r.ReadByte()
+			if err != nil {
+				return err
+			}
+
+			if flags&hextileRaw != 0 {
+				if err := c.readRaw(img, tile); err != nil {
+					return err
+				}
+				continue
+			}
+
+			if flags&hextileBackgroundSpecified != 0 {
+				if _, err := io.ReadFull(c.r, background); err != nil {
+					return err
+				}
+			}
+			fillRect(img, tile, background)
+
+			if flags&hextileForegroundSpecified != 0 {
+				if _, err := io.ReadFull(c.r, foreground); err != nil {
+					return err
+				}
+			}
+
+			if flags&hextileAnySubrects == 0 {
+				continue
+			}
+
+			count, err := c.r.ReadByte()
+			if err != nil {
+				return err
+			}
+
+			for i := 0; i < int(count); i++ {
+				pixel := foreground
+				if flags&hextileSubrectsColoured != 0 {
+					pixel = make([]byte, 4)
+					if _, err := io.ReadFull(c.r, pixel); err != nil {
+						return err
+					}
+				}
+
+				var geometry [2]byte
+				if _, err := io.ReadFull(c.r, geometry[:]); err != nil {
+					return err
+				}
+				x, y := int(geometry[0]>>4), int(geometry[0]&15)
+				w, h := int(geometry[1]>>4)+1, int(geometry[1]&15)+1
+
+				sub := image.Rect(tile.Min.X+x, tile.Min.Y+y, tile.Min.X+x+w, tile.Min.Y+y+h).Intersect(tile)
+				fillRect(img, sub, pixel)
+			}
+		}
+	}
+	return nil
+}
+
+// 64x64 tiles from a zlib stream. With 24 bit depth a compressed pixel is
+// the 3 bytes blue, green, red.
+func (c *rfbClient) readZrle(img *image.RGBA, rect image.Rectangle) error {
+	var length uint32
+	if err := binary.Read(c.r, binary.BigEndian, &length); err != nil {
+		return err
+	}
+	if _, err := io.CopyN(&c.zrleData, c.r, int64(length)); err != nil {
+		return err
+	}
+
+	if c.zrleReader == nil {
+		reader, err := zlib.NewReader(&c.zrleData)
+		if err != nil {
+			return err
+		}
+		c.zrleReader = reader
+	}
+	z := &zrleReader{r: c.zrleReader}
+
+	for ty := rect.Min.Y; ty < rect.Max.Y; ty += 64 {
+		for tx := rect.Min.X; tx < rect.Max.X; tx += 64 {
+			tile := image.Rect(tx, ty, tx+64, ty+64).Intersect(rect)
+			if err := z.readTile(img, tile); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+type zrleReader struct {
+	r   io.Reader
+	buf [3]byte
+}
+
+func (z *zrleReader) byte() (byte, error) {
+	_, err := io.ReadFull(z.r, z.buf[:1])
+	return z.buf[0], err
+}
+
+func (z *zrleReader) pixel() ([]byte, error) {
+	pixel := make([]byte, 3)
+	_, err := io.ReadFull(z.r, pixel)
+	return pixel, err
+}
+
+// Run lengths are one plus the sum of the bytes up to the first one which
+// is not 255
+func (z *zrleReader) runLength() (int, error) {
+	length := 1
+	for {
+		b, err := z.byte()
+		if err != nil {
+			return 0, err
+		}
+		length += int(b)
+		if b != 255 {
+			return length, nil
+		}
+	}
+}
+
+func (z *zrleReader) readTile(img *image.RGBA, tile image.Rectangle) error {
+	subencoding, err := z.byte()
+	if err != nil {
+		return err
+	}
+	if (subencoding > 16 && subencoding < 128) || subencoding == 129 {
+		return fmt.Errorf("unsupported ZRLE subencoding %d", subencoding)
+	}
+
+	var palette [][]byte
+	paletteSize := int(subencoding & 127)
+	for i := 0; i < paletteSize; i++ {
+		pixel, err := z.pixel()
+		if err != nil {
+			return err
+		}
+		palette = append(palette, pixel)
+	}
+
+	switch {
+	case subencoding == 0:
+		for y := tile.Min.Y; y < tile.Max.Y; y++ {
+			for x := tile.Min.X; x < tile.Max.X; x++ {
+				pixel, err := z.pixel()
+				if err != nil {
+					return err
+				}
+				setPixel(img, x, y, pixel[0], pixel[1], pixel[2])
+			}
+		}
+
+	case subencoding == 1:
+		fillRect(img, tile, palette[0])
+
+	case subencoding <= 16:
+		bits := uint(4)
+		if paletteSize == 2 {
+			bits = 1
+		} else if paletteSize <= 4 {
+			bits = 2
+		}
+		mask := byte(1<<bits - 1)
+
+		//every row starts on a new byte
+		for y := tile.Min.Y; y < tile.Max.Y; y++ {
+			var b byte
+			left := uint(0)
+			for x := tile.Min.X; x < tile.Max.X; x++ {
+				if left == 0 {
+					if b, err = z.byte(); err != nil {
+						return err
+					}
+					left = 8
+				}
+				left -= bits
+				index := int((b >> left) & mask)
+				if index >= paletteSize {
+					return errors.New("ZRLE palette index out of range")
+				}
+				setPixel(img, x, y, palette[index][0], palette[index][1], palette[index][2])
+			}
+		}
+
+	default:
+		x, y := tile.Min.X, tile.Min.Y
+		for y < tile.Max.Y {
+			var pixel []byte
+			length := 1
+
+			if subencoding == 128 {
+				if pixel, err = z.pixel(); err != nil {
+					return err
+				}
+				if length, err = z.runLength(); err != nil {
+					return err
+				}
+			} else {
+				index, err := z.byte()
+				if err != nil {
+					return err
+				}
+				if int(index&127) >= paletteSize {
+					return errors.New("ZRLE palette index out of range")
+				}
+				pixel = palette[index&127]
+				if index&128 != 0 {
+					if length, err = z.runLength(); err != nil {
+						return err
+					}
+				}
+			}
+
+			for ; length > 0 && y < tile.Max.Y; length-- {
+				setPixel(img, x, y, pixel[0], pixel[1], pixel[2])
+				x++
+				if x == tile.Max.X {
+					x = tile.Min.X
+					y++
+				}
+			}
+		}
+
+	}
+
+	return nil
+}
@@ -0,0 +1,124 @@
+package main
+
+import (
+	"bytes"
+	"compress/zlib"
+	"encoding/binary"
+	"image/color"
+	"io"
+	"net"
+	"testing"
+)
+
+// Plays an RFB 3.8 server without authentication whose 6x2 screen is sent
+// as a raw, a hextile and a ZRLE rectangle
+func fakeRfbServer(t *testing.T, conn net.Conn) {
+	defer conn.Close()
+
+	read := func(n int) []byte {
+		buf := make([]byte, n)
+		if _, err := io.ReadFull(conn, buf); err != nil {
+			t.Error("Unable to read from the client:", err)
+		}
+		return buf
+	}
+
+	conn.Write([]byte("RFB 003.008\n"))
+	if version := read(12); string(version) != "RFB 003.008\n" {
+		t.Errorf("Expected version 3.8 Got: %q", version)
+	}
+
+	conn.Write([]byte{1, 1})
+	if security := read(1); security[0] != 1 {
+		t.Error("Expected security type None Got:", security[0])
+	}
+	conn.Write([]byte{0, 0, 0, 0})
+
+	read(1)
+	var init bytes.Buffer
+	binary.Write(&init, binary.BigEndian, []uint16{6, 2})
+	init.Write(make([]byte, 16))
+	binary.Write(&init, binary.BigEndian, uint32(2))
+	init.WriteString("vm")
+	conn.Write(init.Bytes())
+
+	//set pixel format, set encodings with 3 encodings, update request
+	read(20 + 4 + 12 + 10)
+
+	var update bytes.Buffer
+	update.Write([]byte{rfbFramebufferUpdate, 0, 0, 3})
+
+	//raw: red, green / blue, white
+	binary.Write(&update, binary.BigEndian, []uint16{0, 0, 2, 2})
+	binary.Write(&update, binary.BigEndian, int32(rfbEncodingRaw))
+	update.Write([]byte{0, 0, 255, 0, 0, 255, 0, 0, 255, 0, 0, 0, 255, 255, 255, 0})
+
+	//hextile: black background with a red pixel at the bottom right
+	binary.Write(&update, binary.BigEndian, []uint16{2, 0, 2, 2})
+	binary.Write(&update, binary.BigEndian, int32(rfbEncodingHextile))
+	update.Write([]byte{hextileBackgroundSpecified | hextileAnySubrects | hextileSubrectsColoured, 0, 0, 0, 0})
+	update.Write([]byte{1, 0, 0, 255, 0, 0x11, 0x00})
+
+	//ZRLE: a solid green tile
+	var compressed bytes.Buffer
+	z := zlib.NewWriter(&compressed)
+	z.Write([]byte{1, 0, 255, 0})
+	z.Flush()
+	binary.Write(&update, binary.BigEndian, []uint16{4, 0, 2, 2})
+	binary.Write(&update, binary.BigEndian, int32(rfbEncodingZrle))
+	binary.Write(&update, binary.BigEndian, uint32(compressed.Len()))
+	update.Write(compressed.Bytes())
+
+	conn.Write(update.Bytes())
+}
+
+func TestRfbClientCapture(t *testing.T) {
+	conn, server := net.Pipe()
+	go fakeRfbServer(t, server)
+
+	client, err := newRfbClient(conn, "")
+	if err != nil {
+		t.Fatal("Handshake failed:", err)
+	}
+
+	img, err := client.Capture()
+	if err != nil {
+		t.Fatal("Capture failed:", err)
+	}
+
+	red := color.RGBA{255, 0, 0, 255}
+	green := color.RGBA{0, 255, 0, 255}
+	blue := color.RGBA{0, 0, 255, 255}
+	white := color.RGBA{255, 255, 255, 255}
+	black := color.RGBA{0, 0, 0, 255}
+
+	expected := [][]color.RGBA{
+		{red, green, black, black, green, green},
+		{blue, white, black, red, green, green},
+	}
+	for y, row := range expected {
+		for x, pixel := range row {
+			if got := img.RGBAAt(x, y); got != pixel {
+				t.Errorf("Expected %v at %d,%d Got: %v", pixel, x, y, got)
+			}
+		}
+	}
+}
+
+func TestRfbClientRefused(t *testing.T) {
+	conn, server := net.Pipe()
+	go func() {
+		defer server.Close()
+		server.Write([]byte("RFB 003.008\n"))
+		io.ReadFull(server, make([]byte, 12))
+
+		reason := "too many connections"
+		server.Write([]byte{0})
+		binary.Write(server, binary.BigEndian, uint32(len(reason)))
+		server.Write([]byte(reason))
+	}()
+
+	if _, err := newRfbClient(conn, ""); err == nil {
+		t.Error("Expected the handshake to fail")
+	}
+}
@@ -0,0 +1,216 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"image/png"
+	"net/http"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Values of the result label of screenshotsTotal
+const (
+	screenshotCaptured = "captured"
+	screenshotCached   = "cached"
+	screenshotFailed   = "failed"
+)
+
+// PNGs of VM consoles by console UUID. Requests for the same VM wait for one
+// capture instead of each opening a tunnel.
+type screenshotCache struct {
+	lock    sync.Mutex
+	entries map[string]*screenshotEntry
+}
+
+type screenshotEntry struct {
+	lock  sync.Mutex
+	png   []byte
+	taken time.Time
+}
+
+var screenshots = &screenshotCache{entries: make(map[string]*screenshotEntry)}
+
+// Returns the PNG of the console of session, taken at most ttl ago, and
+// if it came from the cache
+func (c *screenshotCache) Get(session *ConsoleSession, ttl time.Duration, capture func(*ConsoleSession) ([]byte, error)) ([]byte, bool, error) {
+	vm := session.ConsoleUuid()
+
+	c.lock.Lock()
+	entry := c.entries[vm]
+	if entry == nil {
+		entry = &screenshotEntry{}
+		c.entries[vm] = entry
+	}
+	c.lock.Unlock()
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.png != nil && time.Since(entry.taken) < ttl {
+		return entry.png, true, nil
+	}
+
+	image, err := capture(session)
+	if err != nil {
+		return nil, false, err
+	}
+
+	entry.png = image
+	entry.taken = time.Now()
+	c.expire(ttl)
+	return image, false, nil
+}
+
+// Forgets screenshots which are too old to be served
+func (c *screenshotCache) expire(ttl time.Duration) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	for vm, entry := range c.entries {
+		//entries being captured are locked and stay
+		if entry.lock.TryLock() {
+			if time.Since(entry.taken) >= ttl {
+				delete(c.entries, vm)
+			}
+			entry.lock.Unlock()
+		}
+	}
+}
+
+// Opens a tunnel to the console and returns its screen as PNG
+func captureScreenshot(session *ConsoleSession) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), cfg.Screenshot.GetTimeout())
+	defer cancel()
+
+	ctx, span := tracer.Start(ctx, "console.screenshot")
+	defer span.End()
+
+	logger := log.WithFields(logrus.Fields{
+		"vm":       session.ConsoleUuid(),
+		"xen_host": session.XenHost(),
+	})
+
+	conn, err := initXenConnection(ctx, session, logger)
+	if err != nil {
+		failSpan(span, "Unable to open the xenserver tunnel", err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	conn.SetDeadline(deadline)
+
+	client, err := newRfbClient(conn, session.ClientHostPassword)
+	if err != nil {
+		failSpan(span, "RFB handshake failed", err)
+		return nil, err
+	}
+
+	img, err := client.Capture()
+	if err != nil {
+		failSpan(span, "Unable to read the screen", err)
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+func writeScreenshot(w http.ResponseWriter, r *http.Request, session *ConsoleSession) {
+	logger := requestLogger(r).WithFields(logrus.Fields{
+		"vm": session.ConsoleUuid(),
+	})
+
+	if session.IsText() {
+		http.Error(w, "Text consoles have no screen", http.StatusBadRequest)
+		return
+	}
+
+	image, cached, err := screenshots.Get(session, cfg.Screenshot.GetCacheTtl(), captureScreenshot)
+	if err != nil {
+		screenshotsTotal.WithLabelValues(screenshotFailed).Inc()
+
+		logger.WithFields(logrus.Fields{
+			"error": err,
+		}).Warn("Unable to take a screenshot")
+
+		http.Error(w, "Unable to take a screenshot", http.StatusBadGateway)
+		return
+	}
+
+	if cached {
+		screenshotsTotal.WithLabelValues(screenshotCached).Inc()
+	} else {
+		screenshotsTotal.WithLabelValues(screenshotCaptured).Inc()
+	}
+
+	w.Header().Set("Content-Type", "image/png")
+	w.Header().Set("Content-Length", strconv.Itoa(len(image)))
+	w.Header().Set("Cache-Control", "no-store")
+	w.Write(image)
+}
+
+// Decrypts and checks the token of an API request. Answers the request and
+// returns nil if the token is no good.
+func sessionFromToken(w http.ResponseWriter, r *http.Request) *ConsoleSession {
+	token := r.URL.Query().Get("token")
+	session, err := NewConsoleSession(cfg.Server.EncryptionKey, cfg.Server.EncryptionIv, token)
+	if err != nil {
+		tokensTotal.WithLabelValues(tokenDecryptFailure).Inc()
+		recordFailure(r)
+		http.Error(w, "Invalid token", http.StatusForbidden)
+		return nil
+	}
+
+	if !session.Validate() {
+		tokensTotal.WithLabelValues(tokenInvalid).Inc()
+		recordFailure(r)
+		http.Error(w, "Invalid token", http.StatusForbidden)
+		return nil
+	}
+
+	if session.Expired(time.Now()) {
+		tokensTotal.WithLabelValues(tokenExpired).Inc()
+		http.Error(w, errExpiredToken.title, errExpiredToken.status)
+		return nil
+	}
+
+	return session
+}
+
+// Screenshot of the console a management server token is for. The token
+// is not redeemed, it can still open the console afterwards.
+func handleScreenshot(w http.ResponseWriter, r *http.Request) {
+	session := sessionFromToken(w, r)
+	if session == nil {
+		return
+	}
+
+	writeScreenshot(w, r, session)
+}
+
+// Screenshot for the admin API. The body is the tunnel information a token
+// would carry: clientTunnelUrl, clientTunnelSession and, if the console
+// needs it, clientHostPassword.
+func handleAdminScreenshot(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "Method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	var session ConsoleSession
+	if err := json.NewDecoder(r.Body).Decode(&session); err != nil || !session.Validate() {
+		http.Error(w, "Invalid console", http.StatusBadRequest)
+		return
+	}
+
+	writeScreenshot(w, r, &session)
+}
@@ -0,0 +1,52 @@
+package main
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestScreenshotCache(t *testing.T) {
+	cache := &screenshotCache{entries: make(map[string]*screenshotEntry)}
+	session := &ConsoleSession{
+		ClientTunnelUrl: "https://172.31.0.46/console?uuid=9389b857-7a15-a4eb-63dc-50e09b262838",
+	}
+
+	captures := 0
+	capture := func(*ConsoleSession) ([]byte, error) {
+		captures++
+		return []byte{byte(captures)}, nil
+	}
+
+	image, cached, err := cache.Get(session, time.Minute, capture)
+	if err != nil || cached || image[0] != 1 {
+		t.Fatalf("Expected a new screenshot Got: %v %v %v", image, cached, err)
+	}
+
+	image, cached, _ = cache.Get(session, time.Minute, capture)
+	if !cached || image[0] != 1 || captures != 1 {
+		t.Errorf("Expected the cached screenshot Got: %v %v", image, cached)
+	}
+
+	other := &ConsoleSession{
+		ClientTunnelUrl: "https://172.31.0.46/console?uuid=11111111-7a15-a4eb-63dc-50e09b262838",
+	}
+	cache.Get(other, time.Minute, capture)
+
+	//a ttl of 0 always takes a new one and forgets the other VM
+	image, cached, _ = cache.Get(session, 0, capture)
+	if cached || image[0] != 3 {
+		t.Errorf("Expected a new screenshot Got: %v %v", image, cached)
+	}
+	if _, ok := cache.entries[other.ConsoleUuid()]; ok || len(cache.entries) != 1 {
+		t.Error("Expected expired screenshots to be forgotten Got:", len(cache.entries))
+	}
+
+	failed := errors.New("tunnel refused")
+	_, _, err = cache.Get(session, 0, func(*ConsoleSession) ([]byte, error) {
+		return nil, failed
+	})
+	if err != failed {
+		t.Error("Expected the capture error Got:", err)
+	}
+}
@@ -2,14 +2,21 @@ package main
 
 import (
 	"crypto/sha256"
-	"crypto/tls"
 	"encoding/base64"
 	"encoding/json"
 	"net/url"
 	"regexp"
+	"sync"
+	"time"
 
-	"github.com/Sirupsen/logrus"
-	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Console protocols of XAPI
+const (
+	protocolRfb   = "rfb"
+	protocolVt100 = "vt100"
 )
 
 type ConsoleSession struct {
@@ -18,15 +25,109 @@ type ConsoleSession struct {
 	ClientHostPassword  string `json:"clientHostPassword"`
 	ClientTag           string `json:"clientTag"`
 	Ticket              string `json:"ticket"`
-	locale              string `json:"locale"`
+	Locale              string `json:"locale"` // keyboard layout of the guest, US if empty
 	ClientTunnelUrl     string `json:"clientTunnelUrl"`
 	ClientTunnelSession string `json:"clientTunnelSession"`
+	ViewOnly            bool   `json:"viewOnly"`
+	Protocol            string `json:"protocol"` // protocol of the XAPI console, rfb if empty
+	VmName              string `json:"vmName"`   // shown in the page title, the console UUID if empty
+	Zone                string `json:"zone"`     // selects the branding of the page
+	Expires             int64  `json:"expires"`  // unix time after which the token cannot be redeemed, 0 never
+
+	correlationID string            // request ID of the /console request which redeemed the token
+	spanContext   trace.SpanContext // span of that request, linked from the websocket span
+	proxy         *ProxyServer
+}
+
+// Sessions are accessed from the HTTP handlers, the proxy loops and the
+// re-authentication loop, so the map is guarded
+type SessionStore struct {
+	lock     sync.RWMutex
+	sessions map[string]*ConsoleSession
+}
+
+var SessionMap = &SessionStore{sessions: make(map[string]*ConsoleSession)}
+
+func (s *SessionStore) Get(id string) *ConsoleSession {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+	return s.sessions[id]
+}
+
+func (s *SessionStore) Put(id string, session *ConsoleSession) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.sessions[id] = session
+}
+
+func (s *SessionStore) Delete(id string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	delete(s.sessions, id)
+}
+
+// Returns the proxy currently serving a session, nil if there is none
+func (s *SessionStore) Proxy(id string) *ProxyServer {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	if session := s.sessions[id]; session != nil {
+		return session.proxy
+	}
+	return nil
+}
+
+// Returns the proxies of all sessions which are connected
+func (s *SessionStore) Proxies() []*ProxyServer {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	var proxies []*ProxyServer
+	for _, session := range s.sessions {
+		if session.proxy != nil {
+			proxies = append(proxies, session.proxy)
+		}
+	}
+	return proxies
+}
+
+// Makes proxy the one serving the session and returns the previous one
+func (s *SessionStore) Attach(id string, proxy *ProxyServer) *ProxyServer {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	session := s.sessions[id]
+	if session == nil {
+		return nil
+	}
+
+	previous := session.proxy
+	session.proxy = proxy
+	return previous
+}
 
-	wsConn  *websocket.Conn
-	tlsConn *tls.Conn
+// Removes the session once its proxy has finished, unless another proxy
+// took over the session in the meantime
+func (s *SessionStore) Release(id string, proxy *ProxyServer) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if session := s.sessions[id]; session != nil && session.proxy == proxy {
+		delete(s.sessions, id)
+	}
 }
 
-var SessionMap map[string]*ConsoleSession = make(map[string]*ConsoleSession)
+// Returns a snapshot of all sessions keyed by session ID
+func (s *SessionStore) List() map[string]*ConsoleSession {
+	s.lock.RLock()
+	defer s.lock.RUnlock()
+
+	sessions := make(map[string]*ConsoleSession, len(s.sessions))
+	for id, session := range s.sessions {
+		sessions[id] = session
+	}
+	return sessions
+}
 
 // Decrypts a token string and returns a session struct
 func NewConsoleSession(key, iv, token string) (*ConsoleSession, error) {
@@ -54,6 +155,10 @@ func NewConsoleSession(key, iv, token string) (*ConsoleSession, error) {
 }
 
 func (s *ConsoleSession) Validate() bool {
+	if s.Protocol != "" && s.Protocol != protocolRfb && s.Protocol != protocolVt100 {
+		return false
+	}
+
 	//check if a valid session is given
 	r := regexp.MustCompile("^OpaqueRef:[a-f0-9]{8}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{4}-[a-f0-9]{12}$")
 	if !r.MatchString(s.ClientTunnelSession) {
@@ -81,6 +186,42 @@ func (s *ConsoleSession) Validate() bool {
 	return true
 }
 
+// Returns true if the token of the session can no longer be redeemed
+func (s *ConsoleSession) Expired(now time.Time) bool {
+	return s.Expires != 0 && now.Unix() > s.Expires
+}
+
+// Text consoles are served by a terminal instead of noVNC
+func (s *ConsoleSession) IsText() bool {
+	return s.Protocol == protocolVt100
+}
+
+// Returns the template of the page which shows the console
+func (s *ConsoleSession) Page() string {
+	if s.IsText() {
+		return "terminal.html"
+	}
+	return "console.html"
+}
+
+// Returns the UUID of the VM console from the tunnel URL
+func (s *ConsoleSession) ConsoleUuid() string {
+	tunnelUrl, err := url.Parse(s.ClientTunnelUrl)
+	if err != nil {
+		return ""
+	}
+	return tunnelUrl.Query().Get("uuid")
+}
+
+// Returns the xenserver the console is tunneled through
+func (s *ConsoleSession) XenHost() string {
+	tunnelUrl, err := url.Parse(s.ClientTunnelUrl)
+	if err != nil {
+		return ""
+	}
+	return tunnelUrl.Hostname()
+}
+
 //The UUID for a session is the SHA256 of its TunnelSession and TunnelURL
 func (s *ConsoleSession) GenerateUuid() string {
 	hash := sha256.Sum256([]byte(s.ClientTunnelUrl + s.ClientTunnelSession))
@@ -1,6 +1,9 @@
 package main
 
-import "testing"
+import (
+	"reflect"
+	"testing"
+)
 
 func TestCreateSession(t *testing.T) {
 
@@ -14,14 +17,14 @@ func TestCreateSession(t *testing.T) {
 		ClientHostPassword:  "n7t8eu4O_rrOHOLICneCrA",
 		ClientTag:           "d1225441-5ed6-40a6-b08c-e46fe4a3cadd",
 		Ticket:              "lVnfsfYS2I4mJ6JYiL2OlKY9hUE\u003d",
-		locale:              "",
+		Locale:              "",
 		ClientTunnelUrl:     "https://172.31.0.46/console?uuid\u003d9389b857-7a15-a4eb-63dc-50e09b262838",
 		ClientTunnelSession: "OpaqueRef:d965e329-c32b-2c9c-a33c-66cafe6214c3",
 	}
 
-	result, _ := NewConsoleSession(token, key, iv)
+	result, _ := NewConsoleSession(key, iv, token)
 
-	if *result != *expected {
+	if !reflect.DeepEqual(result, expected) {
 		t.Error("Fail")
 	}
 
@@ -0,0 +1,107 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"sync/atomic"
+	"syscall"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/sirupsen/logrus"
+)
+
+var causeShutdown = DisconnectCause{Code: websocket.CloseServiceRestart, Reason: "The console proxy is restarting"}
+
+// Unix nanoseconds at which draining ends, zero while not draining.
+// Accessed atomically.
+var drainDeadline int64
+
+func isDraining() bool {
+	return atomic.LoadInt64(&drainDeadline) != 0
+}
+
+// Returns the time left until remaining sessions are closed, negative if
+// not draining
+func drainRemaining() time.Duration {
+	deadline := atomic.LoadInt64(&drainDeadline)
+	if deadline == 0 {
+		return -1
+	}
+
+	remaining := time.Until(time.Unix(0, deadline))
+	if remaining < 0 {
+		remaining = 0
+	}
+	return remaining
+}
+
+// Refuses new consoles once draining started
+func rejectWhileDraining(handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if isDraining() {
+
+			log.WithFields(logrus.Fields{
+				"url": r.URL.Path,
+			}).Debug("Rejecting request while draining")
+
+			w.Header().Set("Retry-After", "5")
+			refuseRequest(w, r, errDraining)
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// Waits for SIGTERM or SIGINT, drains the proxy and closes done once the
+// server has been shut down
+func handleSignals(server *http.Server, done chan struct{}) {
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, syscall.SIGTERM, syscall.SIGINT)
+
+	sig := <-signals
+
+	log.WithFields(logrus.Fields{
+		"signal": sig.String(),
+		"drain":  cfg.Server.GetDrainTimeout().String(),
+	}).Info("Draining")
+
+	drain(server, cfg.Server.GetDrainTimeout())
+	close(done)
+}
+
+// Gives active sessions up to timeout to finish, then closes the rest with a
+// close reason for the browser and shuts the HTTP server down
+func drain(server *http.Server, timeout time.Duration) {
+	atomic.StoreInt64(&drainDeadline, time.Now().Add(timeout).UnixNano())
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+	for drainRemaining() > 0 && len(SessionMap.Proxies()) > 0 {
+		<-ticker.C
+	}
+
+	proxies := SessionMap.Proxies()
+	for _, proxy := range proxies {
+		proxy.Stop(causeShutdown)
+	}
+
+	closeTimeout := time.After(5 * time.Second)
+	for _, proxy := range proxies {
+		select {
+		case <-proxy.Done():
+		case <-closeTimeout:
+		}
+	}
+
+	log.WithFields(logrus.Fields{
+		"closed": len(proxies),
+	}).Info("Drained, shutting down")
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	server.Shutdown(ctx)
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+func TestDrainClosesSessions(t *testing.T) {
+	defer atomic.StoreInt64(&drainDeadline, 0)
+
+	SessionMap.Put("drain", &ConsoleSession{})
+	defer SessionMap.Delete("drain")
+
+	browser, _, proxies := newTestProxy(t, "drain")
+	proxy := <-proxies
+
+	drain(&http.Server{}, 100*time.Millisecond)
+
+	select {
+	case <-proxy.Done():
+	default:
+		t.Fatal("Drain returned before the session was closed")
+	}
+
+	browser.SetReadDeadline(time.Now().Add(time.Second))
+	_, _, err := browser.ReadMessage()
+	if !websocket.IsCloseError(err, websocket.CloseServiceRestart) {
+		t.Error("Expected a service restart close frame Got:", err)
+	}
+}
+
+func TestRejectWhileDraining(t *testing.T) {
+	defer atomic.StoreInt64(&drainDeadline, 0)
+
+	handler := rejectWhileDraining(func(w http.ResponseWriter, r *http.Request) {})
+
+	w := httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/console", nil))
+	if w.Code != http.StatusOK {
+		t.Error("Expected 200 Got:", w.Code)
+	}
+
+	atomic.StoreInt64(&drainDeadline, time.Now().Add(time.Minute).UnixNano())
+
+	w = httptest.NewRecorder()
+	handler(w, httptest.NewRequest("GET", "/console", nil))
+	if w.Code != http.StatusServiceUnavailable || w.Header().Get("Retry-After") != "5" {
+		t.Error("Expected 503 Got:", w.Code)
+	}
+	if !strings.Contains(w.Body.String(), "<h1>The console proxy is restarting</h1>") {
+		t.Error("Expected the error page Got:", w.Body.String())
+	}
+
+	//noVNC gets the reason in the close frame
+	server := httptest.NewServer(handler)
+	defer server.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/vnc/session", nil)
+	if err != nil {
+		t.Fatal("Expected the websocket to open Got:", err)
+	}
+	defer ws.Close()
+
+	if _, _, err = ws.ReadMessage(); !websocket.IsCloseError(err, closeDraining) {
+		t.Error("Expected the draining close code Got:", err)
+	}
+
+	w = httptest.NewRecorder()
+	handleReadyz(w, httptest.NewRequest("GET", "/readyz", nil))
+	if w.Code != http.StatusServiceUnavailable || !strings.Contains(w.Body.String(), "shutting down in") {
+		t.Error("Expected the readiness check to fail Got:", w.Code)
+	}
+}
@@ -1,4018 +0,0 @@
-package main
-
-import (
-	"bytes"
-	"compress/gzip"
-	"encoding/base64"
-	"io/ioutil"
-	"net/http"
-	"os"
-	"path"
-	"sync"
-	"time"
-)
-
-type _escLocalFS struct{}
-
-var _escLocal _escLocalFS
-
-type _escStaticFS struct{}
-
-var _escStatic _escStaticFS
-
-type _escDirectory struct {
-	fs   http.FileSystem
-	name string
-}
-
-type _escFile struct {
-	compressed string
-	size       int64
-	modtime    int64
-	local      string
-	isDir      bool
-
-	once sync.Once
-	data []byte
-	name string
-}
-
-func (_escLocalFS) Open(name string) (http.File, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	return os.Open(f.local)
-}
-
-func (_escStaticFS) prepare(name string) (*_escFile, error) {
-	f, present := _escData[path.Clean(name)]
-	if !present {
-		return nil, os.ErrNotExist
-	}
-	var err error
-	f.once.Do(func() {
-		f.name = path.Base(name)
-		if f.size == 0 {
-			return
-		}
-		var gr *gzip.Reader
-		b64 := base64.NewDecoder(base64.StdEncoding, bytes.NewBufferString(f.compressed))
-		gr, err = gzip.NewReader(b64)
-		if err != nil {
-			return
-		}
-		f.data, err = ioutil.ReadAll(gr)
-	})
-	if err != nil {
-		return nil, err
-	}
-	return f, nil
-}
-
-func (fs _escStaticFS) Open(name string) (http.File, error) {
-	f, err := fs.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.File()
-}
-
-func (dir _escDirectory) Open(name string) (http.File, error) {
-	return dir.fs.Open(dir.name + name)
-}
-
-func (f *_escFile) File() (http.File, error) {
-	type httpFile struct {
-		*bytes.Reader
-		*_escFile
-	}
-	return &httpFile{
-		Reader:   bytes.NewReader(f.data),
-		_escFile: f,
-	}, nil
-}
-
-func (f *_escFile) Close() error {
-	return nil
-}
-
-func (f *_escFile) Readdir(count int) ([]os.FileInfo, error) {
-	return nil, nil
-}
-
-func (f *_escFile) Stat() (os.FileInfo, error) {
-	return f, nil
-}
-
-func (f *_escFile) Name() string {
-	return f.name
-}
-
-func (f *_escFile) Size() int64 {
-	return f.size
-}
-
-func (f *_escFile) Mode() os.FileMode {
-	return 0
-}
-
-func (f *_escFile) ModTime() time.Time {
-	return time.Unix(f.modtime, 0)
-}
-
-func (f *_escFile) IsDir() bool {
-	return f.isDir
-}
-
-func (f *_escFile) Sys() interface{} {
-	return f
-}
-
-// FS returns a http.Filesystem for the embedded assets. If useLocal is true,
-// the filesystem's contents are instead used.
-func FS(useLocal bool) http.FileSystem {
-	if useLocal {
-		return _escLocal
-	}
-	return _escStatic
-}
-
-// Dir returns a http.Filesystem for the embedded assets on a given prefix dir.
-// If useLocal is true, the filesystem's contents are instead used.
-func Dir(useLocal bool, name string) http.FileSystem {
-	if useLocal {
-		return _escDirectory{fs: _escLocal, name: name}
-	}
-	return _escDirectory{fs: _escStatic, name: name}
-}
-
-// FSByte returns the named file from the embedded assets. If useLocal is
-// true, the filesystem's contents are instead used.
-func FSByte(useLocal bool, name string) ([]byte, error) {
-	if useLocal {
-		f, err := _escLocal.Open(name)
-		if err != nil {
-			return nil, err
-		}
-		return ioutil.ReadAll(f)
-	}
-	f, err := _escStatic.prepare(name)
-	if err != nil {
-		return nil, err
-	}
-	return f.data, nil
-}
-
-// FSMustByte is the same as FSByte, but panics if name is not present.
-func FSMustByte(useLocal bool, name string) []byte {
-	b, err := FSByte(useLocal, name)
-	if err != nil {
-		panic(err)
-	}
-	return b
-}
-
-// FSString is the string version of FSByte.
-func FSString(useLocal bool, name string) (string, error) {
-	b, err := FSByte(useLocal, name)
-	return string(b), err
-}
-
-// FSMustString is the string version of FSMustByte.
-func FSMustString(useLocal bool, name string) string {
-	return string(FSMustByte(useLocal, name))
-}
-
-var _escData = map[string]*_escFile{
-
-	"/static/images/alt.png": {
-		local:   "static/images/alt.png",
-		size:    339,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0pIgzMEGJI+8bE0GUmxJ3u4uDP9BcMHe5ZOBIpwFHpHF
-DAzcwiDMyDBrjgRQkL3E09eV/S67BI+EmJXOpVig0ANPF8cQj+NvJ+3lZTLkcZOdoBfdcEQp4ICASUA8
-o3EKuwSzyoqbDUJcGsGHGaYf+ucjrKJS9Kf8iedKodrzfb5TTv5Rl6p8mrv4nZPLsusKgjP/BHm5uJyz
-ZH7A0fDY0uL5mcUXtquesQkIZd3AsCEkNPVZ3VwXYZupDBHHH+gZf19U8HWznxNHLgNTQrnyXeYHq1ZF
-zfd4xxYv4prZk7ni1R+1hLSezKjs0+uvX6rOZ5yzlY1B/f3ev692u6TIHDbrtMx9ZNUftPD6IyeOuenM
-948tSFzUkKiTMHtShwfngy/ftNKNJJWUdjE8dA+M8ark/wr0K4Onq5/LOqeEJkAAAAD//+Elt+hTAQAA
-`,
-	},
-
-	"/static/images/clipboard.png": {
-		local:   "static/images/clipboard.png",
-		size:    501,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wD1AQr+iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAABvElEQVRIidVV
-sYriUBQ9LxnDw4zFwBQWWkv+YeoULoJNyjDT+RGCIPoLFoqdW4idxW7hL1gv2NgsWA1JkxiIycudykVj
-NC8yzLCnueSQe0/OO+/lAV8AJvviaDR69H3/D+eciEjZ7/d/u93uy90is9nsh23bv2zbbgJArVaD7/tP
-hmH8NAwDALBer9+DIHjbbrfHnt+FnczncyqVSlBVFbvdDkEQoNFooFKpAAA2mw0YY6hWqyAixHEMy7Iy
-52WSi8VCWJalHJ/H43FSr9eVcrkMIgJjDIfDAWEYotVq/etbLpdot9sXM5U00e/3XzVNc0+5TqejACDX
-dclxHHJdl8IwpFMBANA0Db1ez07PfEgTuq4nnPPnNN9sNnM3iaIo0HX9kk8TnHMwJr3pzsAYkxNRVfUu
-gVu4EEljtVpJ1SOyPvJCRAhxJmCaplTN6pdyYppmoXoNucv1GbgpIptHOpdCIrJ5FF6uJEnODknRPNL9
-uU4+C9+TSRRFICIAxTMhIkRRlC/ied7ZgSqSiRACnufliziOc913DpIkke+fTCZ0D6bTKWXNu/pPHw6H
-FMextAshBAaDwX13xH+DD1CVOFftyZqAAAAAAElFTkSuQmCCAQAA///K2GPQ9QEAAA==
-`,
-	},
-
-	"/static/images/connect.png": {
-		local:   "static/images/connect.png",
-		size:    404,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wCUAWv+iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAABW0lEQVRIie2V
-PU7DQBCFv3EcgkRDgRANFRUFFUdIQYFyAC6ABAVXQByJIgUSggpOQEFDnZRQACYhfhReh5Wz/kmgAClP
-Gsl6O/vejFezC0sEIKkV4q2QZMCqmb2XiGzU+HwCb8DYzJSTcSDxSNIJ8AEosF4FA16BM0lDM3vJSb/S
-deAauAOuFjQ5AHaBQzMbw2wnMZAAl2Z2M6dBXmgC7ANrwDNAFMoDOosYOHScxrSBkMmvY2ny903yuUh/
-oJnvLZ34FGgDPUkjsuEymkEuek5jWmjRRGT3zylw7PF1Rv7NEAP3VHRijrPCWt31YoXv2OeKZ5IAj15y
-HlEgF4/3c3EaSaiCrGRpE9gDWq6DFNgCLoAdvv91BDwB58DQM0uBBzMblnRdDkl9ZZi4kKT+3EIVBl1J
-A81iIKlbtz/0aIWwDdwC4wLfdmuVaDQDklbIziiEiZmNmuj8f3wBRfaLJiqqPjkAAAAASUVORK5CYIIB
-AAD///eYBzGUAQAA
-`,
-	},
-
-	"/static/images/ctrl.png": {
-		local:   "static/images/ctrl.png",
-		size:    354,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0pIgzMEGJI+8bE0GUmxJ3u4uDP9BcMHe5ZOBIpwFHpHF
-DAzcwiDMyDBrjgRQkL3E09eV/S67BI+wjvyVSypAofeeLo4hHsffTnL0YnYUcOVOdNjcuaPb27bz+QeW
-Ju6DsQUPeW2azAsWdmzvqGjl4enNm9UoIjuz6mkak5ascPKdr9Fh/x9919/nsTDgxENbJSVrJSXfy29m
-3pSoYEtzYMp6YLj9S3ZSj3MC05mzb4JC+Z05mte99mto9/Xd96JRurz8QdXVQ+n79s+8aWnAemKmz927
-wV7Hs//rGLPnJfccTvW8d/fu6133/nZ3ruqrs7oeNmlN1vW5HR6hocv/X3su8djuA5OK8e6Aq4G3t7HX
-7n4nbvVgrX/NlsBDAocOnFlXZcz6zIHZRtckrYxtj1729V3pOiJclXYMvNvzfr446nsL6HUGT1c/l3VO
-CU2AAAAA//+TtxBmYgEAAA==
-`,
-	},
-
-	"/static/images/ctrlaltdel.png": {
-		local:   "static/images/ctrlaltdel.png",
-		size:    317,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wA9AcL+iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAABBElEQVRIie2V
-MU4CQRSGv7czSOcVbLkDsfUARm9CZWtiaeUNbOngEjR0tBaUhsoCNjK7v4UsCZEZ1iGaaPiaaf5938vu
-2zfwX7DcByXdAgNAkboV8GBm41wHkp51mAlAkW357LQVx0jaoN+QcKzkrG3G5xqqqho551LTKSB/sk78
-fbbTIek8kglmtpTUBbqRzNrMVjGJ3wgegZtI5q0sy2tJT2bW2xeQtJB0ZWaLmAhJ08SSW0vqS5onMktJ
-F7H6zR//Hu0AAlBvzlRm38rfkfwojST78vqOJEUnhGBAJ5VJFWgW5D1wBzh2360DXrz3s7quh0VRXPL1
-2zhgBry2aPjEYT4ALNmsx9NEkwkAAAAASUVORK5CYIIBAAD//xhool89AQAA
-`,
-	},
-
-	"/static/images/disconnect.png": {
-		local:   "static/images/disconnect.png",
-		size:    1378,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wBiBZ36iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAAFKUlEQVRIibWW
-TUxUVxTHf+e+x3wgtYJ1DFVrlLDqwk8sbRoFNdXR+pGaYaMzjFHRxEhk1UXjRzEaV2rKwpSKytCFBmyq
-gqOJqZM2rRqssYmLTnU00SBhEkHxY5iB924XvmcJReymN7mLd+4953/+53/vO1cYYwSDQW8gEFgqIp9r
-rT8SkWKtdZGI9Gqtu0Xkuta6PZ1OX47H49k3xZHRjBUVFWZJSckmy7J2AVPGSsQZXYZh7EulUk2JRGLo
-rSCRSGSKYRg/aq3nOqZWrXWDbdu3WlpaMoANqHA47FdKzRaRHUAIQERuWpa1NhaLdb0RZPPmzfOGhobO
-A5OBKx6PJ9zY2Ng9BgMBdDgcnmEYxndAJdBjmuaqY8eO/f4vkEgkMkUp1ekA7D958uTukRG11u+NtHV2
-dvrLysoyAFu3bm3I5XJVQI9t22UuI4FXGsyYMeOq1nrunDlzOmpra4uBLKDHYEEmkzH8fr8FcObMmWnt
-7e3TZ86c2Xfv3r13DcP4I5VKLUgkEkMmgCPyXNM0f6mtrX0f+BW4NBqIZVliGIZ+8uSJZ8KECTmAgwcP
-rk8mk5+KiN3V1ZU/bdq0Zw8fPpxVUlKyKZFIfKuCwaDXOUUsX758OzAAnBWRCyISHzlN07wQiUR+Liws
-PCsi8Y0bN85PJpPrAWzbfhoIBDp27tz5l5PQrmAw6FWBQGApr45p67p163qc7L2jVEcA6urq/C0tLS8c
-HfcA9c56P9BcX19/rKioKOv1es8DUwKBwFIFrHREbRir/rw6ReMOHz6ccQGUUnsd3ydKqebm5uY6gFwu
-p6ZOndro+K1UQLlD9dZYCKFQqGA4AxfAYXDy+PHjtQCPHz/2ejweu7Ky8razXq5EpBjAuWijlqimpia/
-tbX1OUB1dfVuB8ACeoczWL169TsTJ07MApSWlmYBRKRYaa2LnID2aCUKhUIFjY2NL10AEfnaWXth23aL
-y6Curs5/7ty5Z65jIBCwnFIWKRHpdezK3WBZ1muRXQbRaHSvA2ADT23bPhGLxXa6pXS1cn17enqUw6RX
-aa27AcLhsB/nXhiGYY0UGdjjZPZUKRVzAYYn4voCJJNJr7O/W4nIdQCl1Oz+/n4N5D1//vyLWCy2QGu9
-eP/+/c2uyEqpl9OnT/+pqanprNZ6STqdXnHo0KFPtNZLtNaLtdaVwCog78qVKx86uNdMrXW7iGwTkR3j
-x4/fNjAwYBcUFGwBNnZ0dBh37txRAOPGjWPhwoW+qqqqNcCaXC7HpEmTXms3TEcT+O3Ro0dbnO8OlU6n
-LwNdQOjo0aMf+Hw+BcjFixfNtrY2BZCfn8+iRYt0VVUVgBocHFQej0c5OionsDulr6/Pn8vl1gJd6XT6
-sorH41nDMPYB3Lhx4wjw56VLlzh9+rSICAUFBVRUVBAKhQQgm82Sl5eHU28XRIZNGhoaih199sXj8awC
-SKVSTSJy07btRdu3b/efOnUKpVRGRDKFhYU/hEKhDUBqcHBQeb2v/zhKRFLABmAp8Bmw7MCBA9/fv39/
-sojcTKVSTfCGfqK1vioi80TkmxMnTnwJkMlkLvp8vmX8c58UcEFEVroxotFoPfAVI/rJ67sRi8W6TNNc
-BfSIyMcicjsvL+8IQG9v7wqfzzdrWHDXb67WeklNTU1xNBq97AKYprlqeAt+a4/XWrdVV1ffLS8vL/V4
-PAOGYWjLsiSXyxl3794NtLW1jX/w4MF8+I893h3/+2tl+HDfXbxqB+Uj313ANaDjbe+uvwGINGqkcKi0
-egAAAABJRU5ErkJgggEAAP//kqCrLmIFAAA=
-`,
-	},
-
-	"/static/images/drag.png": {
-		local:   "static/images/drag.png",
-		size:    963,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wDDAzz8iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAADiklEQVRIie1V
-PU8jVxQ9981bBwKJbQLGGKSsBEgDWBs+gtykQAIpDpYrQgOiRSn4B1tESEiInoYuIKWhculULsGSC2O+
-MRFBA6yRAC/YIMAz76ZYxvIH+9HsKkWONNLMnffOufe+c2eAzwxm/uFza3w50HNBwzAaIpHIq3w+j2Aw
-CF3XEY1GcXJyAgDQdR1+vx9bW1swTdNsamr6e2Bg4JyI1HN8sjKQTqe/jcVif1qWFZRSYnd3F9lsFmdn
-Z8jn85BS4ujoCLlcDplMBqZpYn9//5+9vb2/APwGAMysEZH1dE9llTCztry8/Prq6mo2HA6r+vp6xGIx
-pFIpBINB0dXVpQBgc3MTa2trCIfDcLqceHP2Rqyvr8Pn88Hlcl0Fg8HvmNlFRG+Z+WtR1jsi6/r6+pe2
-tjZ0dHTA6/WKoaEhAIDH44HH4xEej0d4vV5YlgVd1/Hy+5ciEAgol8ulMpmMMgyjYXFxkQ8ODn59otUE
-M9eUCvn9/hfpdBqnp6dF8qmpKfh8PgBQzKyam5sxOjoKTdMAQAHA3d0ddF3H+Pi4YmYkk8lXzCwBWIKI
-7ktFhoeHf2xtbVXRaBSPj49K0zTq7u4WTqcTzAxmRmNjoxgcHBRSSij17qx9Ph8aGhrgdDqF2+3G8fGx
-E4AG4LGsXUU3SEkbGxuUSqWImQGAn9oJIYSdvbJjRIRQKITe3t6no2Vks1kHgAIRmUWR7e1tBwDMzs7y
-xcUF9fX10fn5OTEz4T1WLzEMHA4HNE2DUopN04SU8hvb0kUL9/T0PM7NzbHf78fIyAgcDkcxyw+BiMDM
-UEpBCCHy+by6vLyErusv7DVl7crlcggEAqirq4OmadA07aMi9jnZ6zKZDAzDwOTk5M/PigBAOp0uI6hM
-vPJiZiIiYmayLIvj8Ti1tLS8Ld1UNvELCwt0c3PDtbW13N/fD6ouo6osIYTdKhweHiIejyMQCPxRukar
-3JRIJGYfHh5+LxQK3NnZSVJWfXnKoJQCEaFQKGB1dZXdbndhenr6p/dWYmNlZUUws2JmjI2NQUpZ1vfK
-AokIhmEgkUhwKBR6zcxfEdHDB7OzMTExwclkkm1YlsWVUEoxM3MkEuGZmZnkJxFXYmlpqUhuE1aKmKbJ
-8/Pz76aWucpMz058KXZ2doo2fSIpe09EuL+/x+3trf1c9U/5qEh7e3uZwHNzI4RATU1NVfx//HfxLwBu
-2OxnTEG+AAAAAElFTkSuQmCCAQAA///uGdMGwwMAAA==
-`,
-	},
-
-	"/static/images/esc.png": {
-		local:   "static/images/esc.png",
-		size:    385,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0pIgzMEGJI+8bE0GUmxJ3u4uDP9BcMHe5ZOBIpwFHpHF
-DAzcwiDMyDBrjgRQkL3E09eV/S67BI+kyFnLJgMGBkY+TxfHEI/jb6ds9DriINBmrjRvW9Jr5ohqmTAx
-s6beufsakp4K/+Axs+uYejaUveyRtMSU1gwvS2HDlpl/j6VNXGDZW5W6r6z+2+ybktd2zps3b77HnOJz
-Z3LSFDwn/fcSe16bKM0d26y0iTWGOfgBr8TqV3nGwk4WDHlJzwxbCv4UZkx8r3Jg78yZq949r9ynpHT7
-QKhzQVYaW8/qVVH6p4t4d/xQylysWMmWJShZ9Z5d19VmxsyrpXsvRqVO93PiUD+QvSasMTr0bqbHkTM6
-pzc9tfcGWl1tLzAxkeOhzql5z25xFmgqLTbxne6SIqJavjktSOSoVsG/1TNXvJIz6JxtyqqmtOnnS0/+
-x2V7j7y1erV/0bx5eXuY9S2C+T/U/ASGDAODp6ufyzqnhCZAAAAA///4uGz4gQEAAA==
-`,
-	},
-
-	"/static/images/favicon.ico": {
-		local:   "static/images/favicon.ico",
-		size:    1150,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/2JgYARCAQEQrcCQwcLAIMbAwKABxEAhoAhEHAyAcksYIBgGOjo6/lOKgcaQhdH1M2lo
-/+fdduA/Azs7hAaKMcorQthMTGAahtnTsv+3dHVB9DMy/mfW0ILLMXJwQGhBIbA6sH5mZriZMNwItZ9Z
-UQnFbJAciGYNDEOIAe3g3bofTMP1NzaC9XM2dYLVMKmooegHYWZNqJ8YmTDth+oH2+XoCtcHt9/DByGG
-x/3I4mB/MzBiijGxYIRfYztl8Qdzv8ABATCfLZQNzgbRyJiRjRGvfhZbFrhamH6YOmQ2Lv3ImFz9TCpM
-ZOsH5i0UtdjchEs/ueHf1tZGjbxLEQAEAAD//1Jse7F+BAAA
-`,
-	},
-
-	"/static/images/favicon.png": {
-		local:   "static/images/favicon.png",
-		size:    453,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0gIgzMEGJOU//08EUozFQe5ODOvOybwEctiSvN1dGMDg
-p/PueiDFWeARWQykl4IwY8GRe6FABnuJp68r+21GaUFruas8S+qAprh6ujiGWJxeOvmg12EHEdfzPVdZ
-lqrKP2w/0qpw9H1B4kHXQI2O20bS5k6sqx+wlzxepVAiWKC6Q6x8647rDtkbM28VGk6ecUFLil1O8t2e
-8uf3zCudNJf+PK7FaCLV63T0bJow5wPWeUs3Vf8+e2yT6Vw/XsaG658nzDaaLvBLcaH8vxVK4YE7V/25
-6eZlYCUp+srZS+D+0Z7ox3cSr+2PEL6/3+mR8SsXl7kdOt+0WR23z2Fy3N7joJOgmrlBJaua71zUsUPf
-rmVFLTD8f+2dH5sw2+fVzn9eTQirZjoUtaO+w9KuSHLPmaJPfPwxv5p3Ks7QPzXfZ01Td/bj3bXbJnpb
-7P+zoHRXXmjjNYUjk+S+zE9/c31/3IFS3zD9jpd3JIo0fTee8+l7HMG09uHHTLe1//LyNkeuz5/1Ltdl
-0mQTk8xFU7QTlm8M0FhawumgGVoxx56xW7KTq8icpREUAZ6ufi7rnBKaAAEAAP//M0S6hsUBAAA=
-`,
-	},
-
-	"/static/images/fullscreen.png": {
-		local:   "static/images/fullscreen.png",
-		size:    851,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wBTA6z8iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAAABmJLR0QA/wD/
-AP+gvaeTAAAACXBIWXMAAAsTAAALEwEAmpwYAAAAB3RJTUUH3wMCCQsm/D3RuAAAAuBJREFUSMfdVkFr
-4kAUjrqH2oVSCsVCLx561pMUobTgsRfZf7HX/oASIY4agonOH/DgueyhS6E3D1YoPZiKNAhBPEh1jLON
-LUMQY2YvnRJiarOF3cN+MIeZefN9eW/mvZfQZDJBlmUR7hWRSCSCEBpcXV19Oz8//8UFhCAIO6enpz9i
-sVh8uVwu2Xo0Gv3KUQ8QQmNZllPcJyDLcgohNPZyhr2Gw+GwN5vN1M+IzGYzdTgc9lY2vKqO4ziNRuOC
-5/mwj+27g+f5cKPRuHAcx/FycpRSapqmSQgh7o1ms/lzHal3vNq/gRBCTNM0KaWUwxhjSZISEMLMdDo1
-mDdMiOf5zQ882GQC7Nx0OjUghBlJkhIYY8wpinLIwqEoSno0Gj16PLoEAMT87gAAEGs2m5du+9Fo9Kgo
-SppSyjgPV2IuSVLCLWTbtg0hPPETgRCe2LZtuwUkSUowL33BNqvV6jFCaGxZllWv18/Wvah6vX5mWZaF
-EBpXq9VjdyjXilBKOQhhplarfQ/ydGu12ncIYcbLwRDyirxthEKfSRVfjjD3D/D/iHwJEtu/5kmhUNgV
-RTEehEQUxXihUNgN/DIopVyxWNxXVbWFMcaVSuVoXVmpVCpHGGOsqmqrWCzuB8oTQRC2VVVtsSw2DGMi
-y3LKT0CW5ZRhGBNmq6pqSxCEba9ImOf5KJvkcrmNbDZ7nUwm02yNEPK8WCxe/D5qsVi8EEKe2TyZTKaz
-2ex1LpfbYDnC83yU63Q6t/l8fgsAsNftdu/c1bTf7/fK5XJyXS8pl8vJfr/fc5/rdrt3AIC9fD6/1el0
-bjlKKW232zeapt27q6mu61qpVIp/1KwopVypVIrruq65z2uadt9ut2/empYXuq4/AAB2g3REFnsAwK6u
-6w9+fCsig8FAF0XxIGjbdV+wKIoHg8FA93KuJCMh5Hk+nz+514IWy/l8/uR+CO/+SLB4CoKw8ydZLQjC
-jvdeGX4DAVjf/s5kvHYAAAAASUVORK5CYIIBAAD//x4v3kZTAwAA
-`,
-	},
-
-	"/static/images/keyboard.png": {
-		local:   "static/images/keyboard.png",
-		size:    1283,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wADBfz6iVBORw0KGgoAAAANSUhEUgAAACsAAAAZCAYAAACo79dmAAAEyklEQVRYhe2W
-228bRRTGv5n1XmyvL0lsx7HjxokbaAktRTwhqFTekFCpxCN/B7z3tX9FRZQg8UIJbkIvqICUJtBSFXpB
-veEovu86djaRnfi2Z3jIRW6cuq2U0pd+b9/OmZnfnDkzO8AbvRHY8wKEECoAGQB/hRwEoMUYa/QK6oI1
-TTO8sbHxpaIop4eGhj4EsAxA2i/2ACUA2ADa2Wz2O2rStWq9emNiYqLZs9fDBw//XltbE69TlmXVbt++
-/dVetq5s1et1W1XVV7nlL6RGvZHRnNqhzm9dsEII0WMMsadPTy8AwV4ifq8YY0+1OXqAgYiQTqfJWl1D
-IBBANBbh6VSaLMvCQDCA4ViUpZfTZJVX0T/Qj+FDMV4o5MksmvD6vYiPjqJYLAqzYMLr9WAkMQrDMEQx
-b8Dr9SCeiKNcKotcLgevx4v4WJxz/uxN7bndtWoNF5MX8e030/j5ylUwMDE3O4epySlcuXwZEMDs7Cym
-Jqfx09wlQAjMz1/H1OQU5pKzaLdaWNj2sxe3/OL1BUxPTiE5M4PNzU0sLC5ienIaP16YQa1a67Wr3bBE
-ZHd6RVagqioUhwJACEVWoKnatoeQZRmKJsPhkAAAsixD1TQoigoGwCErUFUNiqyCgUF2OKBqKhyyDAYG
-iXFo6pbfw5Hfy9ZVL7ZtNznn8nYHZDMZWl9bRzAYxOBQmGeWM2StrSIYDCE8FOaZTJYqqxUE+voQjcW4
-YRhUNEz4fV6MjIzwkmlSvliEz+dFfCTOS6US5fP5LR8f5aVSiQr5PFwuN8YSY4xzzrbn/leSpMMvDLuj
-ZqNJ9+7eQ71ex/H3jsPlduHunbuoVWs48s5R9Pf38ft37pFlWRg9PIZIJMJTT1JULBQRDAUx/vY4z2ay
-lF5aRv9AP45MHOUl06THjx5D9+h499gxbBfr7oEjopQkSYlOjp4HbEfZTBY/fH8B9XoD/j4/gsEgkjNJ
-rFvr+KzRwIkP3qfkTBKVcgUnPzmJyJnT4uqlK3jyJIWxxCjG3xqn3xcWcWPxD8RiMSTGE3Tr5p+4dvUX
-+Af6EAgEEYlG9k3eS8PKsgyP7oUs1SHLCiRJgu5yg1o2XE4XJM7h8frQqDfh1JyAAHPrHqG7PXC53BBC
-QHO64HLrcLt1AIDL6YTu9kB363DIDggBsOf8I/crgxbnfHcRAgKCiHKZPOy2jWhsGA7ZgVw2h2ajgWg0
-CtWpcqNgULVWw+BgCLpH56sViyrlCnw+HwKhAb6+vk4lcwUej47QYIhvbmxSIZ+Hpmk7WeWdtES0JEnS
-2EvB5nI5Khnm7um17TYAgEsOMAA22RBCgHMJnDHYgiBsApc4OOMgQSAicL7jBYhsMMYgcQlCENrtNiKR
-CELhwd3biYiykiTFOtn2K4OnFjD/2zxu3bwFSdoeRxzQe4YBW+cJsG3Cxyc/wudfnOmM6Lpz98tsm3Mu
-7fhKuYKl1BKxjtCDwN0hISL4/D4WHgozj9ez2/5CZUBENmPstT9khBApzvlTV1cX1KMHDzf/P6Rn65/7
-9+efG2QYxtcrpZVyq9V6LW/ZVqtFlmX92mw2T+xl27f8zp8/7z916tSnw8PDFhH5GWPys2IPSkIIAtAg
-osK5c+f+Onv2bPVVzvdGnfoP8SgWyMGr5bcAAAAASUVORK5CYIIBAAD//9rSRA4DBQAA
-`,
-	},
-
-	"/static/images/mouse_left.png": {
-		local:   "static/images/mouse_left.png",
-		size:    511,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wD/AQD+iVBORw0KGgoAAAANSUhEUgAAABYAAAAZCAYAAAA14t7uAAABxklEQVRIidWU
-P87aQBDF39qwxtK6okJCPkaKlLkEScsJQCgWEpILoABZ/BEVEhXKAZIL5AQ5AxVVFKMtLAws8m6qj7A2
-KyD6EiWvm9G+3+yOZgf430TyiTAM1eFwQLlchlIKQggAgOM4AAAhBKSUoJTCtm2cTidFCCFRFBEjeLFY
-fOWcv/sSf7jk3tc/IwiCfHH0+/1LPJvNoJRCp9O58Kxrw263e3sNBQDGWOGZlUpFi9vtNtI0ldc5DUwp
-1R0AXNctgG/lHMfRWFogpURej+ayLNNiDSyEKDj2+30BkqZpIXc8Hs2tsG27YOCcPwSmlGqxNhWDwSDj
-nBPGGCGkMIm/TIRAKQUAyLJMAVCMMXS73cvNSteG8/mMyWRiJhrqACDD4dDcipdbPKOXD5T3WrcOP6N8
-b18NbNJfA79aoTzo+x8Be573aTwe/xbIdV3zrgiC4GMcx09De70efN//ZgQDgO/7P6Ioehi6Wq1AKd03
-Go03dw9Pp9NsNBqpe5rP5yoMw+wWw/h9l8ul3Gw2pF6vw/M8JEmCarWKUqmEOI6x3W5Rq9VUq9W6OUl3
-98J6vVZJksCyLEgpIaUEYwzNZvPZnfKP6ycl880uNX/MKgAAAABJRU5ErkJgggEAAP//uffAM/8BAAA=
-`,
-	},
-
-	"/static/images/mouse_middle.png": {
-		local:   "static/images/mouse_middle.png",
-		size:    517,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wAFAvr9iVBORw0KGgoAAAANSUhEUgAAABYAAAAZCAYAAAA14t7uAAABzElEQVRIidWU
-v4oiQRDGv57RHgd64MBIkHmMCy7c+JKNzH0CRU4EoQM1UAf/YCQYySb3EvcE9wxGXnLcyASDo45M90Xr
-2TM26rK73H5ZFf39qruoLuCjiaQTnHO52+2Qz+chpUQcxwAAy7IAAHEcQwgBSilM08ThcJCEEOJ5HtGC
-Z7PZjyAIHjjnp9xwOMT3X49K8a+fntDpdE7xZDKBlBKNRuPEM84Nm83myzkUABhjmWcWCgUlrtfriKJI
-nOcUMKVUdQCwbTsDvpSzLEthKYEQAmndmkuSRIkVcBzHGcd2u81AoijK5Pb7vb4VpmlmDEEQ3ASmlCqx
-MhXdbjcJgoAwxgghmUn8ZyIEUkoAQJIkEoBkjKHVap1uljs3HI9HjEYjPVFTBwDp9Xr6Vjzf4h49f6C0
-17h0+B6le/tqYJ3eDfxqhdKg328CdhznaTAYvAhk27Z+VzSbzW++798NbbfbcF33pxYMAK7r/vE872bo
-YrEApXRbqVQ+Xz08Ho+Tfr8vr2k6nUrOeXKJof2+8/lcrFYrUi6X4TgOwjBEsVhELpeD7/tYr9colUqy
-VqtdnKSre2G5XMowDGEYBoQQEEKAMYZqtXrvTvnP9Ret88yzNFJpLwAAAABJRU5ErkJgggEAAP//SbtI
-3gUCAAA=
-`,
-	},
-
-	"/static/images/mouse_none.png": {
-		local:   "static/images/mouse_none.png",
-		size:    497,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wDxAQ7+iVBORw0KGgoAAAANSUhEUgAAABYAAAAZCAYAAAA14t7uAAABuElEQVRIidWU
-wYriQBCG/060Y6Bz8iRIHmMO8yLefQI9rAhig+jBEBLEk+BJ9jn2CfYZPHlaNpJDMGqku/cwzGAnNqPL
-7DD73/6i66uiqC7gfxMpBzjn6ng8ol6vQymFoigAAI7jAACKooCUEpRS2LaN8/msCCEkDMMK603L5fLH
-ZDJR1wqCQJU1Ho81H8exiqJIXbOsa7Pf758551oxxlilgUajofl+v488z6URTCnVMwC4rlsB34o5jqOx
-NCOlRFn3xoQQmtfARVFUMg6HQwWS53kldjqdzKOwbbuSkKbpXWBKqea1FZlOpyJNU8IYI4SYt4cQAqVe
-lkAIoQAoxhiGw+FbZ7XrhMvlgiiKzERDHQBkNpuZR/HaxSN6/UDlXOvW40dUnu2HgU36NPCHFSqDfv0T
-sOd534Mg+CuQ67rmWzEYDL4lSfIwdDQawff9n0YwAPi+/zsMw7uh6/UalNJDp9N5evdxHMdiPp9XDnxZ
-i8VCcc7FLYbx+65WK7ndbkm73YbneciyDM1mE7VaDUmSYLfbodVqqV6vd3OT3r0Lm81GZVkGy7IgpYSU
-EowxdLvdR2/KF9cf/tPw3lddBSIAAAAASUVORK5CYIIBAAD//3FUFbjxAQAA
-`,
-	},
-
-	"/static/images/mouse_right.png": {
-		local:   "static/images/mouse_right.png",
-		size:    513,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wABAv79iVBORw0KGgoAAAANSUhEUgAAABYAAAAZCAYAAAA14t7uAAAByElEQVRIidWU
-saoaQRSG/9nVWRdmKytBFvISt7hl6vRWt/EJBMkiCNtooS6KWAlWcl/htnmCPIOksApZmcDiqiM7c6tr
-nF0HNdyE5O/OYf7vzBzOHOB/E8knwjBUu90O5XIZSikIIQAAjuMAAIQQkFKCUgrbtnE4HBQhhERRRIzg
-2Wz2hXP+MQzDU240GiEIgnxxvPx8OsVPH16glEK73T7xrHPDZrN5PIcCAGOs8MxKpaLFz98+IU1TeZ7T
-wJRS3QHAdd0C+FLOcRyNpQVSSuR1ay7LMi3WwEKIgmO73RYgaZoWcvv93twK27YLBs75TWBKqRZrU9Hr
-9TLOOWGMEUIKk/jLRAiUUgCALMsUAMUYQ6fTOd2sdG44Ho8Yj8dmoqEOANLv982teLvFPXr7QHmvdenw
-Pcr39t3AJv018LsVyoO+/xGw53nPw+Hwt0Cu65p3RRAEn+M4vhva7Xbh+/5XIxgAfN//EUXRzdDFYgFK
-6bbRaDxcPTyZTLLBYKCuaTqdqjAMs0sM4/edz+dytVqRer0Oz/OQJAmq1SpKpRLiOMZ6vUatVlOtVuvi
-JF3dC8vlUiVJAsuyIKWElBKMMTSbzXt3yj+uVwz3zS6iYp+SAAAAAElFTkSuQmCCAQAA//9389pLAQIA
-AA==
-`,
-	},
-
-	"/static/images/power.png": {
-		local:   "static/images/power.png",
-		size:    390,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0pIgzMEGJI+8bE0GUmxJ3u4uDP9BcMHe5ZOBIpwFHpHF
-DAzcwiDMyDBrjgRQkL3E09eV/S6njKCFoR5fVCMDA6Owp4tjiMfxp2GBXIcMeHyvrv/LbX0m78F7PwOJ
-uAXsAWX9dw/9reJYb/iBKevPB96kjMtTDQVqAuJum1SaZB+SMb5860bKPHtBweb0w6n3XOTKsu+mbC0R
-dXmibMZf/ejfmWsZMyuf8HfsN1zrPqf72EYWK8GCjg6epLtPu7Yc+qnxbSvHQbuFc7WDOkRkp+qqKLW6
-/VB33eN1UUZwptX63IXXSxm+M96O3Oiy/s/Z66ZLV/nssbH1mrhJP3rFo/kr5ss+K96ouohdcK+BefdH
-vnvpYt/ifs5WMi+YqhmkWR/8hCnPyFXqaX5dxWq/JOXdfmu6trNs0rK7tjvL5rLujrhbu51iLdpcYyPq
-lM+fCWepaVp/Qz/I8I7+P48/O09Ix6UucJtyNM0CGGIMnq5+LuucEpoAAQAA//+s6qVOhgEAAA==
-`,
-	},
-
-	"/static/images/screen_320x460.png": {
-		local:   "static/images/screen_320x460.png",
-		size:    12778,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/7x6Z1BT0bdv6BBKQKoQQLGggIDSOyKKhaIUAenSVELvHUSaNOnSRZTeQboJIQICUqRD
-gCQEpEnvEJJ38D/zPrxv9867Fydjzt47a/3WWr+9ysyJfqqtwQjmBoNAIMZHD9V1QSAyVeDzi5YaWPky
-70oH/EfmrquhBqocgK4AD9Qvn2iog0jn/z59L0oGVuhcHhq7g0D0rOcfMlBmNhewSOPxSOs+DZqOnUWA
-2ZcHCpy7BHqkflffZ3p91jvA1DDncHuw2dvvd2ymAaxHKC0n92O18Qsj85tbIqFUarOq5Fc9ONTo3n39
-+oH5wiUyNUj1VZub9JRJTykgthSwS74cUXqmi05TSbbGtVfLfv0KJHL1GyUt/mh5I3EYhKlKt3h30aG8
-edE/eGnGffC2w0SlJzPL9uUnWarqbCECAmBVFhaqT1FR5E/V1cluCHwGsbE8A72PugG6//93+39T1/n2
-/4iupgtGPyHeUBprhgZH/5fWcvF6hDo2UsNtu15+xyuJF5hUrRkoKChgU7VfCQ5aNORIUMl7NqkxKfWC
-3/g56m5W7u7di3g8fo9veVEVQ/ZAQwNJprkC42dQF2B5U/rmYdEIJXmoL/qBAH3SMyBMZkNPPAfn+Lxy
-GuhzJVyTUgqgLlxc5C0hvMxG/JdStZ41fo53kdUUTc9qTkD67SyoPO+0iUW6QlsOvUZkhrofomnLyZ+D
-Hl0CUz6x4YnTghWQWXJWU33mKdAff65raHH4zgC3DbuSWMvQXmlm9lcpUCzbrItM9t7XMEYC9ZGS5C3n
-z6Wlq8WPu1yz8UztGZmtBQUFsOm2aE1ralFyAdt6RsYPVHCyA/OsMd4yukiURJA31ScR9tb8fNxqOIGG
-mob6Yjn/u1TzZhvNUrAhWRGqvZCMaVNRs/SOp36yU0N/q9qlPY/Doea65JlOOVnEu8yWuuanRu3GWEnZ
-PWIJJ7rm0dULnSGGrEZpmaLf3ek6GJ6StbFQv6BZ/vs328ROk/LeIdzJC7IekZCEcIlN3w4XoKgP25WO
-nKXxqgsmBVHl3gApocgehBi2Y0vCYHPVvl1269sRFs46Yq0a3iiEln6G+Yp0MUg0Mueewsr6ugHte/Ih
-iVTLfMRap/iorPogPNc4D9vF1dXdPTXVyCq69PPMF5/ouPBhE9XG5uW5/tLGRr07HtDB/i4yNDh8NtI7
-ud/Onvdt1rjzW04t/SDzijThdt9vqAFeBc/de2whuayUuXQ8E87ZYwn7h/sS45LURXov8Kd/TgUSKZ53
-WC5eomGCPtUzAg7uenAxxrph20MDcO5Qihz/Uz+c7cIpaxwkvmWpI95R4afNHb83AEVzkygGInkVvMSV
-lYlfDOLxsgxXaS79SV7Hgxwupf+scVyTlgq/T7bcDDLNqVqQdp4jfGEfUCWBJE9fOXNZsvlQMzAx1jpj
-lHklPoP2Upg/vE1MZImIiMitPkZPoNMhqgizqy92SVOzs1rSWQb/gFlRRBOCD/b22PLWcnq2hsQlT2Cn
-sqycdAoqPaifP6Ocon5+pk/Zoxum2ktv39SF6Lc01WsqnvhtYZEFfCT5YW5JmxxY0MuwqV6QiSDRu0Ss
-gLeuLZsjt6ghFEYUSNS8bsbIf2HbHPQnJC8xz9imJIdKEWPLLpuPQOTpC3nGlnvPrEdfxqJTukYEnCnS
-mmkHoof+rq0Je9FFFdOZvrFS1EChUHPOwwO/Jyc/xks9A/n9Xl4uln5L/nSU0quotPT9+Ph4p8pNjW6j
-dDOZpcEeuzp6Ymuux/CjbiW9/Tazu7diNCaUeqslDsGHRsm974+UQWFI7Od3P2ORUpA6UU6ntyILTyHo
-Bs5AnkW3Zt9TbW7qK2/IY/6L2eS/lXtyLWN/P/hQyz50/QZLfNZexom7f8Nf4yRVbl7e8GWBIGfgqKHb
-INLyEsVkHQEHxrZcITWmz309FciIFs/SItqFZseTPQOd+Asakd2huFjseVHx2ghfru1ies7RlVr1URoj
-8gvsdJMrdWKOIfkl7J9OWAHWNF6vxj8102NsDZg88PF4cV0IBCyqbKtDvTQlac7c9NpBEK3Am1wMq5bM
-VJ8wC25yb2qlqicE/X+AgrHFPJdwLkY0YTdAZ8WlhWD4WpjSPMi63aIQnv3wqngXVpab8wpYFRN97eUX
-TXDQGs6umuJdiApyj0Xh27dvgKh9CuAqpkNfOTpccwm+xM+Udgjnp55UVV4QrHHMUhfIX9VhC4H3I6KT
-Ed+/8cIp/2awQc1pVfw/r54O/HYu0r1JHhpSqgIYbIBbSOi09qj3tITb7XhHrN9hums//OzghouweNDe
-oZOT0zjZJbBqD4YlvhtfbvyNGk5HII9KcU2WoZ2hD3D+g2h4MyY0S/hwrQ6b0LHS7mVI90CAZUB2naGh
-Cq2NsTjFkn+KI+wimqNDXD+e7TbSX/QKV+EYgsBoNsge2BFruroezG8fpWzemi8BxXbjj1kFJPsKTb3+
-sPrfphjjCnNEH9u9mryv58Gxv18DN3Q3kYjLeCA99z3R1QFaGlIX8uATd1Tqz0RJdXiCs+DxZdGzhchW
-6L0nT+hXPLi7XdmC5mdBDJ1qX0gfuwiNSEsuHn5rcYVloViRvhd774drb+mu6RlNGHV+kpf1czEXtrH+
-xRqheoNtPkVP2wrd4LjnuY+cXfRvpwq7m5mZWfguT+2p4sWQeDxznPprs9kXARXAnUMO3J3Iayy+pdCN
-rBWZlp9+X2V75yJTZRYCpJjLimBmFjas/FJZGT8woN3V1dW7YVlWVlYAh/P11RbGHusdRQtSuBRSoB2j
-Ft1iVFxnAnI/uh+fCZL4fr9K0dNRSg2jgbhtYe9GREZe5OG5knXru+9XtXzmxIfv1AXuenpKIS2rm0js
-frrgX9PfHDi/lbtBuw1olY0lficgSAuYAjZnaa2qrdFRdguDkK9QG/Ojc8mL1VrsQbpvJJn+K43Gw9cv
-PvQwx9snDU4FpNmx9GStSX983fWxwyHNtv/UPr32WPbB0u5kNlEuSGWOc80HLDIandlj4+MxS+3mLnTH
-vdx8VtdUpmPwYH22L7cSLhf4QJbvz4/48QJtSWuGNSKRFm98eCpkzmLtedpb2/IxNiFh9+cVnp9VvuIm
-xtoq6+oi6FyDat66m9KuZPKTE4PwQJqL3MqaQducwS/cOx6//B1usWmFLETVaoVIWOQsinm35DVPHvNH
-qVjT8xbl/EULf74dAZPuy51R9pKXe/4LV6fsEZSdt55npWDP+7msm8v/15k9ZqeXxenI7MNiHKPtM0Zu
-65+x9j67S13HOwpd9me73t+5K48wIivFZ19IAzXXUbegI6h7kVPo73k/as3HlRmVXS5n72q+ct6yP+I1
-pyFtdUDV++rJKcWlFQN0PqL5eP7ECfYHbd+hEdeJ5G+73GerovQw0CxVLIsszbbmqzv9JtFUmQClmTGl
-52u5oHxojyo9uLgKE4xM7q9yYPPe9+9oRdCsvThw3YRfEl590/sZLZDcIy7/18nqO/I2APQSt9tsIE6W
-KUvGK8nEN2pzFkIaZWKvKWiswFSeBZ5hZWP0zl7Q++40jMpsF6YjQ2Iofmn+Jew6hy/iW7fw85ldXCen
-Jxcf8c+bpWtmF9sd4FKmmEg7F2MaGhaN0qRsI/4cHyvtItAy8jhJB86we/fcN/REaNJsC2GDeCWx2NsM
-EP4/uP6Erc+H/C+3XH1wJR9iB9ocA6d2MiYr6b/b7b0doOWN04hWycV6TkDcJiJncoyQtDM+xvG7ysdc
-Rdn13OiGwN9Q0ewbJo3ri8lZ+VP9hrnFfBttfAfxi8u+e78DOtP7nllY6AQGnhHCP1HEU6mc3RzNqclo
-jxZkZgpXbK0SoW9wnv6xEXKZL2FketrPFDPGdS84WCVHQf4YSeGCy9B4TzG7pFDLK9/oeQcuHBwqtz+q
-xwnT+kSF0GyseL0oaBHCGIYtjHUPcVch4xyP/f0d4f0orcs0k/XXZ+CCG3Aybuc2BFyTOb1CV5uAanXq
-c+7WvH7vejKywXF1dfXP4ac7x6xybvKwlgkcdlyb/28RzDWLb5CK0dLDJmUgDN2Ed94almD36p6r7UY4
-PpEG8XYjG2BvctjblqcJj+6g3Ts0CWeDJPKIA6/EfrvAZ2R2dcYNTujefUP+koUZnymqY6lewbKqKov2
-QKY+cxCm7U2KZ0JF3kShV7O3PVAGxp1fDBoGfcTPJwueHKZ4PjF5JbKn7Q2NXJ1+LB1wp90xoVU2UUsZ
-xK0d3pdLtSbjvL33yODIvLVxHf8L0t73HLcq4hqiAadXmZLf3396Q11LS8uVJKK+20mWe0jWtD5mDUPX
-9skYnCavTzhmee/uQWTbVuQ2Tty/MmS1d3HB0IXfvkWvzZHdM+a8ZUVBia3DUPr7ROFwVoPPQ8NjHjgv
-Kgcduh4afCSrDJDQdFb18PAQIXi77JweDVchyyY27DfhgfCmmzs2ZD7FFemnVQpuD2Mg5NXVu8LCwlOH
-qlR5gVs/eeemckfrURAM0FbADv/S2cZajUT0t+FfPMSWotmssbmN7YPUhXBzY+NHN9heHL2BfVYZosTu
-eiP1JlFEOBlGOvJW38vD3Nm208BjVm1ecmX6djWSRt8OtfLeYMS6aC+pA6NCAsvWvElRMjPLnbl8RnN6
-sYRnPvPJjCE4LAGVtSl7sg90fJ38eYaMKj7QJCUToLWEQ4c2FqB+p/6+20/0z47vgOhfbnOVaL73Ns9C
-gFuaL1C/wfFshn03m7WpMx7UZwu3XP27Or+GCH2crmURolD59ntkEXxicvKhLNQ8aZOjJCSPxOyluCNr
-nRG7WdqODL2bpBNWlwyGWtB8wsvWe9a7DdozmkS+P5SxC0xSWQzcakgTmVU6VRphk+6qBrr4AZ8xK9HX
-npg9zyAaZxJxdAKFEweaBHrptNUeb25JFch4oOCTiT+O8DUIdJnnT3njTRhbCGudvQikEivnnCH7mpdT
-UM5lzNvSxZnzypUrucXEO3j835nmmLVKir6mV9fl2q18cF81EAaGho31WoPROJ3+qrcmonw2s3iTGAwa
-8qDOOPdUM1zrJlhk1Vfc9cL4qOH2Vo7/0UJWXdumcV0wxwioL5faWY5H+RTrpW0+cntxt69mpdX7MCjX
-KwZF1WdPItjjNknrRS6YeisxoL4B5fZrGfG6RRPUZ93Qasma5vilPcTT8xtctudOGkrPdODlbS+urlqi
-UE8Ek78kSJDGqnvVy+nkMK5tM2hGKY4pYFW0qczrIw4VGd390rYZQa8XyTi+iZ/rc5VZngJZbxVOtB/V
-Dzo5OFxJ70tV/2ZAi4SQuPlkX8uQ0A56hutnj0TdGu71NikMWCCog6oHLayp3adKumuWyIdcoS9ruFr5
-yhcwxNaEQRV0fyRIaqufB5ctLON6ojcu6puKbzhBNwVJOB+D9Qdxvhi06FsajSJ46uOvtNRhneIiGcyx
-7Tp8IVJaCnLQBJY4dUWllQq87PGM+/a+lyfSiNq46a3761K+btdXTiV4+sHIyAhTxTX5Z1qKp1oubtNW
-SxuztsZnjHuMH5Tc32WJesoodpCdNCINVOROvhcWFg4zsNMYUQcffUchHbMCdn0Kmoqp3ZuplKTJn/ju
-aFg/e1PwzfQ0mm/jePLvKTyWbHirLgH1vnFfFwG72OCY3j8g1VX93RvirURpZTqQ8QqrIhpe+XoM05sq
-XOPYelW4rKNK71TyaJHReMBvkHo824/84McVdzAqQ0paWtfYeCUmSyM5IRTmhZO/S/Hk+Bpvd76r75XD
-oINLCNKWLInNa/diNaFiK9/Z/qjjSusrB4dbMe87uO5NbIhqk9JZRIu9ZLa/knnNMG0S1/9RuES/dAH1
-wxW75400u2Jt6jH7/HnFitFQ2PcZA319tlZJAlsIvS/U5OvWfcMfa7643ct9f7sR7PLjGC96m5NTLii3
-0PGWOzSy5vftgwOv3RqxUMcZLY9VBtRX/qxm3Y/r6jm7v0s9DejkXZCpW111TL+bHQ179gx6h3P5ASvC
-FeMrdI9qpfbHYuf45+sO+4NMLXHqbwKEAuZNJ+sDD5/xVI96Vdoa/50aQQlv/tomgDlXbj+7pXzAv5V1
-S8OQbsXD7zd88ArkLr/GddWPZwN9pOPRQBdXE5lAmlMp/1iRzKRiENm04bfdxDLL0X78ZVaVnQOLdjrr
-MAatXxx67wUTb0cAo+aDN63N6/dZZYJnGPxXwjgJ6FEt29j8kpLXzzYjIBWRae6WQ7/WLxp2R+jM0feE
-mazwQ3Wv3SDStqsZsDFF1hCf98Qg3pZJ9MiGMDbOkOdkyYj5rCimb7Ycb1e0EQsJ3MxdixWYNQwtuvC7
-qbFx83Kg+nXaMET4Evzs4cjMbDhZx/4W1uJy42uJKZj25X9yn0o1Sm3FeHfkMTXxcIRRDPorEay/ouJn
-/d+H6gkhaVyKgz8sy10JwHVuZMKZxKtZ4FsFBRhgiDBPwsq0kwJYzE8rriQItaAfMjuFngalWC9F7Ntw
-+tC+e9ijmGpeTG4oYoJMzXPzFbh4iQ5xrAxdXrlZsor8SCAXy/mOPtp2ZMVM/ZLnF2+PtACZjbxorqio
-eMJ35G1KPkHycDoL7QqN50QF8nPjazQaU0fvawL84GZuNo3PzOQmuuh9bPUzY2LwuuTGFLAQEFlh0pg2
-+utKa8P6fGy6ja9dVmsRKTZUA0HZ6JR74nf1wuUMzbQf1ekQCyg2Hb00xC/rakjiVwY3trmFmLUeHgVJ
-v96+C9J+DZYZdV+RO26NqYzoBG2SuGIZGIYwGMQC+zu8LNDIjihN9gXPMF3mxC3JfrgY7o1x2fMHRgLT
-PSux7uHnzUn0AyE0B4MVKkOuWF7vjl3rWdjmdNHjp238IVRSfaUXZ9lwwiruMvafyC6QaDEB7LDZGu9H
-CiPnLYTjgcc6fhhdlx3augiKDSV5XyG8WRo9LC0tjerGX2NlWvF4f/LDzfwEvJxzj48neVOgKVAi++I0
-PqtzvHOJbFmWCfDLQYMX8YxXPKK1JhREOnGUVbijGRxMSjLQ6OKipqHpeb6xlJmOfdPAaR2y70I0GPHK
-tQUaMQR28MnAg3we64UqqextU67OMy0WfAhrSOtmaK5Wlqzinc9CT9t4Q8Lf3GKmfVpT9H63stszMska
-az2BHK8wSbc5IXSE/NXi8d5JE0O9r85qcFZWrSSCoJkHzz0995O03Ek2p9XNtlQ0pA/YeZ6ZW28Nbiwy
-MivxjpMoqRs8hECdp1qW1gxdXC+eV2GJCai8hlaF0FiKQTg0Nz1i1v/wFnGL2ZpLUVJ9TBjijvfduKdY
-R+cE919dC1UNAfJjEsO7js3Rud9dZvyxLlMJS9J5OXjVMyoggz/w3XO91SDKB2nvIHNCS/dLCfuiixI8
-Ygcb3oKOtgPF50a+X4VMO29uLKBl5k9WOChMd3Q0DIP7+/uvKZCscGrWxhxlOrBFHrHGowQEL13dVLB5
-kHU2ie9o+pq1h9N0SffiDzSZ0Stw0chNYpDDOx3PliqBDp1wYdF3jDwyom/Ym9zXRLV9HsUvVXpMxMq0
-OztHbGqbkEmygZ8Bfxw0RrLQPz8MHJr2jLcM/zyl0ybMOCDA6TljffANp1k2lk1qy23OepHpF9zHk/SW
-wV2BVK6LUszcvItYAcaYHps7exEQlwnejaxAWZf9OA3ExNycuTTNR7zsuytWmbpaigUUXkyt/HwUliJN
-xzQudk4yszQnY1AO5/P240vZ8MKB/lYnpgUGVmBWpgrylIOh575hDT7djshp9XbtNHT3wAaVFp+aQ0iz
-Svv709MvOnAuU9l74Y/hhZURvUjq3KCpmYlGFyGcRs7TqZEVNMBAQTIjH7Uvvr6sht/HP7N6x1DLMSmu
-j2xlt6A8vL3Flh+5F5OYC0rC2g7fRt8OEC1FhEZirzXyn83V2vb1Oo0vdCiXl1Xh2yF6ims7hM8ylTSP
-Kbwog1xlYIcrL9fmk0EitOFv9fke7/Y2csS0GBvqT7qcnVoO5op2dXcvnX25CeTSvzOo083Qk5DWaO24
-0KyqcNzrTSlJSWZweG/t/E3CgGD2cBeFBdN2on/CzIxLckg8Rd9gWDdJoi+3H61P9b6RgvlT8/doRCB6
-HuI/yxjvFVKEoJ8bnq+uc8C0KV/W/bhcjwgOGUtndO8v2dB2srZcNnRBfdezSFAX6Lw7uFjqEipbYy0l
-A//5J4KkwGIn2koLpUmzoMzzn70EqdoUIfII5DKoWjNopd5q+/vYFGPgGTJIYFpez5ggFqhJOJtMPHcK
-RADIeWl+tvty/skLySivqan5y8kgRNgItQyRcni+nU+PE0PPQlJE32E7Ld6WTTANfWs0Sf3kIwPMH/kC
-OTYYXivlPYd0wXwKnF3T/LWto8L+3ZPW9Zm2SIk5qqUKL84hrqN9e2woskSs++VtISEhRb1Z2fh7ItwF
-X0vj6gZDEXYzGK6b77AaQRQ6ZZMo+Jw5W+j8FHiI656GhoRFAM1fXtSsJh21coCEfly8S3WwpbZWUcfg
-opsc05fD3SydhWBBw+P0UEjQ8vIEPcq8TXeWrYVPdwU8DPSbWNikSo1A2lpnqsAWHqNWa88ZhooE2LhJ
-ZYn7pH31wpAZKnLz6+Rl5bVCO3rCqkR14Fq8HKT4m0jMQEVOAPZ65GM7ld+vkUvmIIi2w6loltHaZJng
-7k7hYqxTOa6t8Vk289isK5lkB/+mN9d0CUDGAjKjZQcTc4vuCQvbZr+WTy6H2wsyyGZhib8z3xy0BZ9Y
-OPM3n1ye8008d7kl6WwLfhpPrFyU/Wsr7dYM1dLq8yWUPRNt65mlinvUrdqvh8vNcoP5XVX5Che6u60P
-4nh7mQusm1WK609fdOZqylBWYQ5HK4OVZpVi4+L6T9RvUo6M5/gfz29+IvO2JjJslQhbwaZVEG6Lj98i
-PxUJZ6g1dtEVebpsjl2uPCvoQ24kNyxlkKhVjhdk0TKOnQ2OOYvzFSCRdzojveyKR2eg4Gmc07x+d2ja
-ExxGx315ekJijebvrb7PVVUJemIKv9bSWqtMD4IhToS8kiogtMMrHsYQ/wxHZq/6evexOhF4XefZ/OPw
-VeKMN8okLzCq38TrlafctjmII1PHcUzYF+lwnYlozIcPH2rboWFWnkQNDQ25GpqEv95odMYgGQ3xbz6P
-BKDTA0gTkoyMjJ2WWzEusr9W4w1MCbDJjV+uNZ70ytvuTeXfIylo6idbfERA9MgPmte4WlU6FILflVF1
-MX5YDTk0p+VphAkzjSvlFm5urJ/6dHkycUrOV+IH23UQTBD+jyfP72RcTX18vKoqov+6xic2wpR13R5J
-nIFymtAoE58U8830Iu+FlVJ2mrBb4n4YB7opWJyBGBJaNhVa5pmMknmtsUP5ghUtsbdtai8yVS7j4JAW
-RTlGF9q5k9ASg3hb4/r6+qPCFQU9WmCSSd4ox8lVyQ0b4DZ2L5kyQohiNjLedyqjWX84nJfR/KdRy3Mv
-RImVp1Mvo9ohTmFmOej4shN0rSv9JoJ6XvQJK4XpmeLHhi4WKSkpeO5SJfNbYZPMqb2wq2IA3tyLuZPj
-44+PZpWMD2DTy330MT5t5B7QyEXhNNeJNcZDqIuKya+bnMipCrkGJwzxHckcIKP1emTz6uLGwBOaSaJ9
-0/EgkM+Hhj4JhQeQNugEv7kNtorM+QtW5l0U9dhrgDMHBS1yrfHNltpV+wiC6NVwzHH7jKZnAwEf+w+4
-ZKijkI3O74jwoK6urvhj78bDjUUFROgNUTv/jKMMHQQLk5bwg2k0ekync/Tmvo7coo1TUEy/N78nht/o
-FTsBL+Wydj9ysq7oSd2jmMbz4Wh1de3oNOEzoXpiVhxMU+wvF0DMc/87WbNWrBDZxXWwju4eEePDfe0z
-ZfInGzejb6zMOvFT5BNMfOjjN1SXFfgGLV3GPX00UdQR2PoHPnmCdo5cut46Bbevkq629F1/97rEEZu6
-1AGqdALjZbcyB+95eFj2+nXE48Wl5R9MzIlWNnOI4J0qEZpibatpBRgHGEyECZxWkmp5J+OTr7EE6eTl
-pBQkpgJzzEWwro9BRWoM/aIDq+pQWZYK3X91LyVh5o9fpZau41YPJzKN1//noltnabmDIYREuCVbxWG4
-+PX21mkWriZMD1NaUtKjghHpnqkyMDCgbLiFry9CXtrYjMaCrI6Is0wof2+r0QvPQX4lL12gkfFjZUfD
-WkMUlXYxriETQZABJt4/liRQmu1tefleI3cZkifLBt+RVPsDn87uIjgMLR0XF9ei4Au9PDk5+nDjNxsn
-PNjJnZb8h3a4RhDYc7oerFpV45g1SpQfIHFVCmp3vh0MYPVyHoTEz9UH0Tuf0V6NbR28SuPcxlUY5yvK
-5P9s5PAoE1WxfouwMXLb3K97htPwDUHFBcX+hkoYpGR0S1LyFh6XwB1UP2Xb3Uyh43vZtPoCkbiFfzd4
-EX9iiPUL3f1+iPIm3dnfV72kam1dEN9hTI1bZTVpXvOUbpyxmMRMi34GZbslTTZoPel7rBxwa8Pusbgq
-1waRSbb5WRDxHm3L6xNtws9FsZ5DM63x8fHC269FRZ2Qlh/0tJUTD7PtjzZvH7IKRhmZKz8TEhY2Jn2X
-lVEB5ncojY+8og+/4pWVoM8jhHVXKxCIxfz8XQCLIGZimfOqw7ZP9ivJUpCHqysw5AHQngTSL9V3nITd
-ILtPJnum3rPoVl9ZvBS41MFnyhTvhS1ZCnOc6fcWb4FUHu383pSSkXGY7IPeq66V1wob+yEhlukf/Cll
-nGgmQlbl/PO9PlXPBrjybBpkERQWw9NkN00a+OwnSb0Bv8CX8fxsbGyMJVod4Ae4Xc4c++ZN6dRO62Vt
-xAIIMkPGUrTXMcR1KPOiS0KD6P/qVYpm19rXysts4AsMUzFu7kLYfdZOV+gLtMlxbyT/n8w+6Oqved69
-ZhpsaqMOGhsK6ku7pXfUb/11yPUlfQJhQnxT3vtdVRuHNyqFQ3dAfH5HA7OjHaWsK5vWePISEQ8NmbvH
-+0OYIwhWLkYOVN/1V7yDQShz6YDA28Rnlyic2wjLjaurq5Jap4qbGFPR7swx3oD94f2ryfiA1Y7hXlUF
-+xp/5oe/DlqR1/LCnwQ06EAUae0RAa5CZoMJYWL6dHHCa5MerTgFCH9Pcbjc5V37uUqVjmBtnoML4PDi
-srKRuASqSUSDo/swXdnRngOWM6GZ/8Z3ZjU5lLN0k8P9o98/l39zylFhFfVD1yvW2w4LRGDkmQH7h+/p
-LT2mo1cnorU5Hfgj1azk07y33rrjiKivrJe21T9aYEoCJXmmYGma1+/ZhQrW7N8HcWxwd+Fd/Ta03Trz
-OJcXPn5c3vPfDCVdzD15LsJxhSNGq+SFDVEY6SMwcKcoNPiYZlIpfq1zfEnvDq/96aGVCneFe1vqZpqZ
-iXGjE+8aMIgMiV7p0xGMuh1xMBYXsGFhidoiyCRCL0lQGgavLx9OT0972IglnXT5qSntK55K/Xpds398
-Ifp+9QFN8+bla1hY2Ot8zrqdMVOueDyzzo9HdmXNKybuJsFehVsS1PB9sFGwz6p/X7pYd4Pj/tmtJFNR
-jXv3eM0wCe4DNLsUk+luI0tucs9FnOfdvQc2bwuC240NQ4F5bDEuLgD/HhRsR8zsoJ7ctW7as9ezaHTM
-Ki0V0vJooRwjro483+4eVPfZHEyX9oF2AzPqzpLY/SO7BGpHYVD6R0f0aLiDz1TMZQlIRVJH9P7mBP+Z
-ef6J3tGtuPgGLydfeH86n/m0fUTc7frRid0ICStDr5dT1Ot8VFjd1zAny81AsqC9RrkDFi1F3+pNryZ8
-fonzteRHpWVlnim38i3Ff9I5yPucym39/AnlMMmjSR1LHY0d357p+9M0nHMDtFRgPDw+OsBT+W7GlOkB
-xR5S5btTxIcx9aInc2xe0MiHkmru9eF6eeQKD9KdQjxE0wvya0IrMKXYcm/k5A8qfblikGkSKPbBlViC
-/MB9TMeZMa59oip0BW8qLCT0HmVuswJc1ev9tWd9PgNPLiWbZBLb1WR+0hV9q1k5kCzLo0E9YK0VAqUv
-/xDvGoIXZvAjOKX5Ms8e4UB/wxIwfnv2jQ0Nq10a0eHY94XsHiTlfvcqT5VvUwFmASYmJnKEp7w91E3r
-glDf50bs+eQut+8LsAitU4siAg9yFqibdkyXs8d7zeABvr4Vm2vJEqLpfTVv9h9vIER/8jj3B69/PVoN
-ty6oac5lLdPB8YpBfleTO7UIBz/NuGlV8uHFQzKEM6jb1aihPM2irSgrIbzuCyTMM5tiUCLHZcR6RPrV
-JVUDg7GU6zo3DdQkaiikI71R6XCG/Oh9bIL31tkB6VYCQSTA25pBMCdAAHOEtS7nGnzKYzMujLpIT6z1
-3UDPct0T58KO6mlbH24omR4Pi1b+wJXvYkuK7ppIqBO8IriXMeRTnSdL45VmayLqxSl6I1VPSxaDnWMQ
-pLNN2fjWLM4yw6rrfAnptp4eCz/qj9AY4uqqO3DaRPp0xhXKBJVtH8hRWNozBhJufHz8xYRjvBwnONg+
-ICCbkUdaXFm5crh8SP2bCPCLVksZVs4vdKZOZ+bhxHYC/6vXxiur7sMrktHqn0XyjqpFHIb5Nw9NOA68
-HR1vffv2Lcbcc3D0KhN1WLZb701JGZn0xfv6WpaQbDZQif77pzd+TE5OlgfB4XBzGeVeWLBCPOo9uzAX
-D08BAoG42iIIELOksvJ6Vv/wxER3ezsIhULZ3LmopG3MpGVZlTLn/vpBWlkHHQctpWkObArW8FcUFI//
-5duVeDx3GE5fMh5nPGn9mP1TJAommvpQOitnZDAd7S367cxsMj4c/vjbL47ioWej0VHOJn7TlP+zr2D9
-P9v/fWE2iXLx9y4xPwV2SR2DXPfkc56ffyWv/vxZjf1T1JEY4UI0ZY0RQ7cr8MP5vC36cLWryT0vJWQ/
-9QsIBNGq9NUWV1bm7rQIqbJsh2dmZr7k4igmZ2PRMQwK36pz5AFZx57vRHrIa4lzZese3wTd/zGSillQ
-gI8IqwAQfF6IcAjPbStxnH+vPKGnJNfX11e0Ogp1ehsV1U0ZdheImD1spxQQOfg8qItLt2TUlfRPZOzq
-6hvyUHyClAGAK3pVLPUxCy1lbj1GB7A3N7Fvsbi42KcDJwqYum2zyBnTGdWNryslAEboHuA4weEPi0bq
-Kv89juM4mSIBVngZnKP7Uo6qKi3t9VwsArEl1jIgVC89hR0MpHaRvX849S7P1nh0fHwAbS8EHIQJITpi
-B8KLlM8dc7wl0aVZQvhzhaAaCMicj70toW1fdb06yqdlhwKoeqjact8Kgj4gpccwCKCxUZrUZmoe4P/H
-DTvuUPveX6K7Zv/U2xgYGvK7Xy44/qKsynIzo7eKOSj/6au7wiqAmbpJqRY32F5s9Z7bCK/4h/5OaWkp
-x7cd4af8n6JEjBcNuVZrh7z9Dc4VlZSUZOzL85WeGyUMBMqoATb1VgmXDwa0ShM4Tugg5zLdcJy0lMUl
-JTefBzwxOC4hZ0u8X0UA4xpHU/MANxvXbwmLWKb0Lf5xvKMHxCgl4Chc7TKg87HQEaC+FovjjFYvLi8X
-6dnSA73/+TbcQiDxUoYmeYrNvxD+VOxbdPuBd03qsemZ+fuU6oYAvfk+2ZeR1y9rjB4ZBgHQbJAdVaP/
-rLvjjLM3fu4KPtX9jssHnO8LQViKP5TPEW39Jzo7sy1UNQRoTObXFtmCgLC6VrgUNc6oOd+ZpWRJNDYI
-AspqQndJcfGN080CwES7aznarHEP+mp7ygD2G6bm3Y7IzOQG5mN7eDrg91xJAljPeKrBzMvbjx0IoDzh
-LfISMG91TrRdOJcuV2gqKmOrRiFm8O/Jbozmg+b1Is1X2G//0DyLva3HIXT4cguI2UJx72CDI+CT3p0a
-acBHTUyIh1fVhFKFRA1X9s9P04Ki1d++fw/WOTwEjts8HluSZQI4QBmGnCcdPjkC1gKgQUB329vbezMs
-6+yfdXkRmIUeBsLzfa3CKUv1ALaAD/yYpW6iMuAoZeljX3MEDcCeHxrnhPhcW3uhT7cAIMV8wVbMg4Iv
-ujcNAgARJEp+VVvba79f1cPSSvTPXYhyPiEEAslgO2BLi2/+Zez2PjMnADghs7WLC2iko9UFuOfPtxNc
-ZDWFUndmUvYBMb4dvWIZmkkPr+rvA0gJrEFpts+AEcb0qxay6J+oi9ZR6gL6BgbApat/BpCCaBl7OUeb
-09mjeBUw/ujzTp1jFuB6i/7JcuB8M9DZ3L9Pte1jan16frj0BLjiMRLSnJnIc9UeY+kPrz68euHNDnDH
-CHr7xmn3hVIF+BLK/skSJFyvGsnHnn8VOIq9DeQKICpSDeem523FqAsAzAIm9Dpjg0AA+CYNcF+vJj/a
-/4ebPWhkYvnpebwCnu2zU0s5FNoBpjt3OLtZWIim642d2xK0NdWgdSfjKkcM++aW0Pn9yoUgjEQK9+XO
-kYLhwMgAQMe5GPEAWXU+ASvRxRz34FHRiJ45KyDLftFNTi2fmZ2d/Z1a/vwa+Dy/2QvD1X5+1S25Sc8a
-DcRr92UNV7Q6ObpBqv4+IOGstIrZDZgM3qldVuoqBADoHH3VvSmQGBXRcOPc1QE4l48AKV5ulZxb7yN/
-BE5OKRxZmSdfACsDwovCIO215fMt0JZWgOPbWueuA9Lcf550xgxfbVCTk+3vnlvPAuROoKgC0L29AboT
-aZX7aoGoAVSZnTUAvInaC++mZztnjY6M57185h6bJEvx4tZWYCH4HcAxgdTHVmivmmG9znM/wZbvEgec
-gCmcO07b1+z8TTomBIBzPmeW3OCcX4PUeY5o9YJnST1fhJSBwqPydtA1ez6zNblkHnCUT0sJT0J3TEJl
-9A1QJ+d59j13YdfO+eMIu4Uk9zWOGBamyLFuQbDqdtAyGeAdgJw1ImWTm4LnwiMsrCpmgahIyQKaicVH
-YKSM72dyg4Dzvcjz/MMdd4GWslD/LVDntmGdnP+ywsedlsxW4IQOjQUQ4AvRbN3b/wEjfwRDX+eISQT4
-vQ2EiMASkGab+PgrbbhaJ9mv0qNz45z+UJOHvuyUOXOq7NBhC5nvOb9lQFYjDwV1cd2SuAmo8VoGmCML
-vZ6hybef/Z9TU9gyQO0wQvA/DrE1BuyY35ZNfKiuAwMqbxt/QDjdw4LfN9JstVYwehKG54LtnMS5rnLH
-rTpVAr5QGTOXhebWln/JbD4HHmkuziVysMnOccFwCwCqOPuL8bwWP3qLtKwxLvP9h5RB8efh+n2BxHlJ
-/dKD8wUIogP/OlyhOWTjz3tAp8l6OMpKIPGhK7h0ZSz37TnMm11c/yo8rFePexV97pHKaWHFr8+K9wAe
-SsdGkQ+htUytGdJs2fOGmYGuYans+9Mb6lcvAOMpCzi83gpoKOYq90CAZT02uoE+gM+D9f1+v0oBDolz
-jUYAfYdf6zBQHC9WCQJ7GH2/8JWF++pky81Czn5fgdjEOCy8B5QkI4se6wDLZY8HAUpfSBzhv693FH3+
-GnAAkNhpKQFed3a+vS7jLLUdAhx3N8j9x7DPv5dZn/uClVmoLI0jc/Gykw1msPHSXlZAa0sv0H+gG2Jk
-boP1ugbVkUAc9tRTOeMexOg91qpcBRvkAv1QH7xDWNKaIhSEc2mS1bwPrJEP1eV7l4OdTzDs50Jl7IHN
-UNVPsuGjrAY+QCclsgaucimS5o7jEGT/aIor4T9HHQ0+R90xF15SAMZvx9/HAcpE+laZduj9IlFWHTjx
-UFVV9tyolGCQduXf2NudxFY1A8PiPbuT1tIt9S8wimoXlACUiZMGOshmM1J2NzwnKgUG0mYq7wg2STNo
-41FobqkTeeJtf/9LAPlWj1AtkIeAADDT/moKwC9cL+/QUX8sDxI8/I5MtLcVNHRPzqv8SJRYrWJNTGJo
-zxCqXiEz4HLS9YHBRMfyo34mUWI1A92TN9s4iJqVy/W6GVEpjiAO4NQ9TTtucwGgmQKVA+Oj8p6z8Xis
-twl7gMB8Dyh7zrCompUF8bQ75/92kcrbJDLDWuj9N212jSDg79F9bfVKNcu3/ycAAP//OuIpu+oxAAA=
-`,
-	},
-
-	"/static/images/screen_57x57.png": {
-		local:   "static/images/screen_57x57.png",
-		size:    1807,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wAPB/D4iVBORw0KGgoAAAANSUhEUgAAADkAAAA5CAYAAACMGIOFAAAAAXNSR0IArs4c
-6QAAAAZiS0dEAP8A/wD/oL2nkwAAAAlwSFlzAAALEwAACxMBAJqcGAAAAAd0SU1FB9sJFRASNJucpIYA
-AAaPSURBVGje7ZpbbBxXGYC/f86ZnZmdnb1g0zi20zZJaZLSqE0RvCF4QAiQUKWWSGkrEJAiCNdIPHCp
-SisVhUsRAlWFQtSSFkolqlJVKG1pCw+EiwRCFdAEQpI2cbq1org4TYzXO7Mzh4e92Jv1OvbGa2+Mj3Rk
-aWZ85v/Ofz3/rGzbts2wwofF/8FYhVyFXIVchVyFXIVchWw/9IKeNhCZiONvP44k0nJbTSqGDw9jG5s3
-nDcY2zSG0c0FlSor1h5eixM7CNKDkEBMzMQVE1ABktbVypNlrj5+NSfefYLYjVufURAmIZv+uQmDQUR6
-D7Ix/g1DLw4hqiqkKRuKO4uUMiVKcQmjDLwGg38cxKp5RWzFjN48yiSTGGMQq0c12Rgh9Ot+RFcFjaOY
-IsWGWQNQgT7dhxIFQJkyo4w2P7MaeJZbk/MZAglJI8AYzMqBlFhAgDVw9F1HZwRn0zD1JQqsnZmrIDAB
-lM67LrXrk2DHNv1/7kdioWyXKevqDO0QxsH7m4dYgjFLo1lZUGfAgBHDFFPEYYxv+9MpwEBJSsRhTFqn
-scSibMpUTKV5jQo4loO29ZIAGmMWaK5ShbFCF0uEphQn4OFBamYEdtCkSKWEMKzupZ0SLGne1yQxhBFo
-JWhtMEaIIubUtNKCVhcGtCxrYZDGQDo3ydOPvcKpk8LNt2whm53duc6eg8efPMjgm4UPvG+AJ544hZ9O
-+NTH13HydA5lmcaawxvO8aP7RnjogYDHn7qSNcPjfGtPkVzQfrP3/bjA/ucGLyizbdsL80kRw+ujAb98
-xuH6d4R8ducrRFHrc1EEn/7iy9ywNWLfD0JePa3ZsCFk3eURT+1/mcsK/53eNSCVShgaitDyH2wn4aEH
-R7h+a0guF5LNts5cNiSOz85L5lQq1YEm/YSf7r2SG99/iNs/c5anXygxNu7OCJeGgaEpPvHhs5w4Bg88
-3M/AwLRKtAM/vP8YH7xpK15amooCEcHzDCkNh/8Ft9w6iFizixiFmpRDNzRZBSlNKn7yswJBDm7dXmSq
-rGoljKE8pdjxkSLZDNz3PUU2N4hJqgXsX/4E+x5zectbY/bef6hxfbaRJIIxBeBNs86Uk7tw6rAslFKd
-VTwi8MjeYY68Ch/bNcHGtWMkRkgS4YotY9x+2wRH/g57H7kMpaZfYWu49+vrefa3Lu98T8iunSPEibTN
-mXMFnvmkHxHpHBLATwu7P7ee2MB3vl9ESYzSMd/9dpGkAh/aYbN+w8Aswgh33rGeYyPCJz9/hhvf+xpR
-2J3U4bouItI5pIiheDzgwB9cNl8bc92Wca694Qxbror59X6IzEakTRlXmXLYsX0zp8fhrj2neNt1412B
-zGQyF1vWCRjha3cN87vfHOXuu4tU0lW72707wM05c5pUVHb4wu7L+fnDI9zx5TOteVBBLlfGUrP7bXnK
-Jox0W0DP81BKkSTJxdWuIjB+KuDOPQXu+eo4YNj1UTCpdVi1INUOVAReerGP23ZGPPrg6LTwoVAqCZuv
-MRz4/bG27/7mPVl+8eTGtr7o+371zCrSGeTM07y2Dc//aohrNp6jdK7C8wfWksu7JLXIKSIIwjPPao4c
-ipqglTIc/OsavnFvyOarXucfhwzhlOZLX1nH9ptOYkmlrQwvHQzbl3FaY9t2h7XrHPnTmBqQmMYOzqx5
-k7b3q2WcMYJYgEkQkdqaMmdMOL9zYkx17b6+PhzHWdyjlgi1F5oWTdfd12p7X2r/b6YXa6y58P13XRfX
-rVpS/T0rpjNQt44gCJoAVxxkJpPBtu0WS7JWCqDjOGQymVmj+YqAFBHy+XxrwFspkMYYCoUCSqm2jWrr
-UgfM5XI4ztzVlXUpA/q+Tzqdnj1tLVnftcuRNAiC7rQke0WD2Wx2eZvL3QQMgqBtqmiryWSOFkQvwQHk
-8/l5m2iTJi3L6nlAEaG/vx+tdUvJdkmba/1E4bouhUKhdjLp7KNtT/tkPp9vpIhOAXsS0hiDbdtks1lS
-qVQD7mI+u+teA/R9nyAIGlCL8ZsC3Su+53keQRCg9eKLpJcTrt78LRQK2Lbdte+Verk05zgOvu/juu5F
-B5aegKxrSETQWpPNZpsaTYvle8sCWdeaUop0Oo3ruo1WYTc111XImRoTEWzbxvd9PM9rAC+F5hYdsi54
-XWOe5+E4DlprlFJNGl1KsHlDtm/xS2NqrRu9TqVUE/RS+VzHkDNDfH3W2++WZVU/idX+1sfM4nk5oc4f
-/wMV551q/6eRnwAAAABJRU5ErkJgggEAAP//zaRebQ8HAAA=
-`,
-	},
-
-	"/static/images/screen_700x700.png": {
-		local:   "static/images/screen_700x700.png",
-		size:    17930,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+z7ZViUXdswDA8gIakgnSIYtHSD0ikCinR3hzB0YwDSIK0CIiDdXdLS3YO01CDd35pB
-r+26v+2531/vj/fHc56bM8estffa1x7rWLxTUZbBw6bAhkAgeHKykqoQCGod4h8WBmKkxkYCfKE4q8o8
-hRT0Ua2DHxhGCjKSkCvE/5/qs2PAyE0HWU1nCASHCPEPBZKUQgYGMV3klKQwp28S38JlZLStzYNA6CBy
-kk/UoVObs25e2m71V7sJDArxtpoVy/w0TvFqNQ/vhTmxk7LBN9DlP2/2VCWI2MfgTw0Nods+YqUuc8k0
-1H3p32Naecdi8kzPLpFU3lw6Bno/bTft9GIhfpqRT9nLZOP4wEv4jwUF46l8+k7x/tjgkVdDFB4l71Ik
-g1z0Uyarm+DLBBYHPnHrosGnvwb45ITLg08MPblohq4mZvCJVvh/wf4v2P83wDC//SD8NuDh6TlZ7Zwd
-/PHNKxZpS8tHOervu7vlmZiY3rYZSR1QmZmZkVFQGDk4RH7+fLe9oyMxMfFuQk+w6xx9FNYuNKWkhHNo
-nRvgmHCuo7ACzsG6bAmBGX4a2qHVOOi4qBIcIvy9nWm3pbcpDPsPRSIDf/+2srK2zvn+XfgTnWuTM98z
-CQkJFze34fl5gtufbh8LfaKRRYk7uuJTnOcL7+/TtXvY8aPpBpHQfbIqc4irBH0UXUxXkVEbtry8PDav
-W3RZihSvzURkKS5TZqqQ29D0iDVM5CPHaz44psGDWMYeCyurJA04qySMmoLiS319alTU7Vd4wuj8VK/6
-HvAL+Qn5zaMaq2hoyEhItNb1jVFCGYHR0Hb+lFAfowwIeLZXVle7N/ZRYJBDs79+tbC2zigsJHiJyg7B
-d8yl0WKAZTddibOQZlRXJyYlUQBDozOMTUxA/U1V7uLh4nJoCWDLaIUGvkQTxutsmpg13nc9UH3+/Al9
-1KvYAW5OzpaxfG3yNPIQUjT2VG8bj48Pi2igTEunzrg21AZGRgHd3d0dKZSAKPVET0Gm0ZEkbN4g1+y9
-50692+Hmb3qvyOrVNM+Pis9aav3wlHuMcw0Okz+sStj7smtX2plaWXXXyIKVHsqQ7w/Rw0/V5R0aHlYr
-+uwJlrrYGLMM8oDDc0XzbCexacPAxCMIEB0fYEZ4jXubny9KGn6t207aVtitIn9/FNetKR5P6SOlibNl
-cWrsOrT2xUWb6Yrw9XVLa3okRj0ZFHXGbd67VJcQlVNQUNDT7AgLZhp/8WkUxT5ocOZbfS7CqOuP67Et
-QqiFXG84VTh4eHjwbvKiFhbP4HylgfJsUs7JaGgkHEQCZ80UqCe5vxhTVlnJfqzr5uLCcTvZb5yz9nMQ
-Sd/dJi5za2FeXjMRhGs/KpSHoQ8MT5d+UqCUlpFZ314PMfaz6qF6a+wXV2pdzIiQzdmdM9L0yXiQq4uL
-OFqpiB9xSkITfIIDDQ1t7CliN6zse5GlU5n3p4lgemsYGxtvUk7xeWKoNmvhfU+qPx4owENsHO4EHnER
-IA/t/lBIWhrNK/9pdNLuPTrSrl49uoeIjZTUetFDgF9hLWFvzy7c5kSy3ypPjIuHN1n+BMnD5UakX1cX
-2s4UBy9vu4sLBY29AM5v+OTVxN95VXwTM9/p2VlvcQXKkDdvzGs51bX6AnxfVTceaFtb511bpe7lxby9
-kKDgykX/m5CQT5OcJDpokR8vtmAw2KawLIIOTVtgy+8G7zMyaurUcTFaaurg76YsVTdUdn2vNrT0EHJ2
-m6MTTBLnZ2eb6115OVkR/mZZ/CIrtt/avbSUyROF4CIjK7qf3VBYGKHDVjl/5RVC/rA0LJTXTdhlm0Hu
-LPsH4UPuQ9R0jBaH255BkzhxekRNz84D++DKSkqPeXmlNScvsyaoqg30eBrQz8k7gw225f0rIeEoMzuB
-uPj4JU7L8r7bjJZi0BCNhUHi6KKei+ZCVr+zcBFuOOpeI4SJpGnRCkduAp0MUxC1av5Y4soNCoXqma8i
-nMdysqTQ2jEpLc2VupCBHMq4FbG76xgKm0EEM1WokpKS5o1V5einvaFjd6xMTU2pnDrv/jAq/uIxk5GR
-MTPr7vakPSkxUTOa51W9Bw/4T5I+6ktSbe12TWgxKut9/VznB3IK4eZQ4tMmbRvDyoH438HPiImJpUhd
-U4UQduPk7qqO+V/i6H8FWiDX/xpu/98C44RroYLh4Bdycu+QGAvE4dJ4iBls97XyG/+n+P9fhPbJ6KPe
-XqMttxMhkESQ4/9P6QOg5/h9zc4GEa7kVnRZEAPhTXmWwMyTrBrINhfOPqT66ixDaKeulH9DDZsbw6Ex
-hsYj2uUToHioHYRVnTPTMr/LvH2Vdd7O6s9A/TCBWzapA0f6jnR+6xey0hsI1e5URb+6uVmOH2qUzoh0
-9aa4uLgnya8fVt00OI7ypXon+T0cIQVrJkhKaDtGa3Vi+xRQRstLQz4KHrj8lC9YqgFMXM3QgJrQNy5F
-UHhoFYOl3S50BrjNFWOMnBHX46pZrTvqafi2jIy8U1GZF4ZgT7MnKmJcWlBjmz3ggK/xxcXHa9HKJTGv
-zV0pfQRRs25kbKzP1WrCjBX7V3bTz3tX/CShtyM68iMYehf87VsQu5YxUTFaOWuhY4zeodZvNsJHTUoq
-mDRfbsgHERAqQ0Iw1iA+9qyoB9R/7JZ1NDUtvRnWH/8wegz0Q1PT0+t7P0UMv/engH30yenNogdXvcAr
-x2SjaQKtoYzUse3t0oTDN4/fl79ymPbXX20USFWu9dGIXiHyYvXPRLsd2vaq0o66DE2/lAbHbOfiIKXv
-SuP3xkZ+DMPWG0l6oJ2LA0ger2pdWc+JqyY9qMpoYBgG3Ff6IOUyxMlrMsslYQIxAlV1dXt/j+aZsxuc
-0TunJY5QVaCmo2KsXlr39vaOyUcf8q3VhGgMPe0y4Sw196hEpW3FmVzZ4GtK8C+uvtIyNr7XZTLkAfT1
-gHkgyKE/B+QWaY745U8VYItNV1oxHdyoXkTHohHuZnUlJdFxzBpaXHKCELsfbJbN6urqDscLb4anpuSv
-Kk8XGv1IIRv5vRKKaGLGhz+3l4yvDkw4u6bKrWctnkavLPgv4wKHxLl6VJMZVG49zsnP31mgW/vxvIXh
-O0oaKhV+LNviLxdUN0ijaR/OMM33srLYg43xq3z3GgbLq/kI3DotRHWi58H6w+cykiQPeBHN1XHH8ODg
-k/E+tombkn5fzwLtRSJRmXau7D8q6ylzeO5O3irdSHSDHEFa92qTQYEzVqhP+37xSTTJgfcoKJhA4TPT
-t1mIGvYiTB9Nhj5q8pNLM6GXzt7IyIjkixfEQq6bBk5OhFMfL7IETDnJX+ZrHUQWLoEIutM8H0YOLX9o
-v5SouHLbd256uqcvVYj1VqlGnN1E0cJtX1EBga6tynk9l/7VZmvPs9PTFFh139PY2MheM9oDc8nosiud
-VmbL5wEzwDY5+flZGRmBCwuGoX+KSFeImV+q6OvvEGLIaa0d0SnQ5UQyMTODFTcBdRvIWhgYGB9BCKw4
-kL0fA8REFFCBBQysnzAriHnh8lr7MCEurtaBgVugFgv78IHz8ePmpSUGLi6uJafqAeF5C255boosYNih
-IVVQF8pmqdox3JVDvzVZZCjRxykbzfBIEZR0s5ZKiNByID7Bg4gij/aRcQVbo8n7FYEzMtA8XV7Qtb8e
-jcPcKTFDBDbVtarMJ2Dk8yFM6cH1HHU/En+EZiZwHBGEMlXcoW8w/lHoIf9L4Q27OQcSUJ1m7hrwOsyp
-s+gM0SGw9r7wIILlWKvbK66/gLYXBcg493nX4h8dPBCUENyEW5GsPMviTSCM8dLBiHSt6zYrxZaA4/Wd
-x3osssuE5ZTwMQ9PG7XbTPP+vgvMmIeXd2hggGN1PjEtbaXf99IZFpEFisGaGkECkNkiIpw7qN1uuF7J
-UDne6DJxGvywC1brcGu6o7MTa3Fx8efPn3eWDpQjCoBh70UIDz1l4WJkPDHO99dA99ztupQQmL9Y5niG
-65CSXkf94cyLed1FcCcuien74aGrxceVeE/YxB9Ye/XeF8K58wPBrzSLO8tOAl/w4PrU8DA/mp7CORz2
-qpI4SfongqmHdFWN2+9aYb47Aa2kxw3sPvRejpxuKhFb4pe9TU0QERERkKFVVfFkxDDTjoT5T9/Bqzqa
-kzweRggdoVfipO0GUcW8NJrsqkxB0Rn6hEpOSZlxMN5qU8c24Xg5Twx/xjPs8vq15j6jgYtLbNocTZrb
-8yLDBPwJ6PKd2qNN95+NWm+5evtPsXR8hTenigMHB58T3AAVLv3AGS0tLfuE8/rW1nKf0M5Sw9iZhYBr
-5LMLrMOD5kL7IIJUVHZvGqpBmwldxRgUWnutpD+sYMVzDaK7GF4z/z7eCYVZX6Dgx/r3mrfC00TQvdFG
-MqxtbbM2uxwutAOpV6d3/OfDfmhWOy1Hu2/bXwimHAZRCVXYm4PiOPAuA+3MTv2Rnmv59q/OcSV4BjU8
-u7BF6IM/Z8891prqhkkewIeCguLTg+5E89trGkrWzwLD3zzR1k6W0pe8uLjAfYa7d7ixMADpSLzESzlk
-teg0Wz0XzIMROkzmO+hsMUxX2pubmbUHfnl7cHjodDTr1pprWHDGfry4uvDRbIOeHJolI3CrdJLlyJuG
-reHeRDFbHQXTm6PH/PwWC6TNP3/i2trajjTisl2eGXTU2BC53Gf6VlCg5xFsEVQ4e+ONtPREvWclpnTU
-77U1g/FtXardFSrfKjRlrxCeqPgv1fHc0MEkbCbQJrnt6InWOIa5XeAmoVJkb76qOiaDbhBUDNZZEZeX
-l5M0+h1uz/aoTzKs6uM90SspX+WCR4m1ONh9FLtYfxbxM/lssPyuBaNMa356UHZdTkRBAcPBwQENHcpE
-sXGHxKXv5xrezbn65LRLmst5RocNTVjJzK/dDrHLXfvGSuvWPzApay1OECpBOtk8Y0xxMi4yrj/xgMqf
-bAoOPdX5lqkZExPj4ur651gNFmTjhxbelLPzpkGYdOIB4TvG0q+Vl/qw6dUWA3NMYG0aBilE8wY4fWik
-8bGZiIsv8xL6DkKphWrOndF1KztB6kuhTioPLJ0r/Iddy2tra0VwdNybxaMCBULsE44+Rz7HHp6X06UK
-Nko7auxBvr54ZXNutPfu3aN5qKADqnbPmiP4ZujCVzdtAsucZGuBQH+N0K85Oe+6torWhww63Nvb4N3S
-a9vboMZHCQsLG+Ab6ChOaHEmQsEvo+b1Qb2/cqpSfmN8v+FQDHYh03poO/0xpUxhTZVHULB7e7ZW8+xl
-1bL3rWIGUGIJ3nonGXB66nG5f/vYJZLVWc3hAF2F2F+31lXz2K+8oiJakIK3EeyrBij9uQ//1VZvr8L+
-n5OHXjASqNIw93SFbalzK2Fsz/JEuTVpV4aT11aMiqFc5pcuYgw9VjmwOj9C5KFXORxUMH68nYDhNKzW
-1lZXWywVoOM9tXspDiLfbzqkFNO2CIgFeT13bHtPHBvHulDwjfx4yG2h691j1+2Z9cNSBTg/TSr5ciwl
-zLJBQl7qLkW46Aohr29K+TdMC97krEVK+OU8htCYQLKhbtemph5ae0tyYuJnsD6b1rCA7BtCY8HZTds5
-5x3qxsaLQ+wTRLB4yk8eJn59fcoEnxjpE3oGJye7pvhag59V0KgWP2Rl9d78aZhY9tHKyurbWw34u/Ai
-kSZH+TR0DSqC4ZiYzdna8Rb2ooICtq/M2ccDL24t4bfUFvj3uq001hcXR0VH/1qreHXBeTwnxVGRHTo3
-p0fwhfET6GC6huT66m4RxkswUZzt1djDRr/0mJWGndPwzt3a5C+sIUnTFJiWnMvXI+378eOGrq6uDT/a
-nCqpCYU/kdgNCWevFv1iI6nno9udR82k86ipta6PhYU3m8s6Qedq4SFQV9+sJdu2VDy2QwEtZ4hNSIjJ
-6YJnOC9gZqDgj+BqK4dIsq1WtOJ8AzY59pjwMitaPN9UrMry3CZQjpLd1X12Fd3fxHiFJVRha2xk1IJm
-FaWorMwpzPtz94G7qwYTb8To5+amPMYMoF9iBVa2DpD4GVSszvBgUyMBdDQkl3R4eHiDjYXpCd43LkVJ
-YOKVrXL6fl6ry8Pr3OsuNRYb0FM3GOuG2UTN67zkTJbo5uYn8i+bhSIqSFQfT6aX1gJfyXlmHh4ePlOO
-LlrD1eQvv/BHwVBqhNcUkxksD6jKTicdensCqa17pI83qGxjSydtHElLCUVf45xLtN0A9Q28pVsKu45H
-pjWGJVhd3aj4QmjaCDYa/VxDQ8aIvpkxma5TAcpZ+Mb5Q5otmxgHh8ECAcIFjcMcuw8G7sD4zxpQSC8p
-nJyp+M6cg7ejj/irTsQ+Ozq9BZmNoAiVkoZm5S2pxLusyPfdlw2XlNByE0n620nJyeuXoim7MQYdgq9x
-qNI+ivRY712dJKelfQVm5rEP2qOhhYmrOYyRF5nfv3pwFhIC1zFU30WtOrjHMVyasL1tR1CGmpSSuPTt
-wSvMnIlNe3MatML4WBdBJVNNRn1B8kvCJXFK2O9x1OHhzcnSjpZ+NXPzB9VJlmz1LBM4XtunHF6uMpfT
-yUlJh2cb340Gnei9lm+s2dm+zohF7WZ0En5kTZ1pRIhoxpj0Jm3id4VgQb3NH/Za8mDpnKULtk5OQy07
-2aBrRUTcwdWWDasAxdGiXXmyHmdnTU3yRMX7sTyiZlw6B0+r7M9c29vbhdcC8GurqWKbcims7xLHXKz3
-wSEJzJZdcqB+y/+22Mg+1TM+j1VYgG3xibjoBBc6NDkwNdVd83qD5dLHwtr6niO/jfuzokWvMxF4qED8
-Kz4+PlJTypKL1y3hDkdqTjaTNeGt+ELfLXhHHkqDMu/WG3WvhZ2zcVq/YbuQxxrKxNtoP8lmhOyo4wMv
-tgYMwNKODgyI9+t78i9+J9c7fPwWk7vvrnmLkBjeuA21gYlJ0J8/TqFib4CjHeLtLIZrtIbQoXVeNa3P
-B3nh1eTm5eV8+4Z18kCs6vRl1ZFlzLlXBz6+wFKkCua5FJ5sNdUglKPHucELbaZimELhycf+d5AEbgu5
-921GJqWa22dUOk7mfmhSCxIaT4xxXbf3ddeLnz6xtmbm6WiKY8d1/d7D1zwmnKcIMo2SklJPxlreQvJ8
-UDlXa1lFhcctjAMU5iYcDfNNjgfoZqLUHqAbKOFMxJWL9tq/sYblkDKy67Ag1vLjmL++vp5ENAJ4Sbvx
-Yoq7yI3qQPhcf8uyb6evp7f3RV1DO9Mp6b558xGfxPfGDhwWxnWfPW9aDrhVl8cnpcmmQlhscgD1Rqo2
-7F3XZHldxSPPzJycR4KCgq+uXPkXIyGYFP5q/YENwuZtv3b5mTUKdFwP+hvw7ISpT6enJyvt0zEzM03t
-G6g9dFrzGFnj5J/ravaGZx/tzbPfdDibajXc015wp03+DPb+sdu2lkFqaqrkyz6H5bZbJkRF876QBt6O
-NBzgdBYu7j7KV2c9MOOjpmm7mb67b6ilNTUTMc2irGxsWH7nF4W2BY7X1V6KeK3ULkT6ayjoqakRuc0M
-9n/tgRGs3uDrK2ULTvBBa6g239kssO9Zs96rDgsPH5wd7nWuaOphQqOywLQ551dcqq7+8iXg9+/fpiKk
-9qcUMwcux51b0/z7Ra0ro9EyCjL3Yxy3samgxKJ7WzqL0Ris7n9Tngu6Vw1zk/+QwxYmHXXirL9SqZa2
-thwNuvYlK0h4VTd9+FKuDldWzB5k3GCrJtbT4msDtbKuzz1LzaT+ECIMPeI4QMVIgtELxk2G75CyeZ//
-RBZxMm1hYTE57m1g4Pe5+O4clJqKHMZvLBbg7IN18kvb1jZff+Fq6w1KgxMXFTml6dH27JjEUIkz3Xwk
-GfQFd/pKySTLlv6boyveieK+WvyXCT8rHvUsx9s/+5yYSD41NfVql75qU6D5w+uFR0WeTFczfLy8pxJw
-uuLsFtYr/OVAb1DAaN2S6+n2t/lhDHsx+C0n5zO9XvMDvuZDSMcjbxodVxbFZ8pDw8OlNhuzs7O9kkYB
-znwSkUIF2zUkvP2D1tWfP3+2cCUXutJ10Pdvz/VknfGiwXV7PTQ/v4MTlGm6KF5Q/Bie+w3sgxBfzGQ7
-LNeUcduQDLXS6TXlZ8/YawWfKSsPDQ42L1AuiOShUX0jUD69hbssEKWnpSUPctrgiO0OASw42SBTfNGW
-n1Z0z8OifkdUWlrayz+yjOVnpQSoc6Sy6+pSFJVCaNNwxMjwHD7xFJne++EsAFSP100VEhR8y7tcdRpg
-0HEyeQbz9Fy99FNT19BahaVFtO6Ok9h1avjeZjsWmmiwpSQiImqQleXcpIASY4DU8rNMip8RxlGxgAq1
-5do/POTk5a2w+Q0Iytvrs3fo4LLj/hEsbmwBeUtYwU0ue7htMs8Fw8cJo+5sDDWc1N+5EbPQPs0MFDyu
-dpEmMB44Ey7YOf+VsrphuaF3S39OxYKiSfXyTtUlItoWh8PHBURl7hys0BLBdwrf5G2zDUB5HFrdFiy7
-dk19i7MyHYQGaE6AK2WBHo2Q6CabwO1TVCrztmTYz+yWzs7Okomh8sbG9IV3hnOOyBjQYN8S3BgwQ7Xn
-+Sn9V8L7RB/+Myl46lNq3og3Oz4oVtIYoNaESz1VXYuNjaWCS/1EkJZleOrszDVb2UIuhuf6navgjcSv
-UnP3Vq+VTX9eEzz2NwQi95r8v8I2uT4x9/PtFL8KCwkJURlxMcKsW+CvyhBffEFNUKmDSjTruba1dRD8
-VPUb1U/8YEA4/2+uEr55SQjjH8xYyNV4amf1XsTzMKgizfNw0zDXKxqnwJ/O7VbDWSt8UuC0m4eH53Zo
-21O1cX/9Vkd7/MPkt5hC5/XwrMonqwW6dmn0j9SjuzL9NXrUeJNNz0HD5l20SOWLJ5xtVeEJ+n+Ttd/8
-AgJyGhqkMvWYc9RLNAS6IGN1jhtkfP8epnifKHeKl4+vdA/HYabtJMGBpWxRFD1BhHR7uiEV5Dn9hwzB
-Lc0sr7nguWtiW6OIlCWKpU+L5fDJY8JJMPbJw+nY8spKIu2YxRrnAOrVFTXYKprzm9YvVhIVtLm5uRbf
-7GF8pE2NPvkOiw2rZlDPHlzgC9rx9ZmMwT3Nduz2YLNZW1j4bT80T0N/kuYpsmkDte7ZDNY1tPojJiys
-OC9O26GL0S8HgtrPqyZLkIcG82pqkt6/fz9AkzGaY3W5Z+vQTIvNbYEt6sMsLMTT/IFaaHOGLucb9c9T
-XbTCp3dJQgdHRn586G9mdMPgV7npdYz9LSIkT0lTW5syx7zpdeFN1xRk4kmXvU48n0WVNQr1lCYVBUhp
-CfTZRlThB/CtwqfDSwMlM+JSqlCqa7oZFRXxDHKiNbcDbDpjfUAoozXwsglraGj4Uqh9XaTbTKE+2aar
-O9pzOKEy593Y2CCoqjQqfiud8V278kPnHP+OMImP0IuPjj0R/tqFqB6169vbh21PVQcfPXp0l0KkqtMK
-9uKh8MBXG1iCQ7dS84dyRxzhhjuuO3O6I7VaSs+eDc/NKe9twM4fYPIPYKW4su0vn0iL0ESUOi13mzqm
-tC86amlpkZ2oBSU03J8gMCeosLvntSUJ6hxTB4cBUlw5KdO7pyWUUGKKHPX3uerEfGn4jTf/UKRmwGr4
-nrqYhfT19X3JH08T8fyo/MxxN66UdBF9mWC1VWP1mFckuZdm/8GRkerLl+1T5dbr5yRUZ6IfHw5U9ddj
-FLjf3pxp8Na0jWPNOabedMIAlnqQ3MtZjcerS+IAraLSKzPi5VZWVLyjm3r//v21379/5Zi3JL+8wT/g
-XbRrfCKhKyWFPjo62rPNn99Pq8r+7y5Abu4X0MWJ6d8svFRcaPcer4Rl3nNGvL0CyVmfg4E4OLDeoLcp
-b0COgXBkfHyvI6LJPKCO4xtGgRCpqri1tfXgaE5+fvjx3aKTMBABh+4fbzmAEjA5OWGruMZpWZ7zBbmd
-6G3lM1N/DNbgPLtg0jHFEu8zVyE+Wa0/+BXUif4an1/o6z+zKQo6Ssd+wkugvUczAeXfnK6MWFhY+JLT
-PzBwC00Cre6XPFTarxBiLBZS6gs5eSkwPjbGNoGjWaxVbkWc8+q9tR56+oTmpyfJC5TzAQdhRZ5HdkB2
-VkscR9fxpjyV99IZID1MNeLvXOkWGes64HF3GKz2pUo5VrBc1/KjpQu/E0C/hvksZFbtZPVbcbHjJG1e
-lCziLFWVMxIm0tHyTAaHy+5XosMGiSzufohHrY1cm/1dRHNDrAVCSGxC7N51V9atByEVo73/4D6ITLp/
-Xq9vbZmGPVaeRX3S3js73rIi4EHPx82toqr6y7/bpjb1YGOcy/FZrK+PZVHHRiBPPbmdMBGG4BQ5GZkD
-PQNr13hHcM23SWB9Kzs7s+OjonUcEMG/9sJH/oAKSsEdZxxzKjLfmyvFWdIP7aD1HW1As/CDOgUFhZy8
-vD2I9yulsXxtmf4I2ATRJxUckzTHm16/iIusO/ClZWRSseQUup+yeXLBmcq6e3q61M2PFwTgrXTzPeOt
-8W4ScmwyMjId0fkyiAL/J3eCLvYupOPkBJHf9ubTes3eSdLTfCJQ09Tsyn2RV3UU43A+Gkx5pN+IbS6G
-XmE7i3hdRnTXsutVJ9473Drs8vZ2abMp+hQnw6JF4Qt66It7Lm5uXKKiDUu/OURF++a2689n3eFJdLV+
-F8TKNShfV7Ber7tMoXFFTE1p/bbaOF4/g6VW3rEYINNxYa4rMUzamq4kPTBjim65RxHONoFujaEXrCdM
-kw16QSGQ0NzQ+VXQdS6efPvQVKBbG69DDRK1LBW+tjK+a0qEbdD06sQwXXKvh4poyNev94e6uNNPSKt+
-9aHiaWHqN5B/eB/O1rWoMfSn9FZtHjm0/Pv3V8UBPyQDQp8FNUA6cnwxUy4ZyGho9FSnK7eBk3q3nkEv
-eOCZdB0nJDrHzJ3jeV8KCuyaCyrwSmUZWpJ4rGXDGAWO+I9dfQ3bw+38tEVxcj5ffpl7ohLtDxzTdN2f
-13S2silRDMf1e2nBm/jjIUu1+J5lRHOy53XcuQfKSew+d3aX5RNeYRBtBwZgzVXpRsVkrKysPzc/7LaT
-tm6hU1QejsJn9wyr8mIaGkQfPB36otl5gOhfKV+8eKEe/+JPcEwAHgFjfxDzhzeJOY9Ac1W71l9bW5u8
-O8FvbheCTIWqxz+pLsqSkpLethnRvN3zKra3tDSc920OnYcEW3kc/diD7V1hbwm5kYyGAKtnlJW5NE9F
-Aetsgm3VfoVNWUjgmqKhh9MvL+HpKaSmphZ66JX99SvI+7BIt2byDVB24fDq3TyhMIyNi4vqMhGGEIiD
-aGQ99t1w3LcplK27Ucfkc/0vHHizeQviZOjjR+D9xOq3ah0ooOU2DDFyv1+O7G4QtPAFbJamB7Nmiz8q
-JQ1lje/rU6Y/4NJ5/bxIbI0vHVVsJ/0sMMS2E19HSQkP7wBv5vAJ9EIDh29bcz6QryDNECRHhZrGskyH
-Bn1KaFbgwMAAZPZ5Hz9lwCb/6fEeTMn6U2Gh/XOK04gPH4ZAPS+mB+nIDqA0X+Hl30qOZMd/oaERI58l
-DCeBVeUrv9gbrWzF9NH/9CQPVjofmNHTHmq2AUIC+1vk0YEQqFiAc7l6kYh6afihMcJKFAylXBxBJVZe
-HuWFqnNVYNBRbURk07vXdZfw3cDIyNKTSvGX17kxgYpA/zfTwCeZJrfGG8zth4Nzc9sMstg6KrNeoDNz
-tbK2loteyg2/ZbKXlTTeXN3/UWR6an5ev2iVj4OjaWDilv0cLkiOMxfuVTYfSsMPDg5wfOK2nEjCpTUr
-7agHt/l3REkus8WTv7ygwazUwVYT8VxfX3ekZ0A/Fawc2OoELCquDyRxz1GVT1yLjOvNcajS/GZr3UhN
-MPOuK/5vno91TjdBxxY83BfcIDzv1LHo+LJAZ725oglUoMA5w87tgxKuuO78Ttn4nN2SMI+iW+vGxc29
-JCmLLaliiPB8ZtDBxeX+0s5utg+oo1ntcujd8Fu3rKkJuKLx2JmrV4xXdph7jTjI8uWnbeDsOEC8wcaZ
-U5UuRby2A4qk8jUXm4t/9nhYBydyaHvsMH+4peB0Blag8MuXliZm1jIb3p4l3gkX96LFOhgT1Aejpqbm
-YwrutudaV5ecoJCQgBFRSh1T0wMXWpuZaDGLF+rqKmpqiygkn0AByZu8zE4brHHpudAucjINL5AAVY/l
-QlxsLAjbRrA4Ei36qE8NDWlieijpeE4Rb7WqLr1V+jaHQj65uLjcsu5z7D1pHtsSUw6a7yumfeLsXIqp
-gTIyOvpcW7fH7VmAmq/epyc9C6faCwJxRDbzDbN9eNEkiiqz5Lh1hKWkL9swFPh31MluOqTEc8FmIwK9
-zw4fc5bA74WHhQ1Mz/eI6eJexeJuu4ddkfWdii5ph6d0maD6Dg5JiOlkyqvqnFkdH3ujU7hQz+zzvOIR
-UbS2zouhs3xyo9oHYXN2ivDoXjPLK62mC6V7DoMynx9jCLlt68Br7a2tM85zHC4oA/PHr1Og9eTExNqT
-F+J/U2AJe3MOSIHS2Fx2k3uwMWu6qflShoqBePVzQ75OxKvHd5Op37sVuk+OpSduw/iJ2UNC03CIFhdx
-puZyCgsjEL3Ca5GyG8fHbvqY47OJV4chIRi/dqsw1f2BVYt8Ljzub4f/6WcPtkWncOuthVE+kLDXpjw7
-3HKmZ6BWh+El+WsYHeYtLMw3f2XHEOqbLnzzIK43LUr3baiPmZmZhb199u4L+OdszOOms4XMUAOOhKu2
-3IKCPjTL2/MsoFZbu3hMdWav8q3dsL8Bu8DzJuINS3HxYxAqUW5ald5EK9znWF5eNsnlOodTHRtG7ky/
-aT6wD5z4iUtNTY3p7favbRDDJkYR3dRPQZ9PXUzesOFVYCDcFdeSBFXei7yXxQdq8Og8bL7t6UaUHQGm
-DpB5E5OSnOgZehU0NTWfTL2igGad8fDycpZTKldzTDimud+sK6Opqa0lyE1fvz5eFd7lhppL+BEc4/Pv
-7kWyd6u/fBmtnC3iF+8H0uDLgldd/f0hlb6V4oH3Ye+1F9rK1YD31NNRfpfFjnjKgnjNR0AY0WFpY7PU
-1+vQWwDBlG8tX5UQdHKw7Z6ann6X31t8smmw8Wc33OGMyry+dPI7Zj/hGSxe+evJZmPLshtIr/ePp8Lh
-VZ2yn/KPGIKf3Y3nIoNmvQGlxL3HUbBfMZ/YO70cUFOc7uDi4T3m5+ff8UU0EVcNPnpOAsLMTRs/Dmex
-SM8JnZxH8lTYX06JAdfhsR5TEUxbqluEfb1E9TY4Fzq+7LhbXVhIV4stz+QTbGNsCYujOUdz9E0uqZ8P
-5PIl2Xy1qotpLW8wXqC7ZwVLB5nQ1jbrz87ClAbmMSQbljL8GbSDI8h2EIMgifEhyIlDQy2K1mF89ViX
-Op8u7v6eh/Clop8JCpEhK6jenLq6GRAN4nNzmZh6mE5a5Bw8/RYZ0tASqDGHY2JAFbJz1Aj6B2MXs1ii
-SvFIIax5Z3IvM0/kISYiF3IoqNNHGVycHmye4dUt4Q2ofXJMv7x7PJJnePSBOuzDB8Hb0TQiftIZ6Lam
-CF2kEE1iYZGjAog6h4JQaStXke1LnQVRERElkBjz9AhooUZ+BN9xeH1u/GmLM+GUux+zK675CxRgmrWu
-rLWNuOw1HFf47jhbB1c3V0Xx/lNJrd9mYmLCFkzz1+gmWns5UgAbpw2yx5hU8+WxEbj9q5pqa2srIlWi
-tuZjTtKv6AjxSGbzxj2a40kqazFQidtKvGTIzcuzBO1iJW3A+IX5wpwmWxWx3ix/b4XtNIht67exR1Ob
-m5hFQJONMUG5XG++bN9VfivNS7SugdzD03PVZk4MlGmgZYH+PoPhlTeLpCVOw8rsm/apUJji5N397kwW
-66hpto7Po6xeVIgHjrX4emGm3aE1BsuoHJDZCNqum17MhmiF3BG0nci0iH+dFhe6FeiITPsMBkDD9cvR
-4cIz0HtOwybAc88Lh/qdpIWV1R/Yw6d5L/O/sGj0ZbEHsruQmP3sjIF5pgfuO4jV19crBzB8KiIFZVrW
-hYq2tgJnwlI3yIvS37zvpRyok5GTG843yBR8Rpx/GVj4afjcSaOByQdsU8L+zK821oMILkm/4P886Mvg
-w42x/HtT1KvzH4Ln71hY2q7EwWdxDGsa++UibxQ93GLBqxui+k9SnHN2BknRDYcq6aNIzxmMJ4Kg7G6e
-D77OxS8IbdNDRLu4YmlIHNGhWe2UhSl1O7RjsaysjON0FYvdl7iM2GImoy+Ecu6GY4K3Qo/e5wpCXrj8
-Xqx81jf5F7u9wzBf1LrD+3+4q36PRiYnGYFE6Hu2l5ZG0062WMaqQgvbO188gceNnVfy8fCwdofGklz2
-ms3WvHaZxWUXpm7H4d7uEcUkFevho7asZnZxAy0iN8GkTXxXftH6H4MOdxAkZ6xAUWbg5BS9vm6JOHo9
-esl/VgTBnLtJKYZB5FwnPjoyAqULkhRMvZ/c+2fcGkaczjJTle2FjV9+PlTyAsRSeOgobt2QMAPhzd8b
-Gyety/DqehR8dQLtORxTcdD3p6amLlllV/9qvbWk8COBr9lW4sTe3s7ukA4L8cbfKs+6evkO/7L5mIQq
-nGTRiAcICCI0sv3bUw6MgnQI7LNAPXH2j45KKz3ZfX1p6nKZqnbbfVHNvcWuvt5IVPz66Sw1zyEpOVku
-LnPi3GqipNVDFpvDAWzAnXprWBw+/A1Pcu/Q4Qv+KwIU/DuBoYVBpw9jaVC0lbEP3XVdODeTuq9SKCkp
-U88j30vSI04LjlUeZVPjiFFV3I8OKJ64mAZLLTwyMqKip9rXoexH9+xtlj7+qhjp5YEWlhPmwtgGbEro
-z/zcwIDKQH3fCyiIRPHxJF8r2qCzCoZ5jFF3/NospMyAHIjTrdbGQKLGO5v8D7wwRd0V/dBwm+QQDZpt
-J+G7G69fv1Y+5lTR0kK8jli7uEl1xkZWxhBNosIUp7XPJoahZ5n2tytrnoQvFkMwmQJf+bld9ZTChDxX
-8A+j3oarJQxTDv0ezZs8tIR2yXx/kxlcOmHuBsRHsbKxMbHiS/EyjBL1F1r40f8kLav2Opekrpnej+kq
-cmqT93Fkq9sy27i7DouLi2PVYi7BZHZkyjwBzsrNy+u0uoJfIIKirKerE3FF9KBJf4VfKLXXLHXJ9NzQ
-zssrpb/e0U/CF89cRPS8X6CzUCsj4g9jV1MTpPjVz0DWT9WKDISS9Ldre1huKyxsFvhrCGIBbff39+Gf
-Y+5Dh5VUdBkiPOHSVTCNyORFd9Y40ofEegyEhr1JPDPO/SJesKrH+Bf36jiJLleFuRJ9F+MCd6f4Wmzn
-/Xff7F9YH4jjqNjhus4lGFxERVSShVJzKsiTlBMn+mtofeK6CXyden66OVHszTPRbCyafe4UZ0EXZbHT
-3uuM8WDcOBk03M5Hs2578YtfX13NwzNlq9LZYzi4b7I4qEWb69+o1F16/EKlFraW4eSeYJPjQOKc8U7m
-PEWIesg/LzeXPk5xNzgGVigFh+5U3xGtF7naAmWpzyiTgeuksoICjkSlFLSrUdztbbo1A6H/I4st57lW
-IyunIXoWf4JoDL1HksBxyWupeX1s3okNsE0c3ybrdXbmTGSI6eL/fJr854/T2HdNiQ0N2ARBk8tHiR3J
-oCtbt76sYK+LV6ooM4+i8JU5E02ktrB9Xt/1sp4f8k6ZF8HzGRNzc6jES6QpqKlJag+R9vG9Wbct1nIm
-vDjIX3nVdhKPWxf9x8rCwq/++ZwnvoLT7KvtSoff43vpn/MKCvT2bWnLXUbHx9ViChYodwKc7XFWnb9/
-+wb7sAOr6b9FTk19MV0IKiUcVXFTU9PQhsEgfw1scTm5m0Brmytcr+PtrKPjgokbR32ONz3U1GJivgnk
-a5W/L9ERSKjHtVvDmt/L+/49c4f3uFSNmsJt9vX4vI8tqHgD7gyoCtWC7UqiYmvL2qUTzcz3dJzQQ2eW
-r8m0sQfxMknknWTLzZEZe5LBh6CbkPT8lQJ/enwgWZWmb2rKqJYPz5lOsV43+LHo+Gv3ONpTB2wX/+vX
-n+THAikl6q45oDPpD54TVnJTkJf/Vljo9qdMX15e/lWjj4ixb/ifbo3gvGQbYZps4ed9lXyYwxEaWmwg
-wcavvHHNltbivz63mxfGs0vWPO/Yy8aBia3KMk7auHbllw4NxSveRyRrzwY0dmHiljJOp7b3xGXzWOlb
-LmlZaNhxg2vRs1WdboKW/qalmvcrW9p7SE/8N4OIKpbEtF88f/5ESSmc6Ku7QvYw4sVdFYne7i5cuDAp
-icLo3mO2esjmL6beeVURhHFQI2UZgOirBAmtq6TF2lcJz4KmQ1bwV4b8QTI3ljJDr0zDfnDBQNX/CNlB
-/vBOD9y7GheIXwE2/wlqngeIqw6gmzhSJ9MM2u3wxTafxT55+cKEM5HRnTj/vQJvchanXlf8eNNKpM6N
-9C6Th6HxjgfJsCOuvuKyRtWckWg9enNYXMBx83tpSyurL1t5Dpe02GKXUg+aC3LOI/KLiwflhp6Egiiq
-WxsPouiOCIkPx50Pq2UeB1bSV7hX53ZkrklZFyUjz76AMtLPhJMc0R9MSFcV+i1W2IVY73T6ekpItEbb
-kGf8uhPaZmFpaWjlGTZOTXAUBBMAQakY0HH1wvhQS+WkXMqdneXjOt7EjP69ujpxYb7lXfBU9GuIcgMW
-xl6dxAOQi37/TiFj/TY0pIo48Zrvh0nt+HnfhSonKobEMb/8E+q8sJzu8iGr+k4roQBa4R14qLjSC7x5
-MRwfHb9LpUZBHLs5LjdIhCS9chiJDlOc0cHvUZsrlrql9w7C2aBescqzOjmUPs7umXF91X1Uqo+7xYpX
-p4oyx53t2p/mjjeSWrTufDIfy8zMLJc9rPwWda9Jc1A/Dce++hFVv+Vo7ouRwm+rHeatMfMY2qlm63ff
-L6NaACGKoMLKxsb33PQwx7fw7VKe2QQ1/nQkmeQ1YQpqb5fO7c6rWlBy6NJv2Ut5WajVS50eCl+6Z9hj
-n2p+8/0qIqwyRYKM+mvxJpvgzVO0qqvmZFiA/kpj1deCAruubZ8Mh3dgaTRLQ5ZMyEnryBoGiT6sxnWZ
-8G8WtXZusZgPz7eqzWOLWTo3lNcRq3kqoBWqv6Uts4gFXfPcTsuZT0OTmyjeahp+6nCEjzMLScbnz81y
-Kk8ijkC2c6JmrDuQgmdH7pcq3Y+h8lj7/L2vYCUiPcLhp9YEXOt4/5nBiOU4p5oiIhuporx48SJK/mvV
-AsPxBurcTn1Cc6M92OJqakQgW9yEDmBC0NlpIoZBFT+wQ1+3dFDEJ9nPGtMF//FANCr3PdPl/gtox2OV
-OH/zRmxRB+qZc+eqbLz2ryL4d+YIynPUc7/ROJu0huBVkMVim9jYhCFuWRssAu9xnDB8M5m48ORrdvbJ
-XvqVTtHuB8Hf30xnj6oaG9OphVzveM3c+8D/ha4HivjLnnpPPi4uu56K2Lj4eKelKBmtt+ggW4baVfYR
-ZisxpZhwdoXgUV4eXnFxcc28HtcNi4jA5Eyz7aR5RBIqYoJPwozFTjo6Ouqbd/TzJ+7RnCf7vXv3Prv7
-ENgM11uPs4W8mU1QWiANlw51svZI4JYNPW+5rX4UlJSU9OWsW25BbE5bS+upW/uZf3m99dj3z2VlI+9e
-fiPeOHsPcpoJ4gjv5Uu2NW9pEN6SklgK4lMwxw5JhtvYzphjuhx+3JYpspsVHFovmy94GEurFbq7yVaV
-+USLEIRCUhdb++tI2mIlx8TObg4ybGhJBZ8FaMx+/7YCQQQ0qEZQ6EfEnyy1t5PR0ppbWb1PTCR3EmBP
-f777aMUer94CcVvV+b10xned6hIobaPaQ7nozOo7Zzx3kdfflwlCpepcr++fdtwJ+SCGHBXJhQrr7SNW
-HyB3M7NczCCfPIKgwjRJveq41z84tsYsEXdbhySC1jevL5xGRCpnSxx4IQGQ6fDXbtX1Vfw7X8trr2kj
-SlMKPH1hbuRlVe5kRTLE3f39by1Vsc3I662Q7u7up6MhP5FCvfn2/TvuwR7yZivrXhFlO84okjo9Sejz
-589vn64iRdD62lKRkYTk5Et0+BekZ8bpVbfB9bRqzh1GRkakAMEc51IZn+dMWhD0y36C9WFtjNwpUwD0
-CbKysnpWnvIgLtgOcd45pP5LaMKpVqUXCZ6COs9Z9/c5jCXhH4CKMM2DREUKhCJ3lHuK8RIRolgmAtl1
-KkhzkX+c8JSNJkLt+XPDu0iMhSVV5FVfVYvqa63vaLGZIu/5BicpXF/Z/RynnK17LT92NoiLoJpkDULQ
-fZSi83c4H3jBl+fGqcjRIHkFBdCNkiD1uqP8j1z8P3LLfzlC0XS4f/97Btr/E0B5eXk5Nj5eCws5gxWo
-zS3PFFdyC2GzTAenR4FIJmX/WNsbOP8d+vkfadSZ/vHgB9uw5i/docgVOyskFY68nBzH2YJrHZiYmd91
-LP6VVrTLJPr9e2wcQySh9Fcs0oKpFX9Z5xYUuP5DAttkwxXpiwuxivcfsbNXXgPB37UZqWtoaF/Lzg96
-oFc3rh9faGioqaoa/bV6jHyW7H9MJLoLTVFnMv23IDW6SIxvrQXJCkiXfJpTUhINDP9Pqutr4SzXaxDk
-z0yRlZvb/RQJ2tL1GundmQPy2cOgqPmHYsIp5+5eHXy9Es8sLR+BIPOPO4ieoSPXvF9oa29IIW3E0FqA
-HJLKqqmZ+oB7bQFAkyFG7i9N2umIa3/qOTS+Zlly8REp0shmJ3IhODe7ERzUbWzEkHfPsVn/V5SyfyA8
-QpxIRXBrkX8bQ0z5v3MxHL8e+idqiC75f2GiPyAJjVLO/uephuPhjP8HKHJXUyRbjmPJjJBru0PBopH9
-XVt1bW0FYZrrtf1f2GQC+xQxIzCxs10ElTgTy24hJjIdQmZB2MG5i5j5awiTiTiEMF3X4NGGGv+TbK/C
-O8bI/avp2lriLls6SEawzWTJ8svpn2eN1nbtrQxL9xuF2Pr8bx0a3Xy7lWZPnHMDzj3+uq2mgAS1GQey
-tFhtcMkkQwGBFIfC0CG3aoXj7uFBmiJeyr/bqPnBFkAK6TRP+np8fPxW9fyZ5WWzfhILCoTO9XYdBGJE
-CvDpXE/cUxbQIT5hY1opq5Z3jzVO8oj+/wD8kQCJc551euw4/oDLH3LjHw07JAFXKYCX34QUHBXyVjet
-OwoDAvN11tX4vmF191YdRJwtK7aqgNkqnwQNIeivHIMb7JDIl9as7WRIykulO55hj1luIGTsYMBATMbL
-4WLpQegYY8gQ+Fr3H9NBUfzK4k3f7Qm3IXXJLidZddfCQsBGXMPm7wlnlJsh+YnS0tJeo3gpGokDoSwT
-f/gBcYc436EAfpmmhGiAhmzP/+CVpWWtM77sRIG01PwRmfGra+o9lshZy6Rfws9WX99GkKeJQRI9mFs8
-8NBEQvW/1i0uyAtGDFcrDt8ByyJ8thsBWEZb5reRtbrrIklFGSNIsT67EQhD94sVvo0LeA39keQAo7Ib
-wv0W+ZpYQPzI0AcBT+Co4lppWBhAWst6vcK1em3kTMT1jPzfGXIBDAQFTidcQD5zaZQbARP/CBdoTsdI
-IAdU9is79I5Y5KcyhAD4k6qPCHhVp8d4CPhlIwoE4642JBpyOT5wIHC8dBQRC/VoVGvxwqcDKIIDsKLX
-6zs2DkpuIWgnqMoDUyHROzuu0eNDLemgXbILKxAIuhPiLgCQgHRYHKEG21d/hLp1SOZDJXkIaqrXX0Me
-9XqDnpVIoqREZEhw19dIFa+//v3qKfnhnvZcvpgMyf56pRHQbP8T7Kyhw5Hq+hn4HLRxHixKG0L16olR
-dIRKk8gvsHIZR3YapMAKS6rW4Y+lR7RYMRDKmIbdPmvQxgKUI0MVLIEt6Bg7SAG+uNYHdkgAqV8slT4E
-IP3HMo8RWISPsn6Q3dOyJkEDhlj6tYtEj17E+W/0+iNovR4JKgKghEUh7PE1nepY1V+ADtjB16jHqEA9
-2a5ddASFIW6lx+0OXiiIGVX5d4v8RvNuoSiAnxO5MZYuAiJi1Q+481+tn5IiAIe0Qhf5FfWQxEe0jMNu
-l7+8xgnedqSSDkMBOuiEkaGBaUtypPCqnEa4ABFLF4jZpEZ2PZZb3n52QVKDUEB/jQI5NvQ/lDSUIf2H
-5DeADh7Pn+aXT1QoraECBl6frT+wAdxbNcBkkM84QKdjKY+dQc8I1fdtEAD8lBRIT+eCAqS4ylaV358z
-nuZFgiGlLN6p/QsFqMMzRrX+M2uZL7UjgogiAH1JRf6d7bSiDgKymr8CAuZVnWxQwEymS39/qrl+NWBO
-15OwIdzRsPOBFYKl56ejZV1sqw1i0mNgHnGa60Ev/U/XD2FU2ghaj2YjJK/JDT3M+uFINai1R0EBlOls
-vXcbQTH1rEu/Fkk7XFXeZtoskwwxmVVOslHTXmGLFI3hpOUsJXmjExXMyJpOV0wczaJzA10iO0pY9M2d
-EQv6KFb1yxHNRk2NHwYA+sPBhdA109iMDRCW7fipAwSMjE6sBnKJLyHISCImwCMQ678eo3DA461qyH89
-AkXENOTfmWoiwWT30R/3A51ZegjLX+IgCKUVlpP0lEhas8o/IrkBeAqfKxikGeuOW+RPtiG8TmAC+VUt
-urZHVT4GJGdD0OlcmGvWjWdcmZShRuBctJ5Xh3r2d6ADMYXTdBFrELmBZk1KCn6b4My81m28tP2CCmSR
-vV11RNQAAXz9ccDP40cggGvlNaEDIu6oR3PNBzA0MHyrCkAEp3+JJewp8WrLR073XzV56XFOV/RKEAD1
-Fk4cPcX2Hd6wIZ6j5B/pO1oHIOkTV5a/ZP+IAhg4IhjcqkRQ8t0i2FmVKtkh7YEAgSKey79Tlf++QTHM
-3catxBaDAglIwLy8m7L6MMtra2gOGI4O6u4yMSdzGwmNM+2eZpz09VU841dLpwYSoJowl82OHFYL8pHX
-ascYa77Dh/wIHbCjoSw7KkQBWGJTImMbos+YLVAgzT0ZgYdnYn1DQL45mR82klhaEEjzWWTbZdjIjgz2
-NvXU21slEEjAkflJA86Zt1OztzIvfR0ZBpDCt/PpuaH5gai0w5t83VcILAU0nl/9bmoJpiezhUoPIUCC
-xtDWxrOmpiPqocbTs8vj+f0BFAjEd9zesC0wPiLyUS8E/CcnpSxZ8NQg4P8XAAD//5EOMIIKRgAA
-`,
-	},
-
-	"/static/images/settings.png": {
-		local:   "static/images/settings.png",
-		size:    2495,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/2RVCTQb2PeO0bEFlYqtDGmKVFVSa8WSWGuZ2FVRYkmCqEhETKJF1VRrV0pVNKqMUktr
-KYKKUsxoq6pKGbuSjKL8raXKn3Z+c37LPee+d+873/u+d+8597xER3srMZGjIgAAQMzG2sJ5f5c7cCGB
-/bVjLh4HAPArEs3NHW3MzSGOVHIAMYQAADD44l0nLvY7gnf/JLCqppINAkHCqKCMULwLzfxu/cXzTT2e
-H/UajlnnPSB5MbuUF2OqBIeTyQad9P8TRG0Jig7/Sr6cKdYF9vtckQDFbEBr8/N07GAu/I2bzRNbn5Aw
-3gy2YCvMsx+MOAw+5+LQ2b7TMQOeWOHDKpir7wTZJV+XtlW1MjncXxO5VLsqrtgVQvtEnijfxQ0ws0VB
-ywoQTpZuB3TKo4Do9Y4mOTmYuMTS1G3vwYS7lxwSVgrUJ+OuTqWkHr8doKNrwhRuF+w0M/iZbaJ/hzi5
-Kjb0+ip4Bqb6MBOebFRu6YyYxVvWiG4sK4X/uuJTVZo1l1fJk0glMeXs+6KSxpWQ0ZQULmCgDe0j22LK
-ZlHc9T4U6caY9Sg/Aqm28D7RiHcWCcwcD7UjKgrKT/1dH2mHELlA/kQmt39xzTGz6p5Oq462x+qHRcfs
-Q/OanesbP59da2p5P5JxS/CDLqpEbfCKyW7L5jo1aTYyc3n+iWLqwNqFTAO3kZGZngfHn3fQndD81mZx
-aQr4E5MZZqJ+TFxH2WeMtBzU9re3RJprZI3+oOGJW7cXFawY3T7ibKz0+oUa9sbkl/wxlfdpT6XiOxuK
-hpwW667ONdVIMuWvRPgVF4VOnWmczZMnlQOFnHaTZqutsYQXDnFO7yP0uDddcnYigL1aWZ9UfjniLtpU
-sYz9fDJExCFepj2n9d0fr8crQS9jw06hyvNderoWVN5i3s7j2a1J62Zdsls3Nc09WRxlu7tJyJU/HYEw
-AXrcPHfaC/FeML/5lffUdqNQJZx6PK1dZt0y6tgyR5uD8mkgJ/iVjHD68fNFmp7zzf0ZTYXVl7LSR2Ab
-xmllknI11ZzqGDch9SeFuGDbWpoDgF+FZJ+z+uOtJdA1YFTCI43E9HL/8zcd1EXIuaLYHFGbx9bD8CrD
-9EX+epdBby7RJxy5epIyJ//1+Tz8zDkBrNyzvnC4TIF2xIxwVRT21LzUiJJRfkNshuEIrtKY5NeUjHIr
-H6HBLyjyNR8uH7jXWzYwxpu5AmQU7Qg8PC4y2H7fR2t/YIQp1h7hAABQ8sD5AMy7sgAAXyjR1Z3mbocx
-wJFJcD882Z8AZ5AogAMzYhjshyQCzQ/CIIWEhhswjKHfEAb78cExAgr5BqFdNIa62zlCzMlUAkQHrgM/
-DUWJQCAQIyo+wMDZ4uzf1/czY2gQjUYxQCDodDqcrg0nUwMRmkgkEnFaC6GlpbGP0AiPDKX5MTRCw49/
-J/kXjwUhHEclUmhEcijkIPfzJ0fQjKHQvzHf7bsQHvePDiWCGvJNBY9DEEIIJEIoLRyhCddE/EN+wI/H
-GYRH+AcTcDTUf9B9EzbzC0T8Oxjxv2gjxH+98Hv5iL/rR4kYIf7pJUpkFi1mCgAc6rGxMHW1FmO5XbS+
-QAe3JiYmurEeB9+6n1k69s625InnGcJpqQdQeX4nKxD6s19eO6diIi5djOubZFBRHiNx791WwcAxf5a5
-48cbRKmxqAcc7/eWqem5FkXdMTz4JpV8CrN94/neTi9yby/y3mWVX5pZjQvBJFL09Pz8l9fd3Ykvx8ex
-YaambV9fjmZjzybg3/b3SwXXbfekpKSUh4WFcbBYrHBOi/rlbN+IiHUYtXGntKqKkvChpLjYcvvzZ6/i
-2rw8+Q8fP5bNMp68Hxjw5l8E5mIGmffutZ6asbk2dVJd/VZ8fHy0Ahi89nx4ODcahyuiRkXtmGIwry7k
-5+f7wJSVf7tY5/mwLwgvLS0teqll9/fKykpPNBpNYjAYP0LCiMQ5tUFvln3DC3WoioKrmxt2aWnJpyPX
-p6Wl5SX7yXPJQwltvvpKP/00dofJvBRJo+HRKFT90sLC0MjIiJJpypXzYLjQozuYB5ruFFYtFIVCpWRY
-s4uCNjc3c1RVVQVAIPqw1VTusH+1EVhYRPcI1tOzTFFB4a6UbJRalpGHh0e1rp5ezOwON/5jXKYM5bIb
-Xlj8xAXu2loWX/qPBsCcGzcEcXp5avjea7R2yynoD1mlT1ta0BAvtri4uOy16ZzFxUWVeLNjh8XF7YWF
-hN4UkBma4Fiw5SpSqHXFAhIScb8sy/mhDtiswclSSQUGCyyEeem3jY15CwjvPigsnP5SXV19vFBGRmbC
-wmPCqrPl9+4+FBJJ/HN0dMGCT7bCrXx3eXkZpudBIpGMfR/dV7arWjMT5/J4MslJSe9eV4cGB79wx2Lr
-vdmTEFExscUzOjpPe7kdY2NfA0JCQqyDgkrljSSOdk0n7C3V1dUhWHJ/FaEzYIPagYosF2fn8ZvWysdC
-7CdvC2e3FfRhVV4WfDknC8P6Ho3/wbp+NKBWLV6nve+86Iuh61D9rr6Ok6Yus/YYTOmbzoZsW4JiQP+I
-q8Zou2xjdnb2+rHXUV95s7Pe3d3dW0ecKLMzQUpycluCyMaG1tY9UWN6nAJaJlWKrfqwrMzW3s4uWkFK
-KiK+GZNd19TUVFtfvwGonQMZbXbdbSrpC3KmWEqDQG2JWt7e3rV3Bunb29sYoz5kbtUQydd38mG4FtEL
-IrgxN0cEdiVI2bIsh7TBWdNhgwyJofZpHtDBVxZZUTC86gnM38Fcn9ba00undNy+fdshMCAg5XFxW5hw
-ceX8wgJtahycKoWZksi2LU5OS9vgfthCC517u9/tCTNNTMpoYyMSnaZ6VJ3H47lljZN9a3IXPn2KSVCN
-jjs0Ebsy+uoVxkny6p7gUXZkdHQ0NSxMU0NLq0aCTvprZel0vqiKisre3JTX9C9RYubMvDzDN1ETAgIC
-Z81t+dnZWVm0hqIi3HMQ6LKDQy+w8CqdX8LWcX8OXV1cVuUoyFgHzgmYuKqut1AsR+23GW8TLUglhxOT
-7xLHnk8UKPC7JGjyojTvMZ8Rn9ZripP9syrks5QxEX3+N5iGN2ncbaBhc6x8rAQLb3N9ppePdiN23D+n
-VC7RTCwxlVoMePNHQZrq5uzw8PCaRskyq7C8ATTIezZn6mwi4oGrqjWXNGyGn5iSfWIIznReuXMW7WGF
-Dj5kAZskgm3vIiqu8NElOnONNtwCDz4OG0t7i0dmvnH/HwAA//+FLFhKvwkAAA==
-`,
-	},
-
-	"/static/images/showextrakeys.png": {
-		local:   "static/images/showextrakeys.png",
-		size:    735,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/wDfAiD9iVBORw0KGgoAAAANSUhEUgAAABkAAAAZCAYAAADE6YVjAAAABmJLR0QA/wD/
-AP+gvaeTAAAACXBIWXMAAAsTAAALEwEAmpwYAAAAB3RJTUUH3QcZCRwbnobOagAAAmxJREFUSMe9VTFr
-FEEU/t7MZIMnXIoIxx2C50HAzs5m8AfYWAgRhPQpRGwMCEHSCSksRC3SCQFTaBEw/yAMBIt0e03gMDZy
-ksCRhQ0bZ96z2QuXc28vekkeLMPs+x7fm/fmmwdcgZGIzAN4AUAANPL/PwEEAK8B7BDRwaQk6wAWRvi/
-AXhMRPtFTudcFcDnEMKNEAK01tBaHwCYt9Ye9XEmz7jMpMRnRORuvV6vtVotdDoddLvdLhGZQZCagOAU
-Y4yBUgrGmMIYdRGNFZEzaxFJVBIfXUQSJoTwVWtN+f52ftzv+boF4NfEJMaYDQAbl6kTM6STv654Xy9E
-tOWc0wAMM8vh4aF4781AFU5jvPdmc3NzanZ2lpRSZAA8BHBvTDKv8tK1ALw0xqDZbEoIYTqEUNVaAwC0
-1oiiqKq1fjMzM5MdHx8TM59LJ4PWAZAw8/Nms4lKpQJmRp+k0WigVqtdU0otpGmKOI4B4C1E5KOMt50h
-pa855zhN00JwmqbinGPn3Np/6cQ5R9baRWZejuP4JEmSM/4kSRDH8QkzL1trF51zpM6phVOMtVby+q9m
-WbbSbrel1+sBAHq9HtrttmRZtqK1Xu3jyXv/RGv9cMzTskVEn0ac7J1S6unc3Bzt7e0JM3+w1j4rehaq
-I77KEG560O+9vykiNefc0vb2duqcW+qXdNQ8qRckepRl2aMoit4T0Z0R79ZBlmUPdnd3rwPYt9aGItB6
-ya36LSJWRH6UYFIRuVXWUDVGJx4A52sZRsaRXLpdGUmZTqa89wRgqgzzL/OkNVRbDaBjjImZ+YtS6n5B
-bzSA+CJmzsT2B6F3rQtjBUWAAAAAAElFTkSuQmCCAQAA//+z34at3wIAAA==
-`,
-	},
-
-	"/static/images/tab.png": {
-		local:   "static/images/tab.png",
-		size:    387,
-		modtime: 1457374192,
-		compressed: `
-H4sIAAAJbogA/+oM8HPn5ZLiYmBg4PX0cAkC0pIgzMEGJI+8bE0GUmxJ3u4uDP9BcMHe5ZOBIpwFHpHF
-DAzcwiDMyDBrjgRQkL3E09eV/S67BI+Q0Z8D94AGMAp4ujiGeBx/O8XQ77CDgGsyhxLry0Zul0dMIQVM
-5xg0nJxK5jQ4K2itm1hiYscktGmB8kUxkSOyfTdu/Oc967ZJp6nXqb3essq4vmzflrl+q8P+R4t9Eb68
-lnmRRte12CXRcptM/nQfTM29wjhp3dkzDIz5B2pnLfubvyNYgdGuMd1TvPe99su0jREyFs+rJrUd/LDu
-FnPP888/66al7j5w44zPpZ/rnXseVxjzzAmr3vO9OfVstT3HneC1nvOetx9g/sC3YsvWVbaTT6ZURxaf
-+8o0/eoshvVpaa6Bc1Q8N5nOPlu26swtfcY5MyPnPXfPnPWt8XaomuLkvRNOaqQY5RStS34g+OFAyKWr
-WsKe6Y1/GeJUYrfFh64OO/uVVcym4c8v5efXgcHF4Onq57LOKaEJEAAA//9sIiZQgwEAAA==
-`,
-	},
-
-	"/static/include/Orbitron700.ttf": {
-		local:   "static/include/Orbitron700.ttf",
-		size:    38580,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/8S9CXxU1dk4fM69yVwyIBCSEHZuEpOAhBAy2SYsErIRyZ5AwKUakkkymGTSzCQQtdQ6
-g2+rIvC2dUFbrUv/Vlu1FVwAFbF1ez+VulZsLXWrrdZdrEvufM/znHNn7kwmoP1/3+8Nzpk7557zPM95
-9nPuuUfGGWPToIhjekVLTTX8gh9X/hq+J1RVtdZtOPeFRMauugZ+z6muqKziC9mpcH8v/F5Q3djQMv/Z
-lR8ztnsXY/G26pZ1q+M7npsH9//MmPK7hpYl+ZeO/utnAPI2aH9eR1/7wMXv5n8ObT+E+2d0t3sHgkGm
-QvtbEF9370jXq+nXnc6YfTljqz/tcbV3fvaHmbOgfw7cL+qBCq0+Pht+d8LvU3v6fFv9cxe+DbAmMKa+
-c75rsH/FplV3MlaXBzie6vV0tGdcNK0LQFdAn+N97VsHeBkrg+sfQn+9v73P9dS7Xd2MNX/NWMn0AY/X
-ty3lzi8YuzwZ4F05MOga6LFdsR36Az1MI17BJ/XHRx45d8ryz9hE9R2oYU9m/PNX5newMjgrfob6JLSb
-wBQm/qCP8n7QBjSdC/cfj59BkKx/X1LNl2wli6ffCvXCulWyhaIUKAfhbpxyULkMfl8hvvlfWD70C6Fi
-7GLGsqcC+8zfAyODXqYz/dgkQQPQ9ragCXpVKH9EToS78zr4FMDFn1kp97Fl6lymq8ksXX2M5am3s0J2
-jC3jKayAT2cl/F02k/+WJULbIiWeLWMvwXcCs6mrmZP/F+PqSuZUS1mhchTq8LqAOZWfMBXvq8uZA9uz
-V5iDv8LilW0A91W2TPklm6+eDvcrWKm6CvAXsRJoX6oY8Hke6lbC7xbmBJxOdRKLA/qc6kT43Aj1q+Cz
-nBWpqfDtg28nK4b+DuVzlkz177KMuOtYhprDctUslqgWAu4koHkCi+fJzIFt2ItMV+5lBcp1UH8f9L2O
-pSp7od0+GMcdbKFyPbT5BytgHwQrlUtZEd/ACtTnoO398IF2yjXwfQ1LVqYCjDtZHvW7jxWq01lKnM4y
-oE2u8ht2mrIf+qaxVOQBfDv4T9gM9hy0vYilKa+xbP4DqL+PLQZ60tQyVoLfys0sifgS4xN/LowPeEV8
-snyAT6eKT/BT+LwR4lHUR0kHGqPrkUdlILMXYSwxPnF7gN59MOZtkR8Y01T4LGUvBT+CzzHl95I3UR9+
-nE1D3kR87gbewAf6F8R/hxXGXQv8RRneBfonZUa8y2FzwBfN5vPZApDHYvhM5z8GWf2e+IB6lc7+BO3+
-my3mu+Hby4aVlXBvPsgAdRX+VBX0YCqbpC5hS0HOs0G/ElQbtEErgW/ox9RlwTcBXoGaD222Q5cVrCjO
-D22WwacE6jvhuxQ+qFPYbxnQ/D1WFnd+cDQujtoWYh9o6yAYyK8XYZwHgSd4/Qh8b4NPA3x+B7//B2hH
-O9oNNvEz+L4dPj+m/kUhPv4EfiusgGDcBTADbDrx7gHAlcZSQB8L44pYOvKJ9O1u4PNewTcctvzMET6A
-V8AvTr/j+AL4rgKfE89s7By2jd3MbmN3s73sXnY/e5a9zP7CPmCfs1EW5Et5C2/nW/k25XHlqPJnPVmf
-qc/V0/Us/dfp046xY+ox7dgk8O7oXc5lv7DAeZj9EaTzV/YR+4LgNPPzAM73lMeUPwGcJH2GPscCRzkW
-f8weDAbfCL4ePBQ8GNwX/F3wt8G7g7cHfxW8Lfh/gr8M3hq8JegNtgSrg0uDi0ffHn1p9MVjNxy7/tie
-Y1cd+/Gxya/9Sj+oH9D36/eP8br/8R+3sRAwrjCr+5WVwOK4eJs2IcE+cdIpk6dMTZyWlJwyPXXGzFmz
-58ydN19PS884NTMre8HC0xblLM5dkrc031FQWFRc4ixdtnzFytNXsbLV5RBuq9fUnLG2tq6+obGpuaV1
-3fq2DRvPPOvsc75z7nms3/Nd7wXfD/zwv350+WVXXLlr5+7//smPf3rV1ddes+f66274+Y2/YK5NHez+
-7kuBmq5ONhBF4A7mbpeXD7DNfdtu/eXv7rn3vr37fsZu/hXbH2rW6zt/aHDL1pHhi77HLrzE/4Nbfv1b
-duddd/cw9hvG7ufB7XfH7bCCnQaeZj27hf2bl/Iqvolv49fzu/kh/gw/yt9TZiinKWcr1yh7ldfVZPU0
-dUD9pXq/+ox6TP00zhaXFzcYd1XckbgP46fH58ZXxA/HXxm/J/7++Jfi37Ypthm2Cluv7RrbY1qcNkMr
-0s7QerTLtJu0/dpT2p+197UvJ0yYkDth/QTfhOsnPDbhWEJyQn5CRcJ3Ei5IuDLhdwnPJHxpT7efYT/P
-/nP7/faX7P+cOGFi1sTaiZ0Tr5i4Z+Lhia9N/HjSvElnTDp7UmDSf086OOmpSZ+eop9Se8q2U/af8s/J
-CyY3Tx6efNXkvZOPTjamTJuSNWXVlA1TAlPun/Le1Kypm6feNPXQ1LcTJyWWJG5KvDjx3sTXp02etmra
-RdN+Pe2fSXrS6iRf0i1Jf06elpyVXJrcmtyb/PPk51JsKatSLknZn/JCypfT9em104en3zT9uemvpU5O
-XZO6K/VPqV/OmDNj5YzmGZ0zLppx1Yx7Z7ww4/UZH85kM6fOXDBz1czWmb0zr595O/x7aOY7s5JnVcza
-NGt41q5Zt8O/w7Pemx03e9bsitmdsy+afefs1+ZMnrN8TuOczXMum3P7nMfmvD13+tzlczfP3TV379z3
-502eVzCvdt5583bNu23e3nnPzft0/tT5+vzS+Y3zL5p/y/wj87/WS/VafbMe0K8BG/pTmpKmpxWlbUq7
-gLS+FMoHlSPgQTTGHBmFjuxER+KDfvxbrUwc/QzbLFNm8u8oz4o2SSkZhWnweTjAM/zKs/fCH7bRofgM
-4Mxm88F4UjI0B3wyiulT6KCPQ8MPhx/KZ0O5l96VF1h6N3xaLsy94t78SwvucmwvMIbuyr1LKfLv8t8I
-f/C1B/6Mf+1CzVRZevBa5V21GGJFIVtBWAoLFvEUR/70uTzZtgh/Z6RnreCOlORlXFwWFMGVA5uoGVBC
-RSbcgOYO5V2/du21zqYm57V++Ir4cdm112rGX67VNKWqqEi7ucl5lbOpu7vJCT9uaXL+lH4Yt92iXaX5
-b9Z+CkyJZ3nBoHopjD+epbA0iJxInyOFZxQmhcgT9KQSFfALKuNPcE9dkDa6Ia10PRKFRdZFNttFmqKt
-wwossi7UtAs15cjO0Yd3KqVNzlq8AcUHtkFNG7RdGK75UMMajeRdGPy3ugXoPJVBRp6ZmpI9mWekCezF
-VDrS8qenImehei6PL3TEp2USxxRj5LLEsmxjrbNpduqkJif35jdM8NuMHRr/mX/0Ef4Lo0vTHq0O1OtZ
-Tc7D0/PtKQs3O5vKlq5brmkXHel741nbYU14VtAn9hDpE2nTQ6RGUI9Z66dAG8xXikF90rMKE1F+cJWS
-mPxgcbGzsdFZXGzz+wH5QRSZUaEhvBLo94Xo5yh05EOvRSD0/OnQy5ZRUmyzFReXNDeX+DV+UIO+RoWz
-ieiYyefzY0DHZPiRqqVq2Vp2cXZxKugLv2/hBbWb687YvHZkwUhA2botu7uuG/7L3rbw4u9T30R2Jr+d
-f8ImwhhAv1IyUPkKHXv8O3b4f/Qj/sll/iuu8EO7ouAW8M9bcFYAY0q2aUX+tSVbhhz1dskL9jZ/gbuR
-FzwlrZC/YHRzt99P94rIt/vxXjGAfwCNEushdika6RorJiWz8dcyjPSMK5XVO0YfFLbihEKBNlNYMpsJ
-NKZJdqQJrsanAU9T04BXj/J9qCYo1ia+3/i+toz/AIC3Ys26dU6423qpZjx0gcZXyxkNPx/gAg2zuSMx
-I1Hjr+z2l/KnuTqapyTiNC2EO0PgRQ0nZUp2pKWkJZKKgaZRFehZUYgCsLfTR4tBuPs0DX75m5QjTc4b
-nU22KzQ/nw7fN2paUREKj4MHYIoBOHKQb8maiURYv4mgMBIRqrbyuj2r0WmcgerD77XZjOX8/wC2blQM
-DqavVO2am7Kg6HqkaNEiTbte89t2aVpBAVSg/SDv7wG8dnYKWTjKHcTmmMIdSr3L5TeW8acv9H/xBS/x
-79t3Ib/xmaeEnE2e6KjzSGBsihOBQ+kHtbBMgErgxugjypOjj4A72mFrct6A98AVaTfY+C/8QJMJOxU8
-j5XjJGnkOfKeo0UTIqvEnfxpA3zyo2hKgIjfI8EL+9phM16+wXYrzptBB5UzadxSlzISAZ7KnyY7dKCk
-RvcrVZpV92ayTPCE0fIpCPsZVXoZpCrRcq0qycmCQPzm+xwNF6JCaFIx8Fv5zq2nzZt3GhF7wwJdX7AD
-fM3oVzfYbDdoPOcqDXwz+TsHFKNEC2gjl57OYbGGbOI8R84QBYoPlQOw3ovuxjgDLFUpsxkrbfygzTjD
-xvUmJykHepG1JdouG8+63naThrjIXpVaESfRg6F3K6JY6ldqAwGiB/zBvdQmQXi/RPIK9/rBLWAbY1HY
-N8QHv+C3KetQ55LiUzILM1Nu5vHGOfxmvuDqaz4d/vhqjM3sh0oVPyRwogfJhI9SNfoZBm8+0e/fJ3zG
-suDn/K9KC8JKLVzMC+NTlvE442t+S8I1V1919cfDSBtEbmUn8GoGzCRCekTRCK1nGRcqmgRubj5/u7Sx
-sfS4LdDkPA4ymfXPQABV4C4w0vJyKO7SRu/xM4tuZrIlaC8I04Gagy6dVFMqKQokzYyChTIG8u+tdjYW
-a9IzPEJiAd01LoSrOZdgOExsdDbzqRqqr4wKIJmLG0tLnI2vemy2Hk3mOKodaDgFYrOpvZjDAOIkIfSU
-P/AHSpu3G85Ak4M/rhnV4AGbSkcPfPyxUlnaZPzyWU0DDsOcU50IcCazWSwrQq8TAahmai+MIcmizMr7
-9uzm0tGDygJn8+VGB2jvz2xzEYXGH9CUyh3zUhYWXVeKvs6h7dDgP+P062y262SsLMGZrIhtfAzTVNvo
-g8Ca8tEHS5uaSpVypVYzqrRAUynfX4ohzjL2hPDYkeDMsAzyS5WK0maicG2ILBi9UVXadDnfryFIQQvA
-Uo4ArImkaea/ROXI6Af8dmO9kji6WTkSuAn+QSNLe9R0s3FGqXKVaL6d2hpHw+OcAG3TZF4Xom4FKF9a
-otWfgd+YsN2kFGyhudSoxvGjCJXKkhKiWPthwPiUaoEVTWEfPFPSj6ELqIFkN035KZjdgkCA/wlGksez
-jKPKESCL2svcmDmgoTOA96E+Dsb1gpBJJtRHunGlYXuzE3kX0Ph+2+VK5egBJKO5WTNpUD4gHcLokQBj
-VTOIDIfynd3Gh3zq7uO3b99+u3IkwzhgHMgIUWPSn6D8EeM+0pOWAhTVKn8cvScQ5mGnGF8CDTCBI2iY
-u8Xv3m58YLwf2MWv4SUQp6caB3jl6IcoJgvvE8R4MqiX4t++y6gI7DqkTMUOojH5ONLHKZDpj8kqkjCh
-kKJ7hO93NgtOoBAg0laF1EpIZXSvZqqXIml4lk0C7xOOYfHZKRncYkslQlWNcjVgUdZnm52HwQ3wz/kl
-hzXtsOmL1bOlrbJMR4Sj4RYyVdv6UZepPaMHAByCDUgbAnJP1yw2oLBigAsYQfZzyQOAf4RsORsnH+gE
-eDjjSFa15tLse3Yd+QcQW2EBrlQAtS+mf/45EG4YmiQZ5ODAFS2geXHYBsSwY+cK4VimqtuRvVqY5aR8
-NpPXTrCL4mJN+4nWVPoTrLL+ELqTDIN7E3BrwlbROpJB8V7djvoXGN0LIcvMSU1/FNb9QrxC3f9BTN3H
-b8whIAKrR0XumMkdPAM+Qb76WuO8LcZ5196o5IGekd+AdnE+uJyENsIzJmJLSDaT1HeuML7sOXCg2/jy
-ijvv/MGdZK5BgwOdmfxVIxP75sq8cErYvrSMwgSYD6QqfbuN9/m03S+/vJsnGh/sftk0MhYMZvBKXpkR
-ZDK3Z+oSgDGB8AMrALey++fGPQHjnp99DNhWGJ/wU/ijxjMsQm7o5yCu8DRM5CElApsh24G4TqbED4Z4
-yI4LXoMdg+I4UooOrVihHPEbRThnAPtWbCK/T3LACHh8BiX4APHBHUq5iZN9IuVVCDYLH8ehQ8uVOv9o
-np8/JfAEv6cEaTbAkCQlOFrsR/i6Oo09rz5J86/UjOxbc7fnqk/edx/pN8zBlB8oFcA/iBc8FJUpTBby
-Qswg+ecQglc4m/hTmrGCP2WABVZA1K1qch5dr/mN089ZL20Fxqn4VQX8UaqERbESZiyW6MO/oJi9ws+r
-NeNx0GGnAHfE2aQqDxlVPA+M5ogmx6xcDLTh/NASBVMI1IMgjxWUITwCNktzxDzKTY+QPwAKFFeIFkdi
-qLfVGfDX/cZyglFmPMxLAfFjNlX52hCw0BVIYpQQLdPQv3C0UEquQ1RpMrHgx6FjkWYSp4lR4giRWQLw
-sfWaJuc0fA/QCLEhCSwfNSNDzIAf1l5/3d9Y+qqyzVYAHFa2OpsCgscLg7OUYaADVx1YPPKXJj3juTtl
-GHLan/EOm3GIxlnqbAQZloKreEw7G4DazhYkgQCeDI1W6izJcqqUZIaEiMrLvyhp9uOwIM0lTRAeE8TH
-TJ1i90FfVWp8ogpDK6CsuGL0oKoEmBKsDGayJ6nNZJpRZ6Qmom+l6XyVH8X55ZcaNC0dPR/JVH4qabpI
-8AuYpUI2nZ2RQrGUf7DjnXd2PNvt93crFelBFmTpx46pirGJxlKA7aEfzmXAcdEKQ1ZhQcA2NIRKN/pD
-FIz0dTuBt9PDY4a81PqtxjubLplw1BawvapFjF2U0DfEO7iePIZ3qPyCc9bO1M2iYzFjbbxpQPDjQe4k
-kT2Oc/jBKCuyGAJKFO0SdAbpibRL4FwMuzzkRzOPhPi2eprFLhWWDPA2mfCSUOswvwYyk0wCASpO5H7v
-Nx5G6UnTgsDU9PVLbyOBJnGmfBQG8OwiFgmOFz3EVxsP4aJAhZ8YLNYB8qDt96Dtt46Zyvf8clw4k0HW
-+XGMGjHRibV8/8yZmtYIM+5GrLL+EHRa7JVnhPQIDMLB97yuvfpqaaP/dfBGT6I6KVsfMvsolwgfxqPD
-ZyEP4vLU49BPsNuoIlr2Y2yHvuCR1UnQ1ybiIkaloauNQ1uNR65+Ey0JOpxlxk8V2lH8VDF+qiJ+br38
-aNddt7nevPyKn/7w59iDB4yLoNftfL1xmxgTxs/tpHNoUxraFMTPpGyIn/z4zpdf3vmz63e+9NLO65WK
-tK+NL9MOHUrjtq/T7qe+p4EebFFWoxZEeSI5Psgqt1j8D4yy1OYPuZ37jTUgjvtZhM1ExNQipc7w8StG
-7+FX8Ef8O41n/Dt5vmiPmgyJAHrjpBQpihU8l2cXFhfKRcS5XPu9VlRU2qjNiz+tsbSoSHPylXtRODvj
-kpQtqZciRXfkSvzBa9gDah7GyFQgv8jfo17zdU8o3vO5AldxYXF2oVxunMvnQS6opUi/NeB00mqVNtd2
-Gq3XXLZ3Se4diO4HcdPV4Zk7AZ2AN4P9hQf4CPE8vzjFBqRnpGuFRQCTL87Lzs8+9cLMJfqi07Iu4CWz
-zktZNb8naTmt5ULv+821e1xLSALndz/51SN+yAOUicKPpAWvUfqUx0F/5pKmytVKc30V18chyyG7V/r+
-8Ic/BEZQ7UYCfzDuGwGJQIT39/RQsGgaGTEKeTVGC6A7G4DfAfgJqjQ9Oc8rdOBqqFxzS1Hu8NuM9zW+
-0jjMbxq96NxzzwVo05yNyuxFmnaj/0Y//Hcj4pR5gzqNu9SrzDUNIBU+3BVY4FevCgTEOspi7uZXKU9K
-33iCdfT7ohfMnxyzVI4ySIOc6AWZExVD9MacaBrlRCrNTbjyBOBKImzSw5lMzExxFMbLRw4lYOmn++sC
-70J69EWdsUJ7911NWVnSPFry6aefNpc8/vTT2iHb04gv2MVeAM6hfkl8ewgdjC0JioC5DrOQm88x8smT
-iQUZMCcV0CsBYyaO5Tiq1XGQ1d+7/WIVpqaGVmEgH/TTGGgdQD1MayAzx1sF4ZANjlkJ4fOBtjHLIfx7
-SK0J95ETwz2dO7QxcPfEAvsHwQMT7m8I7rzx4BZnFDuA5Ml8DPDneja1bdqcaZsxFsPNNy9LWGjBcQfh
-yBqXJ9Iige3CJMcyqGbJgoLMU7emT8qZu3hB9shYXmXOPm96WZZn2gocW5zEu13ybO64mKXmj0X4QzCF
-sVhWCduIi5BJJls4HvwxVgN6PAbXY0Wzs7JmYzGnYCzKi7JnOWZlU1G+ZInM+eOB6TIvNteIJGbIj+Ol
-n1BttGQ0bXSz4YRJY1zb6GaI6jalFpePBIrAS4DiWVyfCHbRnDPFugaGDxaj18GM41nbs/ZGrIXt2WOu
-hiliPQpsYCJ5WssKViYokXUVi19MuimXsj427XLc/qjf1v5fWbsvg+5m399A3+Qo3KYORxDgCOuvBFMa
-obcAayfRkRQFTepMBLB/kLpIOA8LLcHxnArlyzCecF4OhDgDyUT9aJ56WM4Jx7YDj+UMXGu2myjaBT8F
-WqbAGFXMPKidOTZnoD08IOhhl4NRg29An2kwFoQ9UfRKkmNwBtYR4dB+pyRarhOBzSbQuk5otSoz2krN
-5Su+ItI4LctZe8JWqYp1DRjjFPEE+0QrW+glY61uhRxlrCUudaXwl98CD8ahWHj2nACN8l8yZgk8vyE8
-p54EjymlWMgiHGlMjJeaspQ47yCcOSfhYbS8YjI0hmONScLbkf5V0LFT8jjjJJRIbYtJgOloY0p0olBK
-zOnX808VLryClipS5mJMmYv5p5dfvquxcefll+9sbFR42t59aX19afv2QhlaIyF9SETvNnZFDS0yclXt
-NpB+5MqaUK2Tw+KgT5GwjOfHABMKpIT0J9G0syi6Ql4rAuBnprZEAY3WkZ1E48yYkE0PFgH4dBBE1Kgn
-hnwCrdfBuCfIFUOxYodOKrxq10ZGE1654304TvO57JMsM+J5TmH0A2QxBaW1VvFs8lreDVogyrW0kLPP
-0aC0/QIfSl6PE4nrbSPaf2va6GHldGdTGdkkra2pZ9Pa2szxVtdwRW7sCtvfkfzoVTblFeFTvhlc3Hsz
-Bu6mmHDTrXDXENx548I1NWEs8D0h3zEWg1AIE4dYb8wad8Ux2luMxcUTo3zFGJQXW5xEnMS7UPJs7riY
-zdnHWISN6BvGYPklaaUJ/0yCTznYONyLkYONxXWlNQkbg/LlyCRMxXlhXC2tCc6mp94Rq5OR+DNlLqg+
-6mykZcqvB52NIcQ2/nsx2aP1yg3xfgvav62nNQlHsIvWqFJoTTbDmpWF1mWfhazMY1mahZzMsjirinUu
-0F/MGeedcDWVjCPmiurvpSJHrqrym8z5hMBxzjfAgclcbBynx8TxHqAw4a8h+GN4HgE/NGmJjWSL1Wgi
-Mb1rpoCgXwLfQjme9BOvQZtKHBMhXywVOYp1npB/nQrlo8C7iDyxxJ9F7Bg9qJ4j489SKN8EOUbkiQ7/
-dtlOuVXmiR8BtZOAV2PzRIf/nPDwsUcoTzxGueWiWHlisZ/yRKRkUYhmsV5dzibTfsTIVdcxyWJ4GZbv
-juVGzGXZh6x5RlinxsnlrCu0yLGYq7Q9pgMeu1LLr5e53LfAkw0cj4nnX+PjeSvSRtaMlzNG4DElFhPZ
-vgjHPxbjm+G5jMBZPl7OGMHDaLHFxL0yhgBj8LYsJErTlhaNny9aqTC1LiZybsaEGBg3S91UwWYhWCp1
-pi7jrp1UuVuIf27Mvg9X8HAT4Fty15Bc61MuAR2g3G7syjHqV+TqsZsEbl1BVp6XdnoyWJgpRMB6fyys
-ORGwakSeGANWyNlFALw3rCCRUEN5ooC70MwTx0JONJ1aBGDifwTIG8LzXVqfhnGn4ireeCvUmZg2jl2l
-Nq4GHkQvVXeKPEnAXURw548POdvMbMdCvxDojgb+D0m42Bd7n1Jh7kMpIF8neB+fSs/i51pWAULhl4cn
-OQ41Xiy3fPUrnOTEtY12oEezyfUWMbUJGCXWvQ6Um8Wtlc84ITfLjIwuaCOZuOILQcaKKm6tSCS8+FxF
-jcMHjBpmEYUaPW85FooyEIG09es147BmeZZEz9Jh3rGYntJ9qyc63IErqRkn3wxhzOvOzNy8qe0EmyJ4
-7rJlNyM99HwJfOJ/Ts/JHzQZ5wl6TvDAia8jelQ519lJc52p4dnObDPGh2c8V9Pc1TLj+chcOzd5nIAQ
-Qk9XEiS9lo0Lxl8ko0IbGHi75IuIr2ujYHBVwLA8qPmrHFvoeQ2/jECAXs9RE3mxuhf3WYRmEQHTM6iJ
-0hdwNhvaFUE73Acp4M8WQNW9AhS0WaBO4wnq93FXbpJcJSe5OB6szc9ampVft0b9fkqmw5GZMp/aL1aT
-2PPqPpyzR+fgnvTZ2dmzsVD3Zc/SMbWGAsY8Hfo8o4rnAGOfOUDm3mbJ09UnotZGOUtVp3O/+jvxDD06
-BcnJXRiOW1kXqN+15hr4POwtZQfvMfeJKztGf8V7QvvE31K+tNz7cnR56F46f57dqjxPe0lwF2fxrf61
-Jfz5oYL6BNGXH2EPmHtNzP3pyhG5CRWfszzPz6H+E00Iorwb2v0mBCvICKKC8HgXwZtoQlSpfD2w1vnr
-EGzOCANnwzyTfcFvEa3zgaHAEWDnr6cXF08v4rcUJRXBf2LdQEmnvfAUMzPlWygUAfoDvMT/tl/+CX5B
-FFf+KfYTZsp5T4bloVSK5VlVSjKfxF8z0p1N54onU3Ct8W3GJfjBnQfL/E7/ajTb1XCxTOyHONG+fXyG
-+u/QXirAy9NyladG/7ATN1srR9JGm9KUHOAVZL/Ki8ozoA+zInZVC4UK73u7Cvci+220L5l/hruQbcoz
-Tc7Rg3yG1oi7kY0XbtK06+k5FuTgyhe035lxLV7EgyUcJnuWPfCpyhceYxEEkUN5tRM5xkvcx8vvza9L
-4EecX830r8y9mBcs1lavxvc3ROycTc9j8Vlf2gn2BoM0VnLcIHwzpxdX9iFg3LR9q39C3TPhPcJdXfR0
-qnvL0tWjebQ/GbRRuTe0b8vcVQ9epdChNIl99Z0XGv/DZ/tpZ/0F/Bflxh1Xhp8VByW/HYn0RkSRn99/
-5ehjO8GXva9MWzC6Qb7hpzxJ+7pAg5YW4hbJwjSNaw6ldGfAeONafu9dd6Upd43m3XefMvGP4T1MQbMP
-7cbKTsNUzVG0Uym9co/xut9/NyBpSoM+Cc9Sn+Cb4F/PI3rAhtKgg5ZBLwio511znjG86Rp+ycjyCz+7
-7TblyP8ZlfFdjad3pyjCyGSz0JEi3s0J7XqN3w7TZ40/cklCncOMZvn1yjkjPRize0aWrhaBvEzogcpD
-e3UT6RlMYlqhyo2jPMvc5QoRPxDgTwTk/v42sb/fXJODFIY/t7251CjhT2hy8S1g7gHUxPNvTm21eTxi
-R5DYDHjJ9kWnnnoaBeMAhmCN38nTjY9mOyZPyZ9tfM7ngF68CRHqNU3AjCuj50aW2VqoVO91NgOggwHN
-eD4gHkMpldroXlEcsexLxBkl7cSUIPYHlErqe3qom5nbqHFyH2uMOZV1J0w26PPDltVh/qXML2y/II22
-rg4fkAnUTQHaZ3MN0ZQUmoOG31IjoVqxKBciguu27yFYh0wUJvyenigMlr24Ar5YvTQTlIzE+Mgt6arN
-4RCyQIZcboKvOOyYPt3x4Yea9qFyhDfnh7ajWvZSzre+VVIYleeIt0seEPxB/pqZFq3S8tspnwqYiRVe
-EGwm94Zr5lMcsPO123eNHpIbju9MD+2xIduYFN61R+qlXLyd9FHjh/AJkbnJlPqUgS5dFPIlwAoVdRK3
-iKWo7yGKV3cF+MFdx5Qj6aPN3GZ8KTKeDOPzN7F/EIwyrkT0T+KFaqEDtTxDRSJ38APHIA0KAIhRRPzh
-mzwhQ6RLPN74CskWuviYuQ8bUScQAG4YTwDiCl6+fRf3GVfQM7pLjO9jZ3OscauEDThCAw2Vh2i0FfDJ
-2W6uq9eKIi/kB8ku58v5UpRZFhSHZyNonOnpaJzVWmBtLZknmOJH85aeMjlvnmmcPGHlvLmn8ylgoppl
-n2tqaD4WVgZTLNu2W7VAiueAqQF8vvE3IX/TPwndMhfbo94VEzp2ELN3hCksEOZ04jkAvx1gBkitQhcR
-++YmhXbumV40MS302Oe4WGSFuLfK3JGJy6pioycvNvd54jz0BoB1gndHHsZY5zeu9zfl807NuF3C2r0b
-96gZR35J745gHNmhVH7bd0cGErIAloPf7Wy6zDgKJpulzdWMB+GiXOMHWmenLiiqczYplU35WqvN1qoZ
-T9RpWp0W2qekbAOcMd8dUbaJYGKcLlZm+WHNeJamZkudTeHYhzSf4N2RInwBjCh8wSQLiHEazwK9fKmG
-IMMx+gyAFf3uyBmGhy8yXuS7R99TKv3fCZzjh0aW9pZ3R1JgWpEsmvup7bHQnuDKcd4bSYl8b0S52JyK
-aTfxTLl448SFHH5g/nyiViv1P0iVS3Gbn6DjJ7S3kYl8gbZrpylTAwHjV34/b8O5+LFjSsWx0Dghnw7N
-1YvEXF3s6T5b7Psb+84IfwXXMB6kmWG5dhmuXCAN1dXWvbdc7BwIe3na6ooDVfzJyTCwQ0DOKlC+o/g6
-3NGjtmMQ7DtsR8P8v0juQbXulU2JvVfWOh7QgYrwOyeQWb0AKePp/vD9r6Vck/Cdk4ninZNrn9sd+Osx
-/y6eynMhZv3IeISvNLaGZbtD7O2zvG9i9+8yDvp3HuIBSGwvNS7AXbAn2QNrfY5rXY9zivl11BbYiP3b
-5v7CE79vUsQfJaCPqhHbYJtLhkqaOT/AK4c0bchm7pt/mfh74vdNlB+sH/17aOK/QhLq98fcdh2ywQqY
-I8xhlvdNMsTrJvGW100UgJF6x5VPj/iR6BBovh9ovSbjwAEg+x6bpFfME7b9R/tmt5k2ZLI7cjkDbGl/
-RoZlGcP6Q+gMeGWlD3CL+RFucs+YrrT9M/APpcIPvveRqH2yMZ5kR6z5heyFVvwsfaNzBuhmrKBOq9Aq
-zQ5EE+6tnS721mYmiXdTrvrnVcbBrcaBq2/gt+Lm2lA+cqXYE27G6GW4+hX+ftiyJdxchTRLcAU4J4c4
-PzHW/tzvX/FX3J/7zuW7r7n4Jtqfe6GxHYztNr7B3J9r6u3csRlfIb1ZawpNOgXQLfwmtTUdg+1o+Oqb
-7vl98UXa8/uV8UXaI4+kce0ruecXchVlH/QV78rgbNyRqsy83vjQb3x43X1Ae+CJJ/hF74XiEdoyxPzi
-yBDviE4BMJSY73Ogcq0QhnjM1ohMBVUKXXzrvcKUfcY/BuWn4KQ15X52KisNvgrl8uC/oKwLHmeZzBnE
-PQHLoT6TnRF8A2J2OdRnsergBywb7v4FyhXBt6BcC3cXsLzgu1DmA4SFbHFwEZTF4K9PA8h/hXJ58COw
-tGpon8tODf4dykXBf0BZDvBzWRNAy2Vnwd0lLAdmYEtYcXAzlM7gEihLg5dDuTx4DpQYh/JYJVCyFKD9
-neVDmxehLKVyWfAVKCuDH0JZAxjz2dlAmwPo2Qh66wz+DcpSKIvYUqgvoutiwP4IlOuCj7ISKP8B5Zkw
-FifQiWV18C4om6hcF9zPSqH+RSjzAVcp0P80lNXB30J5RvATKNcGP4OyiWra6O5ZwZfZMpYJ1C6j8S6D
-9n9hy+H6RSirgw9A2URlc/B5KNcFD0DZBndXwN1/sXJWQWUlQCincZWTRMpBUljWA9/KWQPVNwY/h7IV
-ZFROYylnG6jXxuAfocRxlRNPKlgt8LmCNYGHrYC7n7BKoOcjKCsAWiXg+iuU1QChEnBhTR3ArAQ6saYV
-YFay9dS+ja430PVZIJdKgP8Wq6K+VUDtcSib6Lo1+BWU2L4KWn7EqgHLJ1BWgZ5UU/tqaI819dCmmnpV
-E65qGMt7UG4A+quB2r9AeSbwpJrgrGFTgtuhxPcl1rD1VJ4F9lgDI/o7lJUAp4bg17A1VINYaoADe6Fs
-AGprCFcN4aoBXO9C2UbXG+nuWcGPocRx1QLMz6CsDn4NZRtc18H1O6yerQXNrGdtgL0e6Hwdyo3BPawB
-2v8JygqQZgO0fAXKGuB/A/ATaxqobKSymcqNoDON0P4NKFHijaTnjcTJRqDzKyib6S7Kt5Fk2kQjbSLZ
-NZF1NBEPmwAy1uDommB0H0G5jtqvp7ttVG4A/WwirjYBtDdYC4xsH5R4txV4CzIArZkJZR2MrpXGuA6w
-vAtlNXBgHdCGZR20XwcYsWyiu21AyTqA/y8oN4JGrQP4b0F5NrRZzzaCjNqA5o+gRBm1kYzaQEZYooza
-CGYbcAnLJqpvput1wIc2GAXWnAma2UYw29g+KDcQVRug/UdQrgMb3wj8+QDKSrCvjXAXyxqgZCPo9r+g
-XEt30e9tJGvaCKPA+iZq2Qq2sxHgvAjlemh5JkB4A8oGaH8mjPFd0Dbk/1kkr7NIXmcBD38JZR1AOwtg
-fgRlI9B8FsDEu80A5yySyFkkx7MBwltQYt+zybrPJkrOBoxYos0eZo8af2C/Z380HoXyBbjGvzl8fegg
-LyfzyWs8u/GQvFZYHHteXqssnc+T13HsFH6OvI5nU/kOeW2D+v3yegKbzN+W1wlsspIkryeydKVHXsMs
-U3lAXp+SuChzgbyGTLDoMnk9jU0owl0iPA5X3QeJErzmkNfdKa8VwPakvFbBmv8kr+PYLF4mr+OZzs+X
-1zao/7m8ngB8eEJeJ8D1Z/J6IqtQ0uX1JJajXC6vT0lfT++N4PVkVlDUKq+nsalFt4K2e9gAGwE63ayb
-9QBXdYhtHRDTdIgpefDPCXFKB3toh3s+uuoAD9HPXNAHyxGoW8765P1VAM3NtkJ9L/PC9SBctbNOiHkd
-gKmPrWSs3DMwMuju7vHpCzoW6vl5ec4cva7d59PrOmr6XYP9rhF9eR/8XjXg3urq9Q4Muto7czs8fdC1
-AeBtAvg++PYAdtYwuMntG/T04xGaHkDZCReeXihNesdSWwr0RsPRQ91LLeNmSFWIqFLdRKYjilKifCxJ
-i8OUmO0XC5LWExFeaCtQIpKlwBjxrUMD16DXDeDz8pbmwkf/NsBPJB8Wyd1v2biHmg4AZzBD8cFvlC1K
-tZsNwbeHdYFcPWyY6jbRPR8AGoBvU+qsx+cbKF2yxNfj6nW1dw+5PF19nmFX+6Zel29kwIXSjUZ0YjUy
-AUapCPtf0GfM4raQjHqgZzPc9xIs5Ecn1FSR5BBTPfTsg9qx+pcLMDDe9ECdN6JPC/HXBxjaCbcuW/TC
-dwf87idsiGcIrjsJsy6lhL0x9iO+AWprhVwbAQF5Eq2gqJxLibYwZZF4TWo6iOtuSckm4pYHaEao7USX
-2bKd+Oihq2H4uOG3qTW6hY/tRGsZxGmdJFEKVEQqiBewopQHoM4LVHoJVi7AHgTJL4ExV1Hmh/8W/6/8
-s1v4DxkOyB/H1ADfrcT/Gsx04VcLlONxXwc4qLlF1NcFnBoESaMujIQ0uvh/cYR2zNGA/jKwo9Uwpkqp
-LSjNbhiJkLdOWhzWy5PrI1qtkOBC0gCh+z7SHC98u8GShkh3fFJzUO7oGVGH0A7sVA5LXRwgyxOYBC0d
-5EmF9mHfdoI6TNC6QlQNkIVuhtoO0rMcCxVDcHeA+vosYwv37ZDewidlaodfXXR/kGCZlLRDy3aito94
-ELYZtLZ+8rNuOeoOSXkfjb+f7MVNPsdqa4JCQfuw5IewqS6iyRVqayfeCFl0EReQT4Kb55PV9hN3ewh3
-j2V8SD96yRFp8ciRHimpzgi77wtR4pI1/URdO/GhX+p9D9my1Zd6JE8Hyb7tFs0SmiG8ifA0XosExvpG
-K82CN4LiIdkiR2rVEFy7QzUY1fB3V8izmTwTYxQyQR+0CXr6QrgEh3uJM+3Sa3pIjuZvQemIRbP7abQ6
-+cZe6UVHQi37iM5e4iDyQpecsFtGliM52wHtxDhMjP0EScQGN3ndsKabkhb9O6i1yZ1NMqb0hjiClGyi
-X52huhNzQ3BsCWGxjs7q6QV93jGRLlJ/OyU32olPZq/BqHhrh3qhw94Y3B0KacSmb8STMKcjdcjU7Vj9
-vZQR9JBWCv8zaOGtSYng8GAol4oVxc0xhu1AD2VWpu+I1HWrZiDs75LnGCS5md6vS8pirE2Idu3SPqPz
-idjxvxN6Cl6bI2snr4jab5dwwxrogb5DFlrCHtIcvTekt9H+VPjLcHbjpuvYEhDeogKiURVE2Xpc78D1
-D4q1dpZ+gvwqXfKhS/odkzcmNV7Kbk3ud1HOIcY/VpZWC9ZjZKa4eiPsAXEtgH4LvzHfTQ3skDgHpb/p
-o+vzQ9bnlZEKfbepHW6L77ZH+AyXtMMhgNEhuW+OMEd6BLe04Mj8y2oTkVIOxz8hlfRvlCOPJwdTl6xW
-7iWL6Ijy1NaR4+8u0rKw/thl1ho5V/BKisMWI+Ri0t4gW7uJgl4WnbedTH/MrEPkE2auJ7TpRFm/iPkD
-1MJl8UNeynNi+96T6Z8eQ//McdaNiX3fbJwnjjZ9Ms8xaWunSBK2eA9pWae0JJ+8k0NZyqCU5yaZCflo
-pGbfxZQnR2YWpr8I5zAeOc8QrcP+tStKQmM5bW1jP6kW5IRG2EERq1+27Q75XzG/DPs00drMJqN94Ik0
-w+S7nejdwnSiepj8I/Yy9diUbBnxrYcwfRMpemmk/aE45gqNxhWqE5G6W+aPfaF6H+l5D+WpHcQpzO8G
-SXrCFj2yDEe4AUmLxyI1IZX+GDoeaV3j8ylXzlUqwfvUQSxooblZA83Jssg68LoiKlI0Ei19ZF/hmZnw
-n4Jel5ScGHu/pCuHWTNtc74hsuNu5rLMIkxOR47aA1B9MhILXbBT9m56rGidHX/cYUxDoXm+memOyLxE
-wBQZr8tCYTjbi8yGR8gix8v6rPMQkbX2svFzaRHvxt4NryiMnT2ao7XHHK3wEeaMLVpDuqT/9VAGKqxM
-6FannEt5KMaWkr4spYhcT9mGNQc7uVX2S82O9DFuafNuiU/ktkPSh8TyPDkyQusxfI7AcDJP7ZXSi5yp
-Rc4yBF0oqy5pKfk08v8c5zfX0Gjaomcd/3/NL8J+K/YMw0Xz8h6LhdhDXkhYpnXOKVYRhkMRJDrSiuzY
-LbOq8Dw9dn4XzuO9EmJ4XhadsXUSrVb9NHMfn8SzmGQntEr45K1yJmDN7XooZ8Mei5m5yhlemeuRNWac
-QH6bmhnmwYDk6ACN3Vyb6ZOcFDEjFvQ+ivaizifXKdykj52EzZSmic8cgaBik9RPsSZmzcnHn317JGcj
-8UTOf0Uu75aZ9TC13BIztxqS+aywHYf0Gp5vYCn/iZ0MSdrNPuPn0/ZQPm2dXQjueGmEW2mu5qbs2Uec
-FtHZx1wylxo/AkbGvGiedJB0xPx8IORhRSw7WS4aOVMRMITtR2bN/aFVlgE5DleMnFtoY59FQ0wem7MI
-M5MeCK0n9I+TYZiSNueYBcRVc42gP4rbkbL9Zhl45CxXj8jXYsMdPx6aa3IiBkeuPYTXQqyrhX3UxhXK
-9DoJr1fmMcK7dMpVDR/JxxXysfaTanuO1Dn0dAOW6Iz+4Xyib4v0+90RGj42+xPwYvHj5Npl9rJ64fE5
-PRgRTaxrDyezHntM6xF6UxihNyfO38ZmR4KqWJmTuQp48lkQRtY+0oKwTowXZYU9uOUaxwj7ZqsU1kww
-jMmqhePPXU+2DjZevBTe4tuse9nZ/9frXmNnUSde97LHXPc62VymNTSXqQfNNWctJ3pWhxwXOaZJ+ZD0
-DKaUhuGum4k1+i423gw5OteJzp3NdVd7iDcivpurcjj7Kme1QHUN0I+jQKrX0FOw8POxFlrlxz04ZfSE
-Sad+Oj1vagC/UkPrexVQg3PaFnk/nbSujeZxa6DdOoIlYDRDibA3MvEEQaff+GstcbGCbKKSbZDPtFoI
-agNc60RpIz2zq6R2OvXAUayjEdWzaoa7CAS+euhlPuOrI1oEpa1QH8YaSVUNYRSU2SVfymEM4m4ZwK4h
-eEh/DnEKr+tDdFZJSsuIRwi5lZ4wriNON1PtOvhuhHbiiWMZjVlQW09jqIL7YiyVRAFitkteldNTzI3U
-ohroaiUqGkn3RMscGiGOp4L6I9a1VCsoa5BSxuswlFzJS0GHjrvtJDzUARx/LT3rEX3tMejQSdK1hLWZ
-pFApeV8mn0lauSN4H9Y/pK+Cnl+W0bhbYtJrQrPKwB5TB0wM1TSKSuJHLWFpofWHcoJUG9Ih7NlM9a0W
-vRLaLSRfa+FhuVybqMT9bbKFgGePGoWwA6Q/PArB5zJZloe8hm6Rcb2UYXlIog2kS2O50kYWV0mtykge
-LZILdtKkBsld0woFDtPS10ktbAhRFslf01rMdt/EQwhYJm57hAQr6Cl1raSwJcSNk8PN/b/Ys5Rj3+L2
-9ejNLq9rcNjVqVd5+n16fXufK7SbKNdub+1xe8WdFk+Xb0v7oEuHil53h6vfC32G+jtdg7qvx6W31NTq
-DQOuftG4VjTI0c2NQ0tzl+YKYLIvgunwDLgByCZXr2dLjt7e34mV7b1ej94+3O7uxT04OtHYrleVNent
-vlK73Fzj7Rh0D/i8uV53b65nsHtJQ1Wt3W5f/J//2Yn+xsp6vaqhvlWvrSmvrG+ptJKvL9bzi/Qq16bB
-ofbBEWR08f8VQntjc2VZ3eraSmCLS+/2wLh1Txfxcgwf9QUwwIU6ct/n0b0+d99Qb7sPmOMZ7O3c4u50
-2Ttdw8DFgT4XdAIoHZ5eYJ9nsN3nHnbpXQhqYNCz2dXh8+YQiKGBAc+gj7DR3Q5QCx+M1O7q6oIbREp7
-R3unq8/dQZLpdfd3D7kBdQcA7+sb6nf73C6vkBoABOjDQAdIqmvQ5cJauwdH0TUICgVknq+7+/UtPe6O
-HsLn1fvaR0DwurcHBtUpZN+HQOAHtBxoH/SB8np73ANCSz1A6aCXFBL4U1ULagJK46UBhLRRQAZqAPAQ
-VOQAq4Y63XjR5+l0d7kFJjtghJEMujcN+bAXENw7oreDanr6u/EbgI4Qs/s9Pt3r6QUVHcHKPq+rd9jl
-zdWBCDshywFiO3oBB3bsH9HBGtzDguk4aLjf0d6P5GwCS+lFQlx9m1ydnXgVRQYQtsQzKNAJpQd4XtPo
-JH+B1z3tPro1KO3Wjv/7cW+IXBw3khtNCREtOYTcDt/35th7PFtAfwaJWgQCBA/idriwiSNGkoGOu+JQ
-OyTXBTMGXd8dcg+6SP1Af8KSgLp2kKfpJyz23+kBqhFZ+8BA74gd2hIDPR1DBIUUEtF7kbe+EO0ecjfu
-QesAQC0qKqtq6mtaaxrqW+zpEf4qHWjoAt1BahCM10UW0uXuBfyhUQoB6yFnal8DcnANLvAujEU7MrAD
-eg6C3vS1D56P4vOCUXX0IDvcpN12oRmA0DM02OESCHNAEdwgYOm/hCTkkMn+YCjpYz2ydQzIJSFy74Cr
-Qyq1QK63d/mEO7Z3hKKCFwCTYGAsCL0Bqt397b2mb4vmD7oO8BPo9YBNka4fLH/A0+8iHfLardobzT89
-xD/EWWdaXwycUWbTBz4HobV3klH5PDlwt9flgx85drSPoU3ghHxDWKEvXmw6C9QL8jAeiBlQTfraJQcU
-IlrU2KNZkIMIO3ra+7sRKOgvxEvSNKhGN2lqYCQzkHZ7v2uL7uofdkO0RB7jYMuGfD2ewbFD9Lq7+9HG
-XIjGhVdg1N3gH/vw2ufq6Ol3d7T32rcMulGKgF4Y3ABA8dDQYCj9IY5LcUXQBOgbK5vralpawBD0LL28
-ob5CGkWja7DP7aVgBvoJcF0wOMDe70NfRE4b4wa4425Xjkm0RO3Z5AMjBi7Y2zFmhzgbgZs6DWHMR6c7
-kkMtwfG6CCC5PemGR3IiXJ+IIeBaeyO8NNhd6CclCl4rWnsYLWgEBjaTIV0ejAwoMuBWpxsV2Vtqty9d
-qNe73MKDjRFlv2fQ1Bg3SN4N/cDbDoGGhJUnBwxaD2kOdIhWajBuM6jJkAGwXL1dIJT8hSfuGZOhJjQz
-dHybeJETFTBc7eAdUCB2VCEQpoickCIMu/SwrwB3DB5OxHSLvyMfD25PxDLTsXXqkp/ofXzQZ3E7BFCw
-C9dWn+nteob62vsXY8pJyVwPXKBNeAaRmUTBABA6MOjGbKYPiATLCDfvc/ngygc5hdvV2+mlYWI/RAAg
-NgE/IRMTnjwifHu8LrOPjL/g5d3grIfdri1hbwXaOgjScYBqeMYIZXyZQDe6E+Gn7einRbgAcry6a+sA
-cM/t09GcfZAJDUQYoLQ8k5IOzyDE8wFUWLCyaC8qgwq0AOlL19yPKQtETrQLqfnAxj5iCFKMIQKd9ADm
-Cf0Wh4GDxohZsJAygn5JthxtDAcuQ64u/JqlbYQdYiYHFiyzB8pCRFrY50ETd/V3egaBb2honZBq+NwU
-Rkfs0WyHpls7XANkzu0d5/d7toDud7skl6T7g3ZhOsawC28JFY4gelCYicgeosVjD4sHeFMoeBPl30Lu
-CECFnVNOrBCUY+8b8hInrCYLcoAkCQQ3NqUQTpA6CRZGRNfoHMxql/p4uZf9m+Ze+ji5lz2ce0VHmVaM
-MvVlGFoiZ3WbXOAxEfgQKAMOadjjhoy+yxqQTa9jemfMXe1IDdg7pnI1LeW1ZTV1lc321jWVYj7W0lDV
-2lbWXKnXtOiNzQ3rayoqK/T0shb4nZ6jt9W0rmlY16pDi+ay+taNMEHQy+o36mtr6ity7JUbYKbV0qI3
-NOs1dY21NZUVOXpNfXntuoqa+mp9NfSrb8AZX11NKwBtbaCuElRNJfSrsgMt5WvgZ9nqmtqa1o05elVN
-az3CrAKgZXpjWXNrTfm62rJmvXFdc2MDTBzL6isAbH1NfVUzYKmsq6xvtQNV5Q2NG5trqte05kCnVqjM
-0Vubyyoq68qa1+YghQ0w5GadmuQClQBDr1xfiRxYU1Zbq8NdewiGvqahtgJar64E6stgJinIAeqJfzl6
-RVldWXVlSxguNhMjsIc5gB2qK+srm8tqc/SWxsryGrwA1tU0V5a3Eq+A3TD4WqIQcoqWyqZ1UAHt7BIF
-yGBNJaEAmsvgv3JUDZ1GXA8jRDitDc2tIVLaaloqc/Sy5poWIMFe1dwA5KIIoQcKfR2wEOVVL+lFsWDd
-WIWAVtjbLgZYUVlWCwBbkIwxbXOjX+T5Zu9psPHXHE742pc864ux4D4mX/mO+vuS3hHE/9tcHItnNqax
-CSyB2dlENomdwiazKWwqS2TTWBJLZilsOktlM9hMNovNZnPYXDaPzWc6S2PpLIOdyjJZFstmC9hCdhpb
-xHLYYhjHEnp9K585WAErZEWsmJUwJ4x9GVvOVrCV7HS2iq3GN5tpNyquWNawM9haGHMdrXg2sibWzFpY
-K1vH1rM2toFtZGeys9jZ7Bz2HXYuO4+1s5tZgG1nVzI/28F+yF5jN7HfsCfZ4+xOYEsH28U62f8wF3uC
-PcWeZf8Pe5o9w7rY8+wI+yO7C3j8IXuJvcBeBLm8y37ENgMjz2d9IIN+diOw87v05M9Lz16G2Ra2lV3A
-RtiF7HvsInY/+z7bxi5mP2Dvsf3sZXY3+y17k73FDrC32d/Zn9jf2Kvsz+wv7BX2O3YPu5fdx/ayfey/
-2B1cYR9wlcfxeG7jGp/AE7idT+ST+Cl8Mp/Cp/JEPo0n8WSewqfzVD6Dz+Sz+Gw+h+P/WGs+13kaT+cZ
-/FSeybN4Nl/AF/LT+CKewxfzXL6E5/GlPJ87eAEv5EW8mJdwJy/ly/hyvoKv5KfzVXGVQ4MebajfXZmX
-l5fghXl3f257r28C5NcuvNB8Wzz4Pem7QxDvMR7h3S6YROJF/Hoqy7C0UZmvlDeLK0d8Od2sofIMKtdS
-WUdlPZUNVDZR2UxlCwEjwPniyxHfRnVtoo6+HOKrIH4DddpI5ZlUtmMZ157r7YjbhEUHFp1YuLDowqIb
-ix4s3FhshiL+fOp3Pv7uxaIPi34sPFgMYPFdLAax8GLhw2IIOw9T52G83EKXW/ByK11uxVYj+PsC+n0B
-XIo3coNBskrz/Vx8P5aBVelkrRz+fSH+z8qhVubHDrblY7y33dcPVhpHdh3EczqsFtwOyFiWF8tcb1/H
-ACvwevOWslIo89kqKB1gZ1bY4kqlMo7KeCrRF0wluhaADZeAnYr2JbLtc+I3z5ffV8jvJ+T3S/L7TcDQ
-DJ7hYfYIe5A9xA6BZT4B9vkUWKWwxmfBFl8Ge3mFrOU19ld2DGzndfYGWNPf2TvsH+yfYJvvsX+x99kH
-YK+fA+Qs8Eap4IfmgO/Jkr5GeBrhZ4R/GetVYvmUswBeM3i9GeDPsgBOAfinVeR3FYZvWKsw1sPgLVTw
-FM+Dr1DAro9C+TbYusI+Zh9B+Sn7BMrj7DMov2D/pnMcTyVPVw6eTHits+FePvjQ2Bx4DqALLhwFuB8B
-vM8IzvRxRhj2opEYSoErr8bg5NsxefkxUH4cOPoF6V45QGB0HqcKIz9Mb4urchyi9vfAhxciaieD1piy
-RQ0y5WLq7DygPVpnSTPOdw1inamHcVITJzAet4da5oE33souBQ/+c3Yb+NCDQNGfgOJ/MwN84jzwe/ng
-06r4Br6NX8F/yX/N3+afKqcpFcqw8kPlsHJEeUl5R52s5qld6oB6iXq3eq/6oWrEFcStjhuIuyxuF/4f
-pIKv0mkn+M6/Smed4Ekn+N69Quec4CknKp1ygmecrKV6POEEzzdR6HwTPN1EodNN8GwTTmebxNPZJniy
-CZ7zgaea4JkmNnoqhiea4HkmeJoJnmWCJ5lwOquB01v/NjrJBM8xwVNM8AwTPOkD3/vndH6JQueX4Ikl
-Cp1eIq7w7BI8uUShUwTwLIg4OrcETy3BM0vwxJIEOrEEzyvB00rwrBI8qQTPKcFTSvCMEjyhRKUTSpBu
-PJ3ERqeT4NkkeDIJnkuCp5LgmSScziSZTGeS4HkHSCXyDc8iwRML8BwSPIUEzyBBmvD8ETx9BGnD0aj0
-VA7PHcFTR06hU0fozBHgJJ7/gKeN4FkjeNoDnseBp4zgSRR4wgieL3I2cZRO88BzLqjVV9QCzwKZRGeK
-0Iki1OITcQ6GhIWniOAZInjWBZ4fgj3i6PQQPDsETw7Bc0Mm02kOeCIFwqATQwAOnhaCZ4WEYb1LWPGE
-EDwfBOlS6XQQPBsETwbhdDJIHJ0Mgmdm4KkgeCZIAp0JUkH8fYVOA6GzQOCD54DgKSB4BsgEOgMEOVxN
-FBynsz/w5A9T0qcQnYJ3x2mcjSH6PiL68JQPwbuX5ZhRj/F0D6yNo7M98GQPfCrYRtlfJenOZ3SiRx1B
-/Dud5YEneeA5HniKB57hgadtcDrBYzKd4BHmluA69m2QnG8mbn1Fp3XgWR3YF8/pUAlTE9H6NxrNB3RC
-B57Pgadz4NkceDJHHY3uLTqTA0/kwPM48DSO9WSjeBYHnsSB53BMlJITPMMTOPD8jXrSyK/o5A08d0Nw
-5x+k429RyzOk7tJZG2RxeNKGQidtvEBXE8DvnQa+aTFktBr44RWoJRBdUgFLE/i6FvCgp4KfOgze+vfw
-b0koyrPHjbv/St8rltNR3U9mvH3l/xsAAP//OQKBdbSWAAA=
-`,
-	},
-
-	"/static/include/Orbitron700.woff": {
-		local:   "static/include/Orbitron700.woff",
-		size:    17472,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/1y6Y5AkzhMtOra1Y2PH2rF3bNu20WPbtm3bNnZs27be/P733S+3Ok5k1YnM0xkVlVXZ
-EQ2QFREBAgb6GUL8QMj/2eTMn/X/mP93iIgoSQMBAfv+THH/g5ruArKokLDID5f5syb+wW9gSiBSUTlZ
-xR+uFwgIlAsICByCYIb3TlRR+S8QENjHD58PBARSD244hy+rSM8EBASR+xP34w+kF/x5mWVorW/3wz38
-+I39+En4njM9m+o7/nBQP3pAUP/h+xsI1NTKzeRnOgoEhG8MBPT3YZ04g8/MWN8ICIiC4ceH+Qesj0NY
-2GY/5A/3wwMR/oAUUgacwszayfWHC/zRt/3J8SQAj/LI0tjBBgiI8uH/z3mcx4C/2srW8CeW9kcP+P4H
-TySeKCbW+q4/udCT/mjh/6cJLAAkYKNv/ZMDvRAQEEoiEBA7xvi5iamdraMTEJDAys936PxoRnujV7/a
-ORj/xAqx/8RB/gcziMigH/u/vR75qt3+n+XhLvjPjpEcRbtmGuoZ6entgBpAQ4ATjg32QgXy+aWCTxrq
-6QXqAeFCAssAAblmSidKWknJY458H29FWJRFgeFQo9xWqqa7WzlhFi0icF6maqjV8hciI1PDQiPTVNLS
-DP3dQybFx6GFhYUuiyiOKzaKFJcWWUwsnNsHTEtJ2Fx1HXvGfD9/fx13qIooVGFYFk3eCpbIlIogY6hT
-HzOOIOQ4sSq7vIB8V8eFVb+OQuAtYmn/U9Zelt6cV3w8DfA+aSme/NuwQsN8PonRMOCU0XAU3qZvk0Z/
-ZFrLLQZWEhAhoHvD6frLE/58IPhGbAUnG/Y6EHHgelepkWgN41EkJb8CpmkMwTyCtnxKptaynn4V3jzl
-Ey7DxEpc8+4pZs3Cce3yEt5F6XwUunG8Wq7gqGHNKGQMN8AnvhVLw+BeQx9WD45S2AXjXCqxVLmAB2YL
-m4kuwV4OejGuKUuDjk1WKSHqdnjNCrLORxW2lUeJdWFCrmIseodAvwnD0jipfmbB/F3dWeERCI6EPdLf
-FWfbujKGBUcS7l5AravfMYV35LRMalDbWX9i7dmpAw0jGoCyxaw+VImPfC/mcQkkJhsaxbOef5a005YF
-NzJ3ujTcFWKzvgpY0V2L8Y9WZGPEeh92dNwMtBCOQotkrk2VzGKsddCDD9ACooZUCWsHZ7iT2L/e25zi
-itDg0ZH/tdpfjcJySlIvNAlsqsXFniExyJ55N6+W9YTDXqD0BZWTPxP6Zyb7+I9F0tE0U8ij3XiA4g0m
-l1fMfm23rG3bd62I1yJcc+XnrluQUfLYW0DJRdIbzJeNTDvshc172IllpmjDJ/+MzLZAbZYL4wpj+5AZ
-HuojDD40t8xuoemOe9bgu9tb4bF7NvoluWcO3vzHMHfPn+Zwl+sKng4Om0VQW+csisVKYYuALEM1yPiM
-LKbH8VqHUBme5VOw38JywQJjs9bZX0w6VWCLX2XK8puHzapJ2+eL6YFR+9p3bYitgrqUh23RLzYSO3B6
-eEAkI6RoaXPx3yKbRs61srvrM3+c8xXWfArE3S0o8mGTnRcTKsyWynEOjkadUTnEbfDpiso5wfn2OQja
-Kjg7zLfT3vfeSnRyFzLKvLseK7BpZx2+pCsadRoa5TU3E0uFYUvj93RbZHmEHuyrlhyrWXyAocpwsn9q
-xzl+TFZRH23Ip6ZIGIrRBsgwkijwD12juSVLkdhfEGIYGXDgIOl9jEjxd9MbekrBjNHfaulUAaCh4FgL
-TErqfaceMcKo7RzPXj+fDY9pAKOvRDXqCGb4sK6jH5MxO0dfld5Ob1kkLOAPJ9oIKnUuG+vHGXyf2uQx
-BQ3cP+JO4Q4qXJMkZXX+kjBj8nYpJ8VpMjrFkmrev40rz4JPm4nOrCAuuHX7hXjMLhtyIVD4IljHtNeY
-+zwJUoxTcA2Tt35uGC+Dpslqh7C6r8OAJbVjSByr+iH65lX9FB7v+5CeD9SGEAuLtn7ls0evgAxXlqKc
-n7vKMYT8ak4K57nwiRePe4coy+k2lYL7MI38rDjk+GFuxPeFWEnsnMXebQgpec1l0yWWkLKLkvylNFU/
-rrGemga24QPtKgEV34Fw0pGU+6gHk9osV16qijs/51enkdw3yEKiHSGZy0SQ8e3RIMDobiFRTm2nWQkD
-ADWRlOSYOZl9fthNPjMxa6iMbYD9B1AgoYE612lmE1w0ME5+KNj+20Ne0/GpOQi+fvsQjSU0eua/+81Q
-z4BJf22gd7y3vzGkLMLPLw/yL/OWMYPBy1Bv7yAQKDwc3BkIHJwMg7eAsTxjAEhAPyh+MeB7FRMzVZaJ
-GxSG2f8gVSuVDoQSHIIcaOU3Isj/NPX0THz1sCBECMd6T0AOGP1faSKx+dei+vz8kvyiiJLzovUZB2CC
-4eE0MZsZL/TYZ+yydukGPIKv4XywvpixmSCYyZlUGJYY8Uzbh5xEBYQEFARUBMQFpPWj4v+BBkNOIq4Q
-3Hx8I/6R9SPK1ieK53jHomPaMGqduYnbpVjJJBwGy+VokhpJtQGaQlaJlYsVYAo5BT4Hrf7+/ub7+j75
-9vv2+LL97Dn9Hv7G+ap567n7Dv+e+KZ/73n4jv8e+g747vhm+6h5f17dtvSp4U391psOUEcs5kn98L3e
-kgjigelAd6G88H1JwfaNiwK2wSRBjdX7qwoEZ4ChwuqCIENng6/D6w0sQKSPLM9oDy1OaY6lzuEGLkxm
-jK7M4gwvTWuNp83jBSpIYoiqyOwIK0ltiaPJ5QEJIUph+kuVDYAoS29LoMv37dubuxP7r/YmdLT0JHWl
-9QUsuPOjf6/8LTn6afqqOrsb+hOVQjqN23ntIY3ZZCqNx2fLPwiuTHwMjmtu3bdaXFL/N7+jHr2gCXFi
-WwIRdYvNab10Rov1dr0z3cBeElzHzriDRL1kxDg11YSFBVZ5RjpOSuXqaK3NZzAcRHATUCxMPub/aUuA
-f/oBENfMLC5YJ6XFJkBOxoYhlHCIkRgjRGpkgi9U8Mak1JUeP+EO5D+p8n+LJVVNyr+1ed/XiIlDR7tu
-1Ykgtso/BvdamjSKzm8VQVBqvW9oyWg1dOybipLXYqoUIl74jyfX3zM9rjdewSxBHU2Ppjk8rhu+TXO8
-WIqL/kivD7yxaO+nm4jsmxiOwl3WndpSVUx6lppLZYwGD6SsCrAUU+FGid40LfuVTiFc9fJhM8PQExBn
-algXFguwj0t7CifcobMdThw8gHz082qxf13RSk8H5uydw0oO16zNegImMBoqhGk2mLxhJVcqLkNuELdZ
-93Je1i1IUeqWTdlqAY+FrDIRltYokp3GNIFTR/rOctaRRdezb8GsDAOLqAJeVuI7FAnBJ/Kde1GYX74D
-0k/VU2xWtXPmlgFRtm66V5u1fhG6tjuZU2KkLwXmllVJ2Wz01rspI3NLn3cRrnuM3cnrMXfvcZSHGoqs
-FdtR5/TL/RpCGYSnzANIUaqvB5TuFqToL/C2yTm7bF5OpPdDYhYIyMIpwUXBdYnM3vCbuZIDKNMpdkvb
-CavrAwrmlhifvinPUbaOX9aG4lfT9jKAWKlERe53nzfiwnvPy7/SEYuBOCH8f/JrKQ4XI/8gVhBN711y
-/o7JOIxLPcBEjbiGlzZHY65ysp7aaQTfNu8+o1lH2sTFb2R+39G3Rz2m1zrT4ignsr4kWOL+zW3il5TN
-tJsewaG75nN/jYj9HgYGL5bTeFzEK27trlOOvGXbpytLaE0kLZn/tsA2KEjK94GGoHwtTl4VjUCd9scK
-J+6aPC7MReZGw36LkmUmMmOK3ZIGpssNc5opv0p8QIxck4tL0xqhg2Ma/VJFY44RV6RfvtAQyrtsl6Uz
-jo0cJ4GllL7WcfKyAWOy26BvcqjgF9dFs3HUvcnMwHfUxDBNj+I0vLmuP8UugQZZNfAjNS7ZYUQGzuWV
-zbvHdoDPhmWNhvF+q5BL3FNmM9Sj/5KS9U0gT4Pz7N/7cQE6+kbADvwLxC1Ifxx7J9FRH5uV5ldy77lj
-unQPGfKHo1Gi0za/gEOZpYYCVRpwT1j424mXlQXAJKYeHZYebiSzStlyIG44dCI6dM/CDcxNA1VApwto
-XVxarNJ8hezqAHWXDsybZbPtCBvWp+vNhi67J2tAqR/e9ZW8Xxjbog9OWBDXu2lYps8y1zRdrTRr2LW1
-AIbevFWp2IIZJTqwMDyveEyz1BQ6B6SxZSyNFAbAdfwyJdPw1fgYm8SqYo8TvPsumEmBQsrNj5jL6MdO
-R9qt7IgYVu+NKMBNgFzG6uEhJxhCVk346IcXTEQXTEDU7FaI6Vs8Y1JZDRsjY0UcPhx96InaErWY2dK4
-A19PsPepMsgl9p7ZaBfPFT7FjSPlchjFtmZA3Nk8yOsMo3F5uZ7SK1jTU35wcWuEdsqNrJa+h+RQ6s02
-MwZWJmRj9FQHmuZ1Et0e1diU5i2oOsiGc1Mb9cet1vLfPRgHHZk0N8UWqHqjEFpiK3gN9MMYfSwlEfHK
-mb/q06J16EBG1AjHXAznaDfp8uWZwp/AGydX6bU88fjxCwNmV7nnYEvGh8CgO/OxV6zNRyYh5d836qaL
-i+H87iGXR8qSYkStFZgP090fL+AkIlqEYZvc7guls5snlkwEtqyRU7h0WhOzbJI+pntkJOYC1mDL82nA
-lmaJmZB7kYW+XimJoHVXhACSan5JY0U4xjHfzeDWfl95c0kqSLe4tCrPJmD4jEhR9t34p3GTMnZx/MhO
-zb/DChlTDrjiiG8BeA5SQXfeRKfoLisnGGaRmBn38IerijdgHcFO1eXcuBEj/TF/21oR8INmz5dyWh8D
-nNEymjxW4E4U2ccCBm+GTIx4C3fgk5q7tF8jw1ZjdlouYMEqyHkR9g53w+TIef1GqJZqNDuuAjTDWCM3
-KiHdHt0uOzPxx8yElpiRE8NV8jJwoK8LOTkVKOAprLOE0jLff40Qoa/4i3BaY+s030yqaMKemEh1hF4E
-6aUXchzMFVm0S0iAhLnKM1wN87cali/GHVSbrlAM+bdTJ++U3KvIscM5q2XZIVA8OheASJi4iZYvnrme
-Ywyk0h/6c0TzQpm/4A6byHpQhggMKkuc7P7abVMyTanNbISmd7SndO91opp/74O0cqei9XaNdTy4EQpU
-DLMLNju2gHdzx/ubuzGvoyWozJ+26hGAzaWYtmnVuNim4Anf9CxQEC1n5xrdcLpJ0m+Iglg4upzWmbHv
-w/gKPODaG5WEue4kOMT62yD954wRWhYbPxXOnaJlDJvYMwZwnaJdSMiHwlZ2Ebe4ELMnJwg4hjeVcJIF
-MXvV2z0aiFB1objYg5SZCahVEugrS0jffwWp+Rx5uSpM6udNgixdc/t/28npcZu3s7GnG1gu3tBxqOCO
-Vd3T4OLY33PmtLMqdYv9Ppn5Z5u5eCgf3vwvDSVl7k080MrdsqIwdXs/FPWOrp44675vQPuN0omIJfVC
-ZOXTzKUAD/Lrl+ocGPwe1Llo/YErG9uSFK4ZxwPXFv2Ac1eeEcrA+6tNpvbiojnyNS5CR2SSlXtxyujJ
-xQE+/IVXmSAaD5VdlbtSvH49fQ3yyG4mC0XOxZl7/KOocM1J1NuC64NqbY7iBoU7sX6hGca7m0lFwv7u
-8uIb1QEItS//wLlcd7FkJ/2Wo8qAu9kQu1f0SdBp2j5pMdA19YJ7vgNGQ4dKhIupMwFXCHlp39yIuBc3
-DvNnfmV+OAqMqtO7XspiGbvA314xrgFVarKkSdarsl+O5uyl8CLXCRH6h1G7v8GsypTLylOLuYCNeVbM
-L0XZgAj6wj8zr58967yrLCs0Jicd/yARKrIdGdk8NFLMR+xAVe6vIcVzpUFa+ZqLB0Zgyj85z4Hm+gxa
-tcWxEhyAEabYK513Mi+2Ojt/58StPAHZLUUvdKt/z4qKtSJtkGEd+tZJLMcd2XPgyE3QZVEfGKRVA5A9
-3KIPFV7hYpYx1oSZxl6f3TgfvdrRP/KdSrB/AeDsHY0rjPAWS11tneO+ONKsMes7MxfuRTk2Y+Yp5j47
-JM54uxQR/JDV+PIdRjBTkYZUMYptkJbpUZZtXUNZ8rLFe/z2x7sjCCATovgb40UM6tWLvs2T2Hl6WgDZ
-7F1naaZX7iLplipAc1gBLUKRR9GA+/4Gx6ehgluYDcSF2ozl0Ge9k/SBkGV+sc6eo1pTIw1WnKBTBp70
-xY0NnXn6v5im4h+jMINTSx/8Vs6DS5B8/FbWewQ3HK4CndWHY1EfuMPooXLEMZTIa5GNeJHnc4l6LTOC
-l4V7LGuMVNFYMg9NTXXemqRmM75PU3BJ26pprSC/Y8bBCIiuTk3muTPX+LTchmZNbo2fHrUnTdbTyHF0
-lF3rAbWVG/BmQigTJvGsfQRFiIsIp5AHXxXkay8vL9W3b35ZXEXe+/iRm0NrcT6Y9L0x40xSw0QyWKSe
-gUStUxIDFVqNfRUgtLV4BVeRjc5GCC1DB+dHrc3+50bN0/8IFltrjSoH8SQJ33opsRrWrVoTI1LkQ2jB
-q7clzi/cGEHT/5m9tXWYNOOaF3zqE8IEk5Xy/I5hTSO6EMxI8hgkT8t4kvTxRavF8dKYjOXjOoHbsdbU
-otGXQvYgCgrVZXJ6CpOrHUVvHWTISc470PWlUSJf5lXekKbZIZWRsothr1YRpyY1oewVPba7ujLXI5kL
-rcuDoc35Q1Lu0uvxum67jlfWssJUAM4xadMwF713m3rRdqFSGFCOoMFxq2rjDuEAuFCZNCcIrMHKl61m
-NnbOhVM8odLZWyaW29cQRoHZ8e3RUDUtopmxJ8NyJoq/6y9bkslIDjKvXgA7ZJT3TC9RXJPJrOjAJpiz
-N6dloraZuVhKK6i7eLCS4iIcgYvjOjOTDvWs2JieqRXPLJF1xE0f90wYNXru+EOr/PHX5DRVmk1kgCm5
-/33T503xuc+tmSemadm0qVLF56F0ehDegZb2D/7xrA4EXkolw59BTVzD3b8K7ZM8pJjLy4+EtiGHS5Fu
-5jfa9C1z1NOcBuzDmty2l2fhjl5bu8dEwp0kh6qZsDLJ8yCcKkxLyR4QgbpPvmTCT0yXuz3aesLAgoRh
-8dr500g8ry5hmdT6KV6saW68g4AHWBCXsUaLQEAAZE14Y/SdJWnDOAlS3pzIlWnziuScozQsqOJbgm63
-IJj7oNudPnY/TqFB3J7rnlsqVXZJPn8HLVblGZA0+xMC7nuaIXnHgMuyMBeWQZa05UF8GMZmkPZAiOOW
-H9cVwn/1egK3Qi3kxfM3gEF/f/2jqkUB72n9JAohlrNuPfv5qBZ9PERA/yVSMHlckX6fe8iGUXCuPsKA
-a1o4phJxuC04PAItCaMa1aslzOs8Oo5sN9Dnfq8ket/oY4HS+4zKe6v5blRaM7v1dmVc+Xn7HjQdOFot
-vCE7kGMPuok8PEdb4Xi0mH0VbXimXCQyx67++GY3G5Lt8eMuhSdDzeMA1Z5zbvkX8H7CaIPDFXgpZMSK
-0pw/iOxBNWLDuDDwd0CFFFbztUs2ekoee/binRU5fTH5itWttlrSKkyFXs4miU6LR656FIX0sJBvp73y
-CrowTmTIsCvTFQbyue93JAVKGQJv/od/tBJxH21qY1TgvKLKEMbihjQaD8vWUI52DU8Jm3xkpcAd6pOn
-1yfF/acrojOMKlO2VWTH4TAnpl8bIWKH97lmMlmFcud0K/+S5dWmy+xLCdSmtvHAg2bIMyEn+PX9Wk2f
-31D88dRYrV+h6Lkbtx+DvpaioeP+q6TBryhsD4GBy3/uV4+7kiLTNe1qSw72Tw06l9kW0MonH9lJ3k3b
-w9y+ylR8KGPVpmwNKfI+Z4XF7YsSNd0Sj/aTDoWbDRm6nrfCpoxlmwbHwnWOjBJgJJ08Pt1wGKdDEcK4
-dEVmfk5sxDclSD2BZ+nZHTMRGUrwzpY2+k+J1qHP7FIo77txk3M1nofUUNali30WIw5fsZ+xUd8/P+hs
-tnIRg3Aem3SkQcWHfm2cRDY/NkUXnP3e38GpTcaz89rHOjvhgoU9ggyXOCQNgDoSsm3lkEcpYDsUEjSW
-KZzogq5VRVJNGjxyZ48Jq6vFogfE4GrMzlGsN2QBGHk4WTQbbwHbh1YKlqbFj4NhqFlV9D1P2NfMOiSe
-qxmNbLGOhbp03VEoeNVVMPTRqiFYoFscIg0PRuWEAUeFJO5HobuNyQmFKhTyKj6ZphVUk4cpE1RwRwHN
-WgGVoCfvxvCDNZC/VASAGvryJaAihdbCx4nsIOXP+zYBJq0JfQfCdmzJIO/5e36Qz+bQgEF+u4TZwjBS
-RD/inZ5Un4ENHIzByJlfhq4Ps2HohiDs2fHs2BAHYZZxoy7G8UNw7R/5s7R197YVBJ76m62zURERbugV
-Xd4ricGawc2za+Nandp0M2cQgxcW3oz9Hv/Qwuu08q0KaGTJGa0n+W8iKiEeW6GWJcPWgoM12jOXFSId
-zPfbjtqi7pgu8yM2Mntw066OhnSiRociX+3bzimcBJMjg00woGDZoowdmWEZynGsMJJi0GCOU0L3CBcp
-6tnGeEzZ1UALAob6OVPZV/8t1tLWYDTbF6jCG5bjB7HKUfJY5/qjC5LBqNTptS1ItyQ++pmmgE5TfCJM
-f7OzexHLPB/xRjlWwNp5cGaK+qbN6/6SopK1RVaJKSwoB0qvYHbr/aJZHApqUDWHrjr27MQffjy4cyAd
-dCgne0wj9+rpoKDBD8GfcGB13adabL2ALjy00iqQSQvBPa6acuhO10SaxjygE5n5wj+GG2pCupBgqRjX
-DjjUIoEeueWhm7XJgrJu/rvk/GGhXPlPousOvfEQxzQ3s4nRNKm93HIHe0aFYsW03CouhUZ58w7PuAwl
-hVf8dWLGN2RPjpIbZ8Y3+LMEpwEtu4Qs+54PPIaiSGH0DtdcPBLgUe9kz0tmr/NCyU2TqCoenGxXSSbu
-SrHdQ8XproUvyheEorougbLDsRaPUqUkIc7xicvex+8GrlOgcERaNCnOXudweBYdnZ1E7cHJiSnUbB2z
-4elo9M7a+caCUoZIdGczhM5SP/pRvob6+/NjPF2hSjYEvFT2tXYwj1hPwi+1ho9oZhrm5K5LBb8Xkcea
-EkijlIQnfniXjMgDnwbxxghNqK+c2iuH8Ny59aphIzyvfCUuYVz58kiiYEz5asdEowRA58EfcZh1PAiL
-GLuIobSksbh6AJEaopH78y4SmXa4oWe8M+BJ2ORHDZIbMn7TTzO/rxpR8wivFVZWnS6Pr63d+JVbKsVR
-V9d+xo3SV9bX6VLm3M30FW1lELeyJQZqEgR6pAVyGfC3ZpOjgW6fvEcbwEkQceOWb60w6A3V/dNE2/eX
-86v5/beNe4f05zQbamUvwoeurnuhkVwQbssFHoYoUPO86MbfGCOwAj1LHOgNtV7BkusL/0Y8ptDnvISd
-MBlL16vifpyukDnaj2lry/3yXySaSMzxXD+7Qca5YW5aTXgcp09eB27sySCxRDF5IlcvgJlIt/qTmvTq
-vBqQmrSJ8nvKQAbj9/RZ+XTQmyTHfiizimKRfWJl6dek8sFHORX33iUFWvT5FzRu4mTSMGMbaXQPMQzi
-lzGKN/LM/c57RvKcGAZ4FDnBfIcOTOhb0U2U/bKizxF2Y8kgGXI/u2ocrBog4lp3pu8cdrSjk7tu0Sb2
-YvRiqcZxfwasE1+Q4+gwrHjzMNowpsNtbzturW+0Yo97trFmgjbH5MVtTz5NM5o2iYLcOo0ChczQK125
-yz2dVeiJ5EhVOyJ+SAsLYynRgURH/elVLztuvgzYoU0vKJldpV6Ca9uxdyhJY+XgyCEHWJbEoZM0uwc+
-8hjxldVhlZ/DEB9f1oAsznrG8WMIM+bwiQnmuNHPYZgbHyO8ivRKnAxMzb9F0VPevfmPy44no1hQiTNZ
-XMAvVAk9rZ2z4mjWiL74qSentHYXiw0bohexhatCGHwW4K++S2wHSy8r8DPfyctw6pFC3dfFfVsxP0jq
-0YtYy5IciAimIG61a/QBTnrEpUMqWRrgCYCLGxDgAI2UoDyHgqrV8Gd3MB1GHS3cfMm+7/ltpi8Bxl7Y
-y9qCyp1+VAuMEjgRFQsGeHe5tUqfzkkUlK2GZBtxoII2D0zrBieSsVd/d3xcoFW96rgYh9u9ftPOLVNu
-pSyPNry086P3IvbErh+kurdRtXh+GK4dJE5NeUZxp+8uaS4sDSNX9elApmUkTTnNsDOyRRyK/qqPw1T2
-ZBwFk0+aUgobBJxoCdMXgIrIqAtlTtwnF3gn9kENHk/uGvAhZpKNNThtACITSpxyToUgpcoLRfi6sP5G
-pv6KoG3Vv8JKJ9b7GAyLQeG584T22uuRmSkh+BN2jv5zh9r5W5LytHcsn8Z5ZDk6dGRp0A8fu3CfH2yx
-1O6/dqhyPbtqCSB9zCfBpGzx7/u/NE387vED7j67Ex5aTgNn4FdlAIFk6XFePspZ7u1CATt7dHOHin9j
-PWu4ASBHH2M9xJ7D8lcM2UPjinrFwnhXgZTbKubaTZp5/VY6ASio2iRpkqU4MD5PWtik/vvH/c70OW03
-+cdwN87hg71LgqvQtoTHQx/BW9LL2tCQVvvhMuo4On8Bm771SJ/92Fk7CxQth0PviJcttuS8Bm1BRKBi
-NsSv6ruFU5BeSoAOl4/8Vwu2zfI3SarlP7cVAjW2yee3QXXA0QjD9jXqNygX3sul05HBd4s7eyHPNMKY
-DsrZnhSvzYxVkCCsZnWlnGOersf0Ocvt27x6z3qgxDFNyA4nR89cQG4135C97HttfXrgKBT+BumusjuS
-s5vXepr552AIfPog0FoV0rxMn6GFEzMTGof/xcdYrZGDGgi+pBLZTU85Tq37Kiy9ocmkF4ywiK8PIHfR
-e8TlG62HIbyhp9S7P7XjOgwCoajfr6HcjgUueDvUnfoT3ZkpKIeDBpX6RDgLEtV6IlvChAudT0rpE8n0
-bL2EeeH73qMMiV5ouEGVmf5JroHlPMZoDtmF6A6mQfeJ3APvcXejs9qiSK6tu6VpIcIKp54ei7eQziig
-K7uZqWP3vjOgPW4IJ8auWzzbUB9hWmbM2xTetS0c8hewzRMIzw3LxQKtAQ1EXHexehL/bfybq77o/QXM
-SQsvvo0sdg+uxvBtmeLpKzw6l+WhG03WI7j7ZIR7gDx2gi92IAH46864C4iU9HrMuumUN88yiee89J2T
-xVY+tCKzdD0fVlPc5c17c2e1+wOVfUKRsu7nckrot5+GpsvSNgKiLKrsZgoX9XT7B6vgXVhoIPzMVopb
-GaTXqofVycnkL3P4xljQ+hpYFrO/mRqk+8gIG0Zl+KIf3qAXK/Vk/c1G6S5XRkl8M48Mxl0c9TgVjH/L
-0tNO9MveWcNbrN9LQknx+jjQIQ8zLxgfapYl3f8FE7P7iR6Cgrw9ntf8atmqxVeh6XYdC5Hl7nvzSbTf
-79Chy8mKRx6WZt03UePJ2Ad0JQN2n9IGutHRG6Tp01l0x6o1uXMMnj6GUIvL+XmFT/0QvGtsxLL7Aont
-8QaRzW7atRj3uBA691o/Px2VfXpUEwbQILxnF9NQqm5gABG20cugo4uvbKN1nmAHzmBlJr3o2oYzfN8g
-WJF4MH242X7ut88ajqOrU017JOyIj3uYIqXbduANVuHcbDAXSx8V3QNnQvwdeHQIHGeY4dLHadZncwQn
-koV4RReDTgIXDitOHIB6vqkJ6wemlpimwWyqFej50LdONjm84Hkbb31uGhZONbwrZ9RSUdxV0wadOaXD
-awFH2TLxPIi6WrLAHb/qKBSVnJD+AD4fb+Bgfvpq/5ryFB1/FPik45bC82BzKnUkQRX3nfXnjIHgtJEY
-R+/MR0HThu1o7JMiXCzPclKd2OPSEslsxQ+iL/e05Mq5/gSmHIfOwXBesZX0dZzpLkXcxvs07QpmL5uB
-W+ZH75ioxqi+tmqeMh0rt5QFUQQvp78HLGnjHIpyKclv3WDqeQkyChFqat/Uea6WTaAdqgTGn49pMgyD
-5km3QkOiI2beGFH1lcpTNf6t+GziEwWcyKvwXaKM8A5IQsFIBHwQ6PJJdAJtL6mjKu0v8csh0qXUpEBI
-e+hCGwjz7SoDgCEqN5GWvxviCV1Nydr5Vr0Pv8503w8s1m+d9VNUVXOK6KS7CRJdOOY26dFTjSWZWnCI
-9itrKPY7E7mYTcXvhRQ/3kOhc3Dm/vQzy5Sm/sm3YcqMIh8H9apm+fKmKYHLVkt2Bm/qRZetKQFmUPiQ
-Gdu+l5Vo6TTw6GswkBhxUXySYUgC38uWyQLtTYCaj7M49Bk9z8C5AFy56fxBu43xkiknph7ukuyMlqKq
-KBeRFXP3ym5E3Iysnb65GNUJd25eMSrJ2D3X4j7t12gIdl8Q+kY8bCBBE21ffZh5uxhtocJGSobma5p7
-Dicxq4fvjI7gedHHDtmqsLQUoTDRNGfl2ZysYY2saHM1Zj1uaNsuGUwmmo6fia41pkoNHStngn1vY//d
-YoJa6UT3hDxl6upCKoDoKw6zfTP614DGHuk0CDqT/8jgAR2q4mY1jnqQlkGlE9qSycd4/pKea3mV/TcD
-782FGGYL+qJFK+0I/qOHfwnizYYY+3cbcvPGevitKOERhi0N1QH/9WhcvEZ2/fNoBmrplOzsrdfqemmZ
-p0CzpegKRzSJt9U6Q8rTnmhM/VPBFydjztPzGXSTqirP5nJ5d8+eqciOqtLACgkgeP6lS6mNEJuu68+K
-B2y5I+HxoXEFNW3i6uJiay0v6TGKUjfJA/tGHMmwPNyTLnP0wUvPwqUA/NN4sOTzOMRyAOe/bI8jMOYK
-NgbjTKtj7+/BJEt0HkeFsmyO1ZsIlmnbmLWKPIhhw8m37BXIhEFrjlWXiq3u3nvygItFg5bNeUgE8mZA
-xHoZ7JOiu17AcWufe2bR0zI4ba3b57qOz25aDpt4LyzKCay7Do6V6Xn/koyvTmp37H1Q8DKbj9rd8+RM
-Rm3HkdHXie3HutZf6x7S+GWXy3U9rivk6XmQmQyhxX3yQ78Racz+Urez7mWMdaFGRGwXoqrzgq4usSeV
-gSLVS9TkT0mvhglunLuVLN1pfs019CiIccw+egnTf9QNGcgtawTyCR5OmBnvv45nKjdFtjtqcmWbB8Vd
-dKZmWn6p38JO0k5i2aWbJSTQEVU8kIull7a4pJ5VRSmXmSoufRlXrXhlnGi3YOGXHGnwEbTJPgZ6MLoT
-K/c4XHuA0u7qoM915FPn8nAMv44rfLYBqt9oH4+/zA/THycI4j7bJIV+Ya+j3gjX8C3Pr7fvRdLT5VQ+
-Bh5xgi/QuqdYnHNA7ItdzfOt378fZdQfPxvaU9Xb6oK9jNjM0B9RWXFNK8+M/7PZqNQfYAvbb2b/6pUp
-3HQ7RFd7U+XDj6M1nJQ9CnFtYADPldvjmREYXyyGuy2dFZASVr7j81zS2f2GjM9R/QL6P/9HMNLTAzK4
-HfuFMHy/7mm8Y+INjKbPXvZhQRb5df2mG67s/0oqhwcJiiqGBmRfAU/8f2Pcn3Oi/PxYvnrOv/crUeD4
-kdFuYIGiBCC8XDOtFXnMFOXRvq546PsMQhaORMvi+eSppa29+I4OF1dCo/d25sR/sYlGJEAM9rSxCrLA
-+O/IzaqYR4VHyGltUFGqCMO0i0EiC1FEbXtRDgXzvrA/Z79nOc58TrvxvytSQIVEyP4uswerh8UKI6Gw
-ZSCdt6vz5yfbKSUNt0DnWkD96bZOoUBoZl2IqER3K3DkFILpyVrfQL1MLnuXWUI5UpBXgmOMVyywkLWN
-t2KgHkALD6FmYvJICzq3BeM+4r8kOwtqJj8pXzrnU2zUKmdSqgZ53kTDkF3j8/FKHu1Db5u/olxPdIW3
-yknoQTETeU6QUwDP9Ue0oaXsVgMCqFifcNHV89t6VniUPKJb3Nu3SJ9vCBzbOzse563dCLJRfXHCDjmo
-B6eUa5SDzpEc+Ia/Iy17k0hRVCDcHCAeSS6W6bEyhMfN+C0rsJsN4zYiRs9mViro5rf5/R6Nivzz6954
-3QW1WQUO4LZFq/5mfYE6r22KnFPcGmsXIJzgWl484ERRXagn2K6CLVkvcc2wqbL524YjD6hZfBKqPPSn
-s+LJ9dROmTUANywE63WicsAvtwt7SSOasvtY8sA24fTKLMbwJg3zPhXwky6SejQhnekdUYU8H2wKCUj/
-yx4B1z5ST+dhaBiXDXREEmzB7WV43xh5PYv/zSGfPcuEgNLYzZRDAjnWNhw7cFNJfQAmFPfV0EjWP5kY
-Bqt2T6ZU6VjFl4zuoI0qWI13xODO8jqtujBYqTuvEpYwqHi1Xe9yTyB9k9MqySUtFXy71R7NcsXioecw
-yXCsTCp4qXNTTYOSKbp8F8vMGXTPKpl1vNb+Cbq80Pj1cx7xEidk0OSRgiUjZ0/6o3Ced+DbsAWC9Rfn
-/8BPHkbO/4x92Bf8ak9Qgt4qxrjBCQlk1F90oShkwUhkqKRidkJ2fnkfMtbdmUrK385cJcIHiaNpgJsZ
-87gGr5kiXZg3dAxnxORHMQgjie0B4ifgtGXaNmLgJ2qU026toQ5g00bYXzc6CfKbQfu/tVKI8AITHMRt
-cjuhI9YSiLovx2sfzllxF/Awc/loEJr0x26jR6U5ECkeeTPnkNX+hdSWPuuJ042WBioxBa81R6I2QMAO
-m2uH5H35M2KNFAlat5RSJKwLeQeAsxMZrlBccIs/LTPMGreBAvQydIpL55nnKP5hoXpRgl9Y2mVqgZ99
-A5F4wRw/AtGdEDmZiE9lnJumJQOXZzV7l4RtxxIfs2Cewp5EOn9CoXqBK3Yyuz6TxsYzChHVdoOsi/UW
-c1NP0saWr1q+/WJdkdfrApurr2HSDuqu6TVRiBc+0kKh8GIouIt1d68N1BCrlsbMQ4qf0Bw3C144pYX/
-I2iu4WlxnJzl+ISSv52k/u6SbBGT2+AoGJMXk0NWT4GsjrBD80xa/xLqaluGhLqqGncYfnJIYSXvI9SE
-UzslRTyflhDxBpR8YiaTPqw1LS4lus4gRMH6qXY0udcwKLWDValgbdVmMIGeYPmr2GV6MuPc9uRdQ+ge
-SeQNsThzItNHTBijNQ1dfEwnb/qaYV5UgewLlqZuiirunMHkS/nolFbZcEqNzWQjDftW859tp8lzp0lC
-pfwxY2XG8nWLCZfzUlnDJocH3rTJidwTxnZWQQe+FE23+dbCyp43Z0a5oLXk1dwqysMWRIMx5Eb6RK0U
-AP6ckWiCLjKV/691tvEeKpM7532xUnxuDySzjZK/9y+XUHOJ4WgFiyuBnoIFl8R6Etkf+0ex+OB0UqfF
-sR9Xof0mmZWNmWVKKVlvotwCeTsA2pbbkPed4cDL2JXHkGqsdd3Drq/zqydl1XL8pH89S5jVlQuL07JW
-3AgniFaNrFs6pmODle974ylpvdvB5AUzWX5i9qIPm+XYzN0WL2uDNX4dn+u3U953s2FNh2GS0+jJ6fYF
-jjqvelxLvXs9HF9W5QfkBibH4U/t8PRJG2naAwHdPH/66Lrpul0ffnd/l4Wd5ZkUk1sYGJA7lJav5RUT
-h4ThFlOFhKePlRUbhISG4xObGJiQUxkUu4/mhZSWlpeX4hoQl5OHhKU9Nm4jDnzN10KucC71eu/bf3Bk
-9wFusb/Q+YY9XtB5iQjr+K2QlPOF4cSztAi3YwnGWFdJbEcph+QQy2jL/2bJAxrF6efE2/mv5yGdMrpz
-ReH6+MnHDtKo5sHRfPWgC3stayHPckFl+lhawZmTjX+tOrtkVNVpPwXPt4lqxXsMXdq9b7BVj0y9cPAO
-2d6FeGb07IyAUA8GNsaib8FC9ocxIHmHdaeisA3Gvwh80GWHzyPBFAl25MmY0UxJVigIlIvRnkq8UVs6
-SMmM0h/GbyRTSEiNUTofqmGV5b8JTZXaLpI4l4pr/KW2yGmyxwzKCWoZiLppqj5l5KmuOXX7lLvGiYxj
-2MLC299ITRuVygBvsHmaEhAK7wxNJEmJw3LaVWyFaaiFor6Byh4g6mxgkKw7qgpsMjmsSl/s/B54Mz93
-mZteAe+wYrvOWUENcbCiODXKyr8fwmqN77iobuUAzFi3awMO0rbqU+7HdSY6Hsm+63Ke2D7rv8dsX718
-sH8Dw+z1ScjBYfep30AOyWMfykEry0Nqi4T9DqcTZp7N8glDCUv5v+/3y5DeSO84EIgU/+QoEIoj0D0W
-EAkmcMB/PcGQpnTM/qhbNX5NlPZaxwo7P4IIEhp2KGNArYfL8Dmjix7YUuPKfH95kY44zz7HQW+xBK07
-aTw55eMJPemo7ijtslbAQaKhA2yspLfAl8CV21bL1l5I4ddKU8Dmlg16hPL1yL03Rpm8xOdrH45z7oWP
-bpnCMOSZ4aMtk3chr8I32T5B6UBAT+yt6Nl8QE/cLeLiMaQP/T6B/peu8Ufd28WOj9FH2NvFbM74y8jZ
-zazWMJ+8z9OJrtHHac7Hoi5v8q3pmSn9l8ownzJBz4upHd/LCarWIQ10FOLC8VmO0QfUxpfgMJ+c9uM2
-05fY50UuyT4BldeNzjBf/wff6Ivhzs010xfY/nfSbYzy82xepE/+h6gPxb4s4jb3myyWT3G3J2Pcrezi
-Mf2XfFapkrEFKIehc9URv3LFCJWC7M2rlpdfTk1izG0bjH3NgXyhO5U8hMfJNYRjj4pk2zeOXMQi5CS9
-kp99tHxp8Rlmh0Llm2WRzJxVwsjyvQJfdPbJsa7nbVLNzED9wrE8PaObAevbsqTWN3kEX6pw3CF5Jr7o
-KYpmSC4A/I2MV9FOdeCDiu4erUA1Vc03zj2J7mZ5rIP6UnK0Q1pUdV2X9UGFVJlsQfIy1dzgNp5sG/20
-NHmwiq+euEufCKayiSZsjBnIkI8FI7UzVOArgj4bb1/VB2cPsCraNPuZHHHc+QeUSfZWcS/dSlKlS4Xa
-s9gCoqPYYspR1bMGLA8uZ/rMQid5daJEdqJNPfXG3XhulUk748ZCf1zaZLR9PfLKhUTUbkJ44Xlnpvtu
-AX7dLDqLZIM+FWOXcWiSUjOaHb7WMWxV+5sLAl5Wmp9o+Ooom3Ff019wtLOpUm2Hud+5fyN0Bmaf0nHJ
-gGxtU9HrKOV2jfzQOwJEv/4y9yBzRq1Zo55Vl0J6sHSEeAy9IOmvST52vZaN8Sh7MbfGPttw0z32Zi+j
-kTrXGQ5+ih2M7YQAjB0ZtWkqNaFxX2O0AFfIYc7fY8WTk9uZ2ee7fZabHjngC1lO+FsO/Q+PG5V0vP1r
-6xPLf0QzXn0e8LHj+uZ3QdKHsz1v+CwPuatQdwAk0ZpcRcKtv0udweRLW+ldrU8T759x+UsaN9Aevo+f
-8TwlvaaRYhp114l7HEaA8cgDQTRI/G/tCHoTXLfkhCSyPSmLrpinYCnnqQWTJCUs0ZNrBpy6nFd4SpXW
-jNut+jzNLrZ4K/lwaR6tqRfv6cZMUiKVYJrLeMZSw+B82l1unIilOLFuo0/U6JRURxnmpcpAlaemHX1D
-i2GmNIuqkRU1zUlM8P6x/NfsAlZ9p+NJj61/MQclNG1xoRRCRwZl5tRXKUmkIiYoyXRGuCi37xwjDswp
-xf7m07eXLNwT7sFT5KzzaPCwF0fDxZMYONS3Eiove8rUkfsWweXY2jPlk0cMDZwaGXII7f3T9XvGyDoM
-THlGU4mXJ9VVhRnBphSVVUcbrbE07WUqdOrT4CRiamYC/ZZmR7D1yEvqPLgjgZSra0iOS1U8m+prVzGu
-TYLHEhbj+X8DSdv2KsxZAGzYGHUlk0Vl2szzmq/hWRLLT9tluchMMjaq3cq2PJOaBHRPuR3l3HtnAqkL
-Yi1eo69P3Z/gADDHJ4kMnzuIZhmUCDudR4X38tb5umhQbWcVOkCjV9cpltD7cyaHQ3PGhDdz3ZCN1Ipz
-3uCxC19dz5ds3bzB5R9YDur4Z4+/LU+9aB6q8OpncKeN9FQ9m9MlrLpLm9KT+58Ytav5ERZO4VRcvddg
-PYLVTdmcnQU9nGi4JvEAXFaZdP8OzZCvSA+Eiu9q2gzkymen4mzOBFdCnZi667EAewA+JvSM1t1U/Mh1
-xQl1oYL8lcsWMptDy/I4lsieHTh2XO71nwcykkGUvsuvCixUa+rlxxmNwxsBnjtrF7vuhcg7fxLitfEF
-6alT92EdlfcYCm3L90q50EHnQbUO9tWQek4TaAyLDtuOGR+tZf136OVzddv2ivMWFr599OK4EJgrx6fj
-rfcP2etbvpLjV5lLj9RsgGpG0QOHTHMstTjeLaXHqhJxWSIXL4CybeVegjMvnsXZI6ZV1gaAQae7wCNF
-dQzX0T5d00ALe0m8qbW/eQ7uXaQ/iE4d17Ss/NV0PMFZsrpYnbEx7QdwCx7YNHSzTTQpW84kME+5NzRd
-RdhnNZVBrDPoSydXxmNOYi1Ob0h603gbMWbRrc+ZaZONwqQ8u3Zozo4aUvpaQqdDVZ8LXqxUjufh3Yqu
-XrfnTG6Q8L1wjNZgOGpYjZ0x4js+ybUHVCho1dJIRte8GZnclyQthx5WXoWNHlaYlFxO4zRzdasU24fb
-ibU0yQg0JUpYefXtX20aV1rndGlfp8+Xcx53yABIvMKKzLl05mNrr57WNm+CSe6aq2vcEOq0z/9ubkmu
-u7d4tHjn13q4O625ezQ1cfRnrTTzGJG/sh0iLIikPVaVZRYpVNfRr+pm2wvj27X8tF5XMeNC3B1ZhgZh
-r2ovq1suZBxGuke3RX/PeX6qq5Ve8ZdB15N4ldd4rUNclQkK5Yz6/fX0/S5+O+36U9n+97mn/HHVJwXc
-wPGdG8nfTirY1VGrbI5LxUBnZYFm6qrLaMPT8jPHt6+v6qt92Dy1nIlmrmbKibUH2eX3xP7jUGvTrvGj
-A0QHFLMnF8erVbOROAdZjnMKR8c0e2f/G5dsdcNY6Hys86vsKRpwcU3mJ3ldh6YWxyLuktBVgpLJKfX5
-W0dsNcrqQb5L5joTWe/3Uy50o3XPtWPd9YmROfUat8OprS0Lss/KqCxdsSUxAiTKbTIZFeKOdUcPvkvl
-T6d7J/1ZSrUBRE+ViDYPOixzzSxJ48KU9i6QZsstVnJkzZHsmQaqgTUNF136Nd4yNWzWp3VtRwUe4zEh
-xIaS4+atVivX0IGVLGItiizlGVSLqrXGzKJNrHtJkUUeErHmfzbrT/p4ru+JFZqfa97tLcuCz1LTSztP
-DSFv6mL35rvxy6nl8xlM23/fMDO0i++UCozeWz1FXm95bZ+Hjn/PG0edSb3u8kwASNy+2w93OzZziloQ
-Y967Ji4tTHbX46Y1FwtfL3a7T26fRGIeM3SbP46uvqxbuOoVLs8zNxR732UFlNRTLsCbZDdUjK25B+8i
-DQvwnxLzV6GXMtN1XN9XH7uP9VoWJTNou6cc6WU/63I8LrPzr7yvDi+9rpy31U0UsowhbrQAFm3n2nhE
-Nh5SFK8STAENc9gAQ+Oc2ZkFa4qDSx3TDoDLjHYXDI7m9qYiB6dXVilv4ZWA95x/Ie9+i97jex0j32FO
-aYsa255Ho+z9ezHRnURMzrUzo6DpWt0Gn1GNx8RxK3Nlh5FG8jCUT9gTl/E2oVl3cUUN1hDdiFCuN/V1
-47CUc2idiTj9S5Z27/mfa0JaOvUCP/eqE02w5G2gjg2ubsfkIQnOPs+pJlrlGA6NMe6+QzDOlQ68f1eM
-PPHbpoVm3KUH3OjiXJHI8tk3OMOSIu6DDSkvnb2c78N1A5ya5pCVA5RXmtogyb7sHv6L0D1DniPUOoLV
-0JL2fd2mqm+VRKfzh5+Dj5bZTfHwQ+N/qstwYZNVEKLebQPxX4FOt48BPC41LaIIU8lXzMvImkRIF1x3
-MvVh0UPdT3zxNRPX9FyVz641ndEa8xBbOgR2uDoNGMD7s6Z0ht5fdv2Jb0mIs9PIM7LY3sZd6xrd2703
-QtnSYGc4dvCDjpwfxR1VQVGg8T1L/XsbcT0Uykv/4HEQB4MpkoRDLiQ7SjsPQ2FdlUMuGTHQuJ8K83LP
-8//d2cV5U+qwV5Ja7p/nD1V5iAXXNuLa1hj30fUUXUUE7sNEvZ/ifwNvq991ZbRmnsdRSnWzKnqlkxfh
-B0oqemWcrNyT37fWAoZPKzuhHQsY60JJEtBWGiSKyZcPN07sa2pC0sQv9rq1dyyfh/JMR2aiaRlXJY3y
-/0QIHIzTzOHohowlkg8Ix53Z4Q3DviFEm2AJeq29ioQcSsp2aykKuWUmDdkj00fPf7yd3jReu23NAr5n
-DF/p/f148RDI27e5oCH2cym7eedmdE8UHZNq+j0F+b/PEA1OtCP9p18rvIHUIrtl8lpkTCMPEaLfGNE8
-86E/mRaFsehVI2rB2M27U7cGFfelp7B/m0gGSOeiFMH2phzpY9VEZSOX0cj6mObX36QNUk3MdRrZeLL5
-ayb+W2BuwLKp/a2PJjPFFL3CFMHepjOkh+f34tAmscfEGtSEDeX/hu3Qq9CwgZn+qDBnkDyQR3jkgUfx
-ZoIv6nikemLc6rfrzBiioGj5TobcB65ioq/T7xbhlqlOL01krlMrCJIzz7sBJqNYC5LEpjEeCxGomnoD
-vGiBPa+XJrOvbsXhtgMlffdioTxHB9W5coJUnUa+/ACdjwPBJS6pXQjbehJMnhKkXiltX0kUODLpCYVD
-2xvASo4TZRa6jOGkgP1cwEBkX41ECYsySFMz6SRRQMtIuDL/p+Wicj5AZ9df5gZ86b72QLNtsCoFaDcf
-JeWB3L6FTD8PnOhvR7fuyxZ3l9/3AQ0tsl8vhxdeNYL93ijrv4cq9jsEgU3/uIztmr2N2vc+HwtJPbpA
-Hu1ss8HvP0ELgzST9UPDAxoQDFEqUkF48Su/Aq3Vx7Id2sPui0HsImzs1nAeyLt29S7wmu8WiYxS+wBi
-2MSKmNGx6p1A3xKSlnraZIrXcC4Mr/t7Y7yr0L1QXI4vU0yT2wmvMGDKfyjYDZ5sZtwhMIsruJmS8xBF
-Q/X1xnSXUPKZRgiUODamRqXxoMgs74iZ49ibjKsH1M3n634pK+cB6bPCORGdCUqNiLfatVnjEEjgjaIm
-PgIpcj2S3DX8YbwQDFp+2LL+cAoiuOUGzLaEHKus0MQ5hmqwH+ZSKRIAfhUPBl3Le1ZWxwaOo0ufFJCk
-0IzSsw8UlmKlmvhkhf1N+ucyvB7cSxE8yy1VHfb749djPA/8fCJ5FmRzH+Fq1+CGWUKloZJkf2riZeW4
-W/p1udrgMvRQKhFrsAjUmeXBCFmUJOpUAjvMry1p/AT0vi0ILQgqygoI5XgYe0ZVSfLaHiiR465bTL9c
-n2rZ4IBqsKGgsRT7viG4L+h2iHE/DwUFur1CaWfQ05e1Fxkz/SydJCiD3J0gpQZqI81Ot/lwC16yzagZ
-6jhXeNSDRgpz1/0WhZwqIrGqCJVkkQ3llI3faNLNOlMY+AJBU8BMkmVMsWO+S3/IRel03LcaKNz1DDL6
-XKTSCtvuUzGTY+oQV13AEcuyRkT5z8cda/XXy5A3qkvFx4BoG1L7fs/kbHQ50udGp1PjbRfrvGiCDwSO
-IhghxWhBDTcmodL2r51wqhLikQyspm+NAjL3456saB3DrMvBZcd5yZ5wg37nhsLe5zmt2nRrBI8hN2Vu
-TE4x5qaKOYIkkI0SmiWPLEzREJESF+h0/yETIXMPX6EpBq8Qfw8FFtMClnUyYE1oGQdoYLaIntHXe0Ya
-dUVPSWdV7VRxVN7Dtoq0GyauW/tbe5YklfCMzzdr8Wf+zUDE+hchKb2rlY86rgH8O+6YNGwO95GbJEQ4
-0dpUnpJKDfhc0kKm1FjgsLAVaQYyUhIikLQYRUCcs4CXyJwUTiGd6ihFtpVETMhq1kUWJCW+wlrHstJ6
-iMjZIVTvFL8Vts56l6kIiW3sz5QfDvgaWpUIliPWput6reFpyKgR7rT+A6+47c77sohc/EOIcxMul90s
-7Xk/bZ4jDnmzxwprjGoG5MrHnEFD0na5E5iCs8PdcxLhtmY1jEg+Vn0taDoppk1LUa14LJs6lOaicwek
-5XBRd9XzHf6ax6mmO33NVVdzxWRg+WSuYFGRV3kH02iqkCe4KWHSknlktPcSzSjdeeuFVFeF6BzhVlnS
-eZTsW9aWG8MduakfT0xUSElCS5Z8roehFyN296lzQDXvRaS918l5S9fY6o1Dfp3BApbU1q5vLlcA4O18
-K/fco8/Wfg475tGu0OP416fOv0dnW7u5nosniJpH6gscuNNBwFaG74nRqGaQ0v8HfgOB/C/AvlMPspyc
-4bLzqtfvTd75+rQ3GRDNUxDt6IvOaNI7uex3RvricnQxxMGxM+iC7KA3OB2BS3AeDCYepDoZXrwb9V6f
-TXxsmmDS15NRpxucd0Zf+yThECqPNC85hpSgoYM3ASFw1un3Ne56FQ19Nux3sfpVAOk7OElacSA94+fr
-bue88zoY13RpmdXAqxGgDa+DQTDq9H09vghOejQAdL1RcDJhrAA3lO+zhOgpxsE3l5jAOk9YwAZnAbOA
-zB38f0KuoVnjATQkOpPhaFKJ8rY3DnzdGfXGEME7HQ0hLpkQO8jol4CQ7DUQecksNLfrEFhFuz2rYDfo
-9EFwTGLsrD3e/oc8D/t3Gur2Zw53/bOv/wMzjW9ceJxtzVdP01EYx/HvU1sKyFb2XiooWFr2VBEVUBRQ
-cGuBAmW0QFsKuKNGvdD4EoxeGreo0RfgXnHHkZg4LjTRa2+05/TWk/w/z+855zznjwG9/s5i5X/rT+AT
-DMzBiIkQzIQSRjhziSCSKKKJIZY45jGfeBJIJIlkUkgljXQyyCSLbHLIJY98FrCQRRRQyGKWUEQxS7FQ
-Evi3jVLKKKeCSqqopoZa6qingWWsoJGVNLGK1ayhmRZaWcs62ljPBtrpoJONbKKLbjazha1sYzs72Mku
-dmPnHEc4yikOc5LjfOIsF7jPXS7SQy+n6eMhDu7xgKc84jFP6OcFz3jOJQb4zWte8opBfnCCIZwMM8oI
-Ls7gZpwxJvDgw8skfqaYYZo97GMvtzjAfg5yiJ/c5g2XucIXvnKHb3znLZ95zwc+8o6rXOMGN7nOLMc4
-LwZ+yRwxiklCxCyhEibhMlciJFKiJFpiJFbiZJ7Ml3hJkERJkmRJkVRJk3TJkEzJkmzJkVzJk3xZIAtl
-kRRIoSyWJVIkxbJULFIiVrFJqZRJuVRIpVRJtdRIrdRJvTTIMmOTb8Jt9rmcTRaLJczjmHS4iu0j3lCX
-0+VQwez1u1WNGPc5PF6nO3ja7/ZNqGDq0i5XhmithsaOYLKZGvVhs7ZF26pdp23Trte2azu0nfox/bA1
-WGymbr3XHdzTxRYspabNemiLdqvWrjTaiz29xh5Fr6JP4VD0KwYUgwqnYiiAaVjPDat+RDGqcCncijHF
-uGJC4VF4FT41PKmHJ1X06+hXcUrHKXVrWvUzup8JxH8cksiTAAC4Af+FsASNAAEAAP//vUB0m0BEAAA=
-`,
-	},
-
-	"/static/include/base.css": {
-		local:   "static/include/base.css",
-		size:    12545,
-		modtime: 1457622063,
-		compressed: `
-H4sIAAAJbogA/+xa62/bOBL/nr+C2KBI0o0c+ZnYRbHXutm7vdvuHpDg7r4FtETZRGVRIOk4bpH//YYv
-iXqlietd9MPWaKDHkDOcx48jzly8PkKvUcb+89scLbAgaH5zo57MWb7jdLmS6HR+hgZhf4D+yUiKPmIu
-adZKMUQ3eL3RNFlGViRGCeNoTrKYMvTufcmHCpTSiGQCSDZZTDiSK4I+/vtXNOiF6FQQgn79ZX792811
-Tz7IMzXwdgWDEpqSjsGDYJ7iDYj//uaDe9+cqQdTXRwdLVi8Q1+OEFpjvqTZLHwD1zmOY5otzU3CMhkk
-eE3T3Qz9g6T3RNIIqzcXr9/j6NOSM+CN6BovCdpSuQKeShNLTnYo2vB70gNGCDTqaIOIpYzPjkdT9XtT
-fcdJTrCcZcxe1V7nTFBJWTYz6l4wKdla0ayIejDrh+GrN0ePR0cruU71wuovjrXi7yJYFmepQBtDllIh
-AyF3KZmBaTLyptQJCvMHXy3m/rExU0r1TJYsMLLNrgyxo14xITXZlsZyNeuPq5PljPuv0VXtNRZiy3js
-k9SnIFnEd7maxRsGZvGHhI0lZCSSd4sNiJxVKPt28UnKwCxa6/5yzAhhhqyoJIHIcaR1uOU490nvKdne
-xRwvfTYxFXmKd07nIJGAIJlLnr5L5QeSvn+KNNmkqYg4IdmTZIb9w31ekbaLbs0WEFtPkxaqfpAc330i
-uxodzVJqXKh0q0Ducs+3qs7U9DXn54iTFEt6b/j2NN/AChekJDHeYqyD1L0a/TmgAAcPYL7nz2UC6kvd
-1Ajpi0KwYupBdWq8ECzdyIp6hMRyY1SjUUTQz6CB/qASToFk+QyNzDMbrENLIkHBAQY8AQaAY5LwApG2
-hhIQII3VQ4Mp6DhJkooIRAJGL8XdmmQbH+jQsMnCqO+xfez+OOHmw3me7poaBoKL1+gDg+kkAp4m9C6M
-KvS+oYXRfo7sXYSzeyzUHc0ixjkEr9oaRAQryZYwQ5oiFgH69tDckHKitC+QZHC5ZpKoeZBbotoL3KoN
-o4o/S7xI9UotaGksrUGr2g+aGD/sq98bvQPAe4Auwi0yGo8LOI7pRiikA3WhiUamKjk4iPb0LtIqqGOI
-PN4Wtu3e+hXrIbvFFLfaW4cTuAEB9X5rDcUSfWd3A9izONLLUJIXo6uxZDEWbyTz1GnvvVUZC37pXIFl
-4TMoFxaYt46JfcjrrDSjlOYLhnkMVwRzH6drHlsjV0HUCPN+1fufPzmEw/vNAjxOKxPCXoAp74Xnos5r
-fStXg1GjyuXQ1/qgDq0JfSBxa07wonkLPGvMW5u2p8biBFAMaQ52+Liqp5hABNJczdQqR53Nc5asXWBQ
-c3DD2CHncRiG1VxrdkwI0V4ekwRvUum907C04GwrCBc662MblfJBgIK9kNjkOpFRGaaKIBXGM1ATAp+l
-MTq+DtVPsQMcX3yiMrChbiPcJR3Bmn3ueNX2tFRizvJN7u8/nVpEVo3VTbOEBaclT8eTDkWacDYhVd1Z
-2vcut3WphC5Q2dJswQn+FKh7zzB6R6sYhi8X+DQ817/eZHym2f1BmoS06WXhMOwMs3JGLxK8QBiMOxDj
-wAIU87aKcVVIAXmdJqNZvqnk7JVcxd41tz5rt5oVTSyEXXmZA/NgVM+3Ap3L0TUBU8aAr6APtSvHFjHV
-9v8uvsdZBB+DN5CfgGeaT7yen4zdZYyvsUllPI9Cx4vBIo5iHe6/p3EZ27Vvt5nxJJXeYh64gD8FRZ67
-OVD4Cq6vptNoMUSjqbq5JNMhTtC4r24m5GqEh+ob5NWZ5vfzz8Pe5McWTtajCy6G67lWEdIs9ZXZoc+N
-piE3Y/kpiGClOas8V+JY0aovlGhWzOoLJea5lfnMyDtfcbYm5zc4wZyOnhD8a1ryleTrqKkiw7If/mi5
-jnv9Nr7sgCx/zwnH/X4PmLY5gTggq1+uW5kcjsF/h3OkE8VqLBDOGW+GQhKO4PdtoWDm+F5CwUjz/YVC
-qaU/LRT2YblnKOzD6kWhsA+DjlDYYp61RUJygEhIvqtISL7TSEj+/Eh4Ocu9I+HlrF4YCS9nUESCOn6Z
-Vz/bTaYY2C+3QD390pJSIuQlpouURZ/8Q6yJ9/EVurODsHmM4lK8gfoCe6wnbN53cnE0MYJPKXtidk+4
-Oo9P3RnWmsaxOauxqWa//Oy6mkzjRdT92YUmnR8L7lXH078yyb8yye8sk0xoCh92M5RztqTx7MP/flEV
-qluOM5HAN1DvI404EyyRvYIvgpDjcq5MJiR/e2JFODlHqiZRPjZsT87/bgfe7nLyNkROhEkw/ROSWXNA
-+hCIFY7ZdkYzdXQc9hQ0+H/VuY462jFI1wYtd4KkJJIkPgjGjIaTCZ5+G8b4aHJ5OYUv2m9DEzOHQZPh
-9DIkoUWTQX8SxrFFk8FoMoyTPx5NjDQtaGJEa0ETI2YbmhiZD4MmpZZ8Jfk6aqroG9FkH5Z7osk+rA6M
-JkaEBpoYtodAk33W6NDkQGAyc8dSGkxYjiMqdzM1XKc2kGrduMN7c8YPU/UkpzhbpsSrQZZ1fu9orH7g
-6o5oyRqFaEjWTx6a6kffepqtQBdFQgwLBC4ZdMIC5KXqj45YlEDAnB4PyNVVNIKIluz0OLwcT6ZXEMRV
-gVsBzY4EY9tR9UHNUPvakK/T67Ue9Hy5UXXoLBEoP6v6R+3U1k5v679SRWSOubKbV2awRLbgpioh5k+I
-Qr/G54pk1ZKZrZWN/QpgMHDn5DXpPMls9Wr2ww+V016/cmcZm9mUb93jdEPQWxQgr/ipxS4f2fKpeVrW
-GMdujqKMtWKcflYl0bTgbqHEV4dTBPKE4DtVzBTEyKOrxtEKVkl0jVMv1xU8VXJAHirzmvK4NuSbuoWs
-UXxDmdox4iTeRIZBjHU3Ec30HhyayRvfWUb85/iIujMn6vvr2Fj3RUrWtq34lLupGEAJhkbOHQ9hAKPq
-1ljQn7sfLASaHrSULZnq0VIpD1dhsJIyn11cqFqV6C0ZA2Y4p6IXsfUFgN9Pphvs7e98QUEH2ewy1Cb6
-m20VAyMWtWDXOHbiiE+KKljRRKEKEvXaGLo0wCB4BLsdg+Tz9OSnEwDMDYcrNxkQ9bYsSU7OFISvsTw9
-MbfnMLTxrzFUSn+k5BuienROzvwilFKO1zdiulX6l5WuHVtir0LbjqQp22rAKAuBrkGnQzfn5fXt7c9w
-K8CCAexKVO9mCqkDe7owNWcHem67a7s1w+6o/4d6v3aqCFSSrv9Un6OO5/pRB3n52Ozqvq7A47IvhRaW
-qpnE9bgEz/ynalkfSUwxEvSzqWO9ZOzF0RNnKI1GpLYOhaKfwW4Y47BWFS28wp/uqtgSOrq0XMeT7y5P
-NWI5ersB1UuzXnm7qBLWCov+XlP2/r1quK713JZGN7Fi22vV5vYvshOuza5GiB6PjiVbAkyoxr0mkW2H
-K8nepbKgaiVTTYC3ePHkVIrmWkRP0Sin051DOvo0WlqlaQvDMAhR2CM40WqiquFPAdnaOJ/phMKQIJ6u
-8UNQtGSCts6U1pt2qLfedRV5G70/jRa+moHsl/ijssnT4k1GNfFa3LpgP3KY9VjSF75d9e6xR9kZXdUh
-jRHNkKgHRfeQMiwageEN0jtzmuJcmJ1Sd2gi3aHZZW1YeKuTa04tLZ1aVU13r5K7LoWuYHSNZFb0WpuY
-x8ILlb04TMbdHGpRttf8tpW4m4EXovsxGHatoCMUIGasdw8HsFGdNSJkpID6DD0zRAbjZ4dI/2uilTIM
-B4UMzwumaUOIJ4UePFfoqSfz/wMAAP//Idw1FwExAAA=
-`,
-	},
-
-	"/static/include/base64.js": {
-		local:   "static/include/base64.js",
-		size:    4416,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/8RXbXPaxhN/z6fYeCYBzCGhJ/AT+Y/jf9K6TRq3SZumHk/mECcQETp6dzImrb97905C
-SEBaJy9aJjHotL/d29/u7e7Zh/B2Gkt4wzMRMrjgYwYvuJgDrslsNGOhAsVBTRkoJuYSeGQeXvFPcZJQ
-uMpGSRw24BBexiFLJSNwa4Fr9Sy4jIBCyBerEnP1EpZUQsoVjGOpRDzKFBvDMlZTFIilVhPFCep4zzMI
-aQp8pGiMXykDqmCq1OLEtue5bYuLiY06bbRmW3BoNxq2DS8En5+sJacTqypc/O7iRpWgiS3osqvt2Sx0
-en2vN3Jo4NszaUsR2mN2qzhPpD2LU2XLLJWLeMyErfedTrojKlnft2YSrR7OZIJCsJzGip1ARBPJ9H7s
-w0nCRzRBFlLJE7PWuKUCnhkwDOGPBuAHg3DB01smkBeqKLTQcyoENcyhYjZhQrZ1HOgamu/CeK01KJ6v
-v6UjNHMCzfNnF/9//uKbby+/+/7lqx9eX/3405u3P//y7tf3v9FROGbRZBrPPibzlC9+F1Jlt8u71aee
-43p+0B8cHXfsYdOSiyRWrWazTYyJ3OErOtYP2sSwSRrmDUtDTBv0O0tDFfMUWtqLduGc/hxkSIjecqgO
-TstVzYRgMksUMtFs1l/UPRoWflu15ToiYekEE2loOLTyp30SC3RhCK1C/DF47Y0UJtA6Egz/rjAtBWMw
-WikmNf8+UBnGMYRTKmiIJ0JajRIccQEtbSdG/b1T/DorzXTBbeuVzhDtVZjRn4IDfFXz7lr7cR3fwNOn
-4N6cPgjSaq1BT6B310NTZ2fgt6EDxQv85RiNfvsLVeZIozYyat26Wteo7T9UbRWnlXpRBXjf2BcRXUME
-m2NBwNQHB5BuNw8NAR1Unqk8RtXoVOM/M3Epl+JoHR6TEsOhdqkeGo0oI1jKfoGHs68J3+xz4Zt9Rfiq
-wGr0Hojv+9W4ANOlbZc4578mbh9vX+Phw6nYpKhgKhNpAcsl7ovSWKnsReE2Bd5U8ryErys4Ss7kVHeR
-7rue41UKe5xSsVoX9uvSatch5b8HPvxb2L5bffA22MAlgUcCnwQBgaBPggEJjkhwTKDfI/2qIujtsYuL
-gH9RO3gEfFSBQHwYEDgicEwc/d4hjkscjzj+BusExOkTZ0AclHOOidsjrkNcVOR6xPWJG/ytv26fuAPi
-ItY9Jl6PeA7xEOt5xPOJFxAPN+ENiHdEvGPi9zZY3yG+S3yP+LhZPyB+n/gD4qMi/5gEPRLssGigN2Qr
-JTrrlDDrY7av3xIcFiLJ1D/33VwOD6haLRiPWmvcIzzIzSzF6SBO2bgJ/1tLnlSrZtGcK1lZbc6b5Tpi
-Mz2U0uXSvomAFN8f9vfxSI1iJfM2ix0izeYjJvS0ZJZzgsYEcL6EFTNdYYQT5GLB0Lvxji5zJte6vliD
-Rn+oDx8xCt29jlo4I7Wx/uU0njZqnacKO4Mehm2fprKGFjpqrfEQnpshJcLhvdL09OzuFfzpCpOzI9cl
-JS9ZFXL1NFQ1retuG0dxD5vGK6qmVpRwLlo1mce6LdngWEFleiqHuZQt4VwPsK2aofbp3r6up/vRSn9Z
-e2ap8Z0ODTFD1ZoEPVlVpzyIO53tDqTBIQzruWp6i6Wp0jedc9WK26ZlDKKtHqDR2LDGmr+CHgPLIbrl
-lenbriPRsTcf4wXgTYNN9Oy/iQtNx/kdQS5oyGoonRGh0dvd6aX6U9wgLCYEhuLgcls56HyFA4zYHg87
-+AKvTwsuY1MvtFi8te3CCCZMxupv7itP255ecNxIWFxbsFLx8jwRyBb4zfR5CnmWqhq0cupa5W/s2f02
-/Anh6Y6sOZTYkPuVDCq2gNfMJYMpvWVwpEfCORfGKA6F+XHFZQMvrrF5Ru6QX1p5OoSjfREoBboosMsd
-7uQ8N4fa9A23yB5rR1Jbe1S83WdIf/JNXmPydzo3NZLwcK53kqduFO1u5n7v7g3+CSpzNNUbLV1wPhfx
-+iB+ae7xSC/V/9NVzqtWRAy1o+rd1Fz1Qy5EtlCVilnlett7TO6ieDw3ad68WMPrqptbuYs4K6Vz3Yma
-eXPpGgXNuhje5fhSCz94imvcn5oqi1HF3lJMcNqSOcC6oP4VAAD//yIb83tAEQAA
-`,
-	},
-
-	"/static/include/black.css": {
-		local:   "static/include/black.css",
-		size:    4613,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+yXS0/jOhTH9/kUR0KIFpqQ9AGXViwuAa644jFS0czskJPYrYVjV7Y7PEZ893HiQFsa
-ZtSQQV1ANm1in98/h/zUk91tB7aBi6+XIUQMxbcQDofZqVBMHiQdjTU0wia0/aAN/wvM4AJJTXnpig4M
-UTrN13COxzgBIiSEmCdUwL9HMxBVwGiMuTJLpjzBEvQYw8WXc2h7PjQUxnB+Fp5cDk88fa+b2cbrsdlE
-KMNvbG67IUNTheFoePx8fbmSZ0rtOs7GLX6IBJIJ5ZOphp8OQGRufSSFKejGggnZ3/B9f+A8OY6XZ75R
-GumpuuFCpoi92tKHjW6cHQPY3YYrlkAkxZ3CUmW4xZVuKh5dRjlG0h1JlFDMdUOLSeu5Bvib5nM7zg7o
-5V/8/A96QfYl6GQHBL6/2cx5p6cdb2+nhHSHo1uqZxRLbQHDREOOzD9FQmuRtiC/b1eZCw1DLdI0F85n
-cYpory6YaEXMxQtZzFaRuWnzhmMpUtwaIoIk7f4m+J+6NN+k+R4tt8giA3+noPa8oIwrakReTbBEQeAZ
-aNlDoGpEnZ2UQuoDfOuEWfmnVy5gKY3fSyoQv2uO96lga6yLCjbN+qkw69KHqVAFWVGFKqiVVKgCeEOF
-OyR5mQmkBhPIWplA1tQE8vEmrI6sbMLqqBVNWB3wYoLjaUkRHzHs2nEw90BIM5r125N7UILRxDAIGSyG
-sSMWQDFw5QuWBq5oah5E6xYRXLuKPuI+BKZwtvUHNtNojJiLGB3xPqQ0SRgezAIEswDFiOZ8jm6fo9sa
-jm7m5UabBxYmUoxo0j/+fpaiEb6WiCvzGpV6FzSWQgmivRcuGEekDrN/mdLycKuIsNUC8841d9pit1r/
-FRuvHyb40IfnCHvuwYdMj6Vm3yjMcKzNy9zSz+dBkvQ66H1i2hpWTBQkvS4pxPzHj9vBfiHmfhzFPvr7
-Yto0JWLaaCVi2phlYtrM9Yg569J8k+Z7tNyid4pZBVlRzCqomsW0EZbEtNg6xKxyjy9i/goAAP//ONn9
-MgUSAAA=
-`,
-	},
-
-	"/static/include/blue.css": {
-		local:   "static/include/blue.css",
-		size:    1332,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/8yUT2+bQBDF73yKkaxIEAFZExwr7qmhObRKokpUvUaLGfDKy661DErSqt+9Y5M/ODXq
-za3EgZ19M+/ph5izUw9OwdjvdxkUukPI8nxbyezmyal6ReBnASRimsAXixpupSNlDirOIZdNt9MYgyss
-obIOMjSlsvDx6s1HtaDVEk3Lks6U6IBWCLdfbyCJBfgtItx8zq7v8uuYHinYNn5bcVOlNI40J1GmZdci
-XOWfXu7/nBTzqDPPi3cx7luS1LX3xrpGavjpARRyua6d5bHR0mrrFhORivl5+WH/TjWyxgVED1isFUW1
-k6VCQz6rgM0NShf271gRFJbINoMC2U1/2nlELZ99Ec/SEFxd+FMRTmfh/DIIDol6TRrOw4tpwGQgGMnW
-2B9RH+VdPkZDDG2Yas8WZunJW/nZCWbipDf79Y4eOscf+TC8iuml4pjwklSEFyk/R6b3imnILkkvn8OM
-43uQzozSq/4BvZ7g/4HvNc2QnxeTU9LUGqN+++zoWcebYJFsHqG1WpUwqapqP9zwX34hvNXwxMkanwor
-XanMpqO/bALW/w4AAP//8AAb8zQFAAA=
-`,
-	},
-
-	"/static/include/chrome-app/tcp-client.js": {
-		local:   "static/include/chrome-app/tcp-client.js",
-		size:    9465,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/8xaX28buRF/16cg1AdJPt0qd0CBQ1wX58hJKzRwDpbS4BAcAmqXknhZLbck14qb03fv
-DP8tKa2UuJf06gdbuxwOhzO/+StPLnpTUT9Ivt5o8v2T774nfxNiXTIyq/Ks13vJc1YpVpCmKpgkesPI
-dU1z+ONWxuSfTCouKvJ99oQMkaDvlvqjy96DaMiWPpBKaNIoBgy4IisO/NmHnNWa8IrkYluXnFY5Izuu
-N+YQxyLr/ewYiKWmQEuBuoanVUxFqO71CP5stK6fTia73S6jRsxMyPWktGRq8nI2fX47f/4tiNrrva5K
-phSR7F8Nl3DD5QOhNQiS0yWIV9IdEZLQtWSwpgUKupNc82o9Jkqs9I5K1iu40pIvG51oyIsFV40JQEe0
-Iv3rOZnN++TZ9Xw2H/fezBZ/f/V6Qd5c391d3y5mz+fk1R2Zvrq9mS1mr27h6QW5vv2Z/GN2ezMmDPQD
-h7APtUTZQUCOumNF1pszlhy+ElYYVbOcr3gON6rWDV0zshb3TFZwEVIzueUKradAtKJX8i3XVJvno+sA
-Gq4bvRHyKXkmJNxtvm0UGSr4/WO+kWLLmy2qe9S7mPR6w1VT5chpCMIKqdWIfEQjTSbkhq14BYKJLahZ
-5LQk91Ry1LoicDs4iOAbIMjfM02uiOHOMvf822/+BXBmkm9ZpWnpVi/d3gJucNVJByuXRpCLC8TMBZlK
-RjVDBYCNlTY4dPjKQbWVNmSW9seaSrolH+dg1Gq9JxuhNFkApWRboZl9BrDkoqpYjh/TfbfNdsnknqBG
-zD7zIdkAYDZnRxwNjwn89joli7yeGtmGuD42bPB3Wc4qzeQ9LVHd6BHocJkR68rwumzfmrOvzN7kbcvE
-rEaPoPrv/nxpfQ0MOaVluaT5+yCXylo+uVtEO1hRiL/lU1I1ZTmOuIB37Das8iYHcDlSRLbdC550sP3k
-3pa03S5Zfv+sWa2YPN4+pIpcS0kfLMHI8rPGx42M3wM+CqopWQGgiAJtMBlztnjo5qzM2iOZKqCy7Ij7
-ObitYwR0heMC0Il57Fs7zY1mIttYVc0KMA0eEVmfq6nXHCyuaKmY41OK9XDAK4iAtOT/xpiY106KwQg5
-7FOvsmwUioVwXkx/chYaY6gheet1og7Wy2JfUxDRXEAv2D0rgU5mzqUhZ0y0bKr3E4jZauJ2b/S2/NOW
-QZAqvrUHpO73wuF0Tzw6jRMGt0JHhAWM1A5A8DZ4X3C6rJZCC/1QoxzWb68Ck6Fn7T0QDPCCS4VGV6K8
-t1EaXbGiEADR4Ssy+8laB4JT5siGwXPHLW9Ya0o9Cg5laGhRSBDArpknyA2XHkhWM1YbwwEYbTAmH/dj
-u/WdqGz4y5a8KsyRo5EzuJH8jq0hg0Ei8Bf1t8tiCYKvR/rw76wg+/QAD88Al7nBMWiCoh8ujR9O7jnb
-kULsKqOyHWRpjyYXHBUv2JdDDKZ31h3nt2ptkHIknXE6yJz/FcyMI+N1tmAwzMwbDBc+45yCHJ5nA1WM
-OhBqTA6hx1cEF7Kli2seNoTAG9jdrjkruZBx4YF7w5bN2jxgUl0+oMdekbe/jEnzAwYP0MBrXukfTPTE
-o0aWERYfQ9zCgerJJfz5C+zISlat9QYev/kmEQb5ZnWjNsPmh7f8l5GXxsedPt4ZCxZD+ZT0yTdwMbPr
-V8Gr4WA8GI3cLqM3QnoR+I1hh0nkGxOjMO8Eb5BiinUo6/SF2BPQQAducOAEhiL1gG64u9zw/4VxJ9Mf
-hGwrTYxskOsY2V0QTZCiXEZGqACHFhytwd5ZmoWIcr89LZxN46rgYxpSO1EVbfgsdHXExq8EOEzFWNKG
-mg1c1JiJBiNx5QuToqPmfZTtaWJ50EpgeoQ7NL3hFNhSuW6wWjdLYMIo6vax5+lbw/XPggny4B1TNRSl
-7CXqsUrjpT9sbA6JAyY+Y/2PYl1dXZE+dkLYsRT9OGjZ9VS4OG7FFvRaPbCiP80e4y51kNxbI7d15nF2
-JQwKtXMbQ8I42PgopJh20lbZbYmtfi9UznD9H2LlJhx9Di1RTReM2wp93kkjurOu2kqibGfw1ZJBK9FZ
-7SSCB5UkLnPQOhxguLOpSGJpe0QaUEeeLpzSpc/ELU8RDQOvvftrOpoj5LFiMDrpHAn0ApwbHJAFL9nh
-PEqzbW26+Q2Fin9qLGJZ2Eocc7+9OpmtYheAGKyaPIfguYLO7MGRu9C5w+ENoRtGC9tEtVODA5AkjVQN
-0bfthX5nWxUBJrjnq+Wv8G7vhJ1VK2ErCadbpikv1VmMhUYk8brALplmRN1rSxHeXqaoDMR/JU+OUngn
-5sZtVzVuByVRw2Q3ncnnaTRmUgo5HLyuzFwRQ5+9qD3vK4PtNEAQdzTCWtTxAgjt9i0tTLtamTGQLauo
-tE19vmEuN/jpA1yrOA27gwmY7VanosBJc8FzMwyAK5n5pkk/rTg7GjvFJ3GU2icGVHtoFMjneKVwQbwQ
-XiQeZaXxC/zDhS/F9IJvmWgcgt7hmFHgZcBz74BJhI3x8XTN13odse0osJlYFdKMu9oghLTu7cOjRmpw
-pJ5BVzc+Rdsqowts8WIDtynJ4vfLZaMAn5O2PVRvVzrCplNvKKYqVMpl3Aeuwb0hVBy6e3CtIY9CjbUL
-vsny47x2YHyqTxufamN8IDkwfuBkpcP7W1ataHaeaPmL6gbscMC5TWo26FgsAXlnNj6V8zBEJ6nPYorq
-0/lz34mbo2gVApXBEPYCSwZPXUjKHA9R3TPpalBTzRFbzlmzmh4FNnNTRfoO2m7FlGgHolwTd/iqkSak
-AIwwftghAqmp/cjbET0eV0t2z0WjIO8iwbqKSvZwp0+k1s/sRboTKF4tpE9egdOglK6V+lTg8/BII55l
-GMU7NNJxR4LIQUWxgRsn88qlDCt327F4llkUwpPk6gKNF2cQDRQv/Ie4b/+84VI4FqULWHzcoOnkqCkt
-CvtBO58xbwoTJzdz6oznbQsWi2O6CN1I8Ae6i7+BANeEHbp8yE7VtS3DE4rZnxdm7r6USIRxrpdIgmMg
-Q3sgyrto0rEQll0qSjRGAQ6pGU5KZEjbGHQ0NrZXa1G0kHy9BjFpJYybm9hgv8ymO/rgy71HJeonp0uy
-6VFPnMa1uHR3JbvkUJ9VvgLr+Jqjs0zyAcEMmUJEgAusxSMiQjJ4isNCYOvN4nw22eCTgY8ZHXOoDnQh
-1ckZBi5Gh5/Ws08C/huBkAMOYv45HUYw3puBN6qw5ZPbM9I9j5pcWA7KVsyhKjtvlA63+eQY34THpQuJ
-z0qxHL7tmLz7WIbUK0f8gpcM8Q1hwk/mM1GVIk0SrLWXP3rIMiiL16YsMV85OVN5Juhn12rBPujhctlZ
-CgQLRgPlA2t+0oJ+7AMcDkbTf4zxOgbGsR5h+bOMB3RfxFiPNVc85+622hsJ1TmTaf0GsWHt/S35LwQM
-GjhbdwKBRpUoWeZfH38p3Mne9MenD7Htc8cx7UI4yP2rSRaMB4oLn+Gm+9FwB4Fe7GDLfwIAAP//1C4l
-F/kkAAA=
-`,
-	},
-
-	"/static/include/des.js": {
-		local:   "static/include/des.js",
-		size:    11456,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/9xae1PjSJL/n09RNxG3g4cCLD/ANM3cClsGTRvbJ9mwLMHGylKpEW1bPknmoaW/+2XW
-Qy9DQ0fcRFysO4TlUlU+fpn5yyq793/bIr+RcRglzCN+FC5If+7Ed/Pg611CLoddortJEC5tNwpWCQkW
-qzlbsGXi4OAnXIqvuyRZfdrff3x83HsM0js2X+254WLfzyTtPizdfZiNC/rr+Zw4SRIFszVKIX44n4eP
-8Sf5fPf/6iXlTe6CmPQMm7hgTkzunJjMGFsS9pREjpu5vXLcb85XBv4u2F43el4lIVgWkXXMSLBEKPaE
-NEbWyyVzWRw70TMJPQ+WRkHyTNzQY7n4iC3CB+btZVYwEOTeOcuvLCZOxAR43XD1HHGst7s1oh0dHRF9
-8pcJGTizMHKSMApgdtdZzKLA+8r2CNEBPAsXxMQCiVFJBTgah37yCOIJ3HtBLFAGH8GFBEy/C1cMbhwI
-ZUIeAxA2Y+iiv55TlAGTyZU5OR9NJ0QfXpMr3bL04eT6GCYndyE8ZQ9MiMJcCEAyaIucJfgf+ijhwrC6
-57BEPzUH5uSaAIR9czI0bJv0RxbRyVi3JmZ3OtAtMp5a45FtcAdwbY/F3WB1xyKyy1Vg1NjSxWBgoiwY
-mODlgMIAOBL6MBe85fDD++yZ9JwHRv4eLBYsWjhL8tlL4f6vjwhhgpn5OyXOEjDJcq4QBpeH4YBc8dnk
-Koy+xYAaJebS3fsR/GNQFsQxGgqpA5hSMGn1TMki9AL/WajMQyKMVuFCAdwkEOyF7jqrMZ6Dw9Fwtzu6
-QGhNfYCQFj6t1tEqjDGplohNFiifcTgATQaQfMUYMY+SVRQ+BB7EjWeBRE55vwyTwOXLvjEodxQXgCFu
-ssfv8boye2fGhFyNrC/2+WhMLvQvEKXhiFjG2DJsAyI/MUdDm0CwZfaYMEE/xZSanBvEnpoqOVDeqC9G
-R/0JTDcoMSD9DIsYf0NxXIx5MR6YRg9CMOwOpj1zeEZOQdhwBHViXpgTo8cTYsQFyclF3aCikpW0nJM8
-fBtpSVE3Qm8O+xYoNS7Aub0NAOxzfTDgxpwaYI9+OjA4zaFgqKCefqGfgRH2tN83LDDs9BpmdY2hbRhE
-B2AAOHs6mKCVUxvUUHIx6pn9a3RzZPGyMO2JZYLLODQ5N+0MLY7OxIZCscxLwP3SsPdUoMoTTZubCBVl
-ng3BClw4nBjDHtyjqVObCwNb9AG3C/wemEPuSnc0nFijATH+e2qOEQRYSs71v+tWbzS1iTG8NK3REB/Y
-IAAmIVqkr5uDXVvvcxFjwwItF/qwC7Da0+45qgApGLERPOQ5gxAMp92BATHo612MFISPEt20upben6Cg
-oX5pnsnZFi+D6dDsigH72p4YF2IBmcCKvtlVxlNA0TK6mDHCIns6Ho+sCeRv9xz8tHm0rwx9jLmbSQIT
-r85NMBcNRY+mCDpPWS5EwdsdTQc9Apb3pJ7BNSZkz9An5xS9t0dDqFVz+MfUuuaqbOMS0oFjc35tgwsD
-HC1gCQMid8j2L+fm2TmxTPsL0bsQZY7LLzVoB5Vk5DaNja7ZR4FgA6ROd6CbFzbPxc2ayggenSrUBAp6
-TakkO0XAEYuT12m0K2kUqOcP5sMGI/7mLFNg9s/3zP+rg00WiVh2tEhQalShVItlfIlUiAQp23EcriMg
-KhyZBUtsxMCTi5hy9kOClCyIUjj/Bi6nU4qtl6yQqhNsi1U2ZHI3Eiy/Ai0uvQAXxZydI+w3CW/b2l7F
-tBi7kLSJ96HFGoCJWOLIvgvt/IHlRCs3ToJuqWDhOchDMbla7l7ZJlAK25gAGhuv88amIaCwgIgyBPz0
-1i77k2yRuwspqdy/YN0+xCNMsK0vnIRFgTOPc+B5wFBw0Y1sS1PlsLE1ujR7gkWxJPUp7FWQaHui0JEl
-R5ZN/vlP5Bf711/xEUr6cfpzmsm6C622Fyhlqkr+Q/2FG/TetoebBW6pGjV6UA1AONBLgRuAZUVnKbjJ
-Ga/g5esNh/MPupPfdQEzZBQq2AFvjL8Z4Iku2QjE2sDcMAke8p4j29b2O6hAQLrAibwrAA729NSemJPp
-xCBno1HP5h0eyc66hJ5nH5PByLZFq4NG0NMnOlcPUgAteAz3p9gFETfsT5Y1HSO31zghja6QNElXh9U9
-jjGwPvoMGI0szmGIh2zxV+cG30kApBw1HbHATtrlnaQwE7ROsBfkzpKhcTYwzwzoV/h0hIKuTNuoQcRM
-7NIoVWyQxS5mOlE7Gd5J++XUpTyixOwTvXdpovFyMuSBbcqcGfVFX4JuI9BXVXAZxIEgJ717YeDZICZ/
-OA8OHDy+MnFIWe0m4a4H1UUeWBQrRuK1jIXLqw9F+cGSibVQZ3MobRZ/Kh7fFDHv38McPLHtb23t/0bu
-4znsA8njXZCwT8SHAmb8kb9e8uMh7iu2V3C8evRq5F9byAK/IFNjRbvJL8dbfGh/n0yc2ZwBTSMDrwVD
-wCd7dxY+4TBL3D019T6+Q527V3WtyccenIiMuw1yQm60JtUOqFanjSYldUpalDRo45BqcNOmDRg5oo0G
-1TpU0yhpUr6+8Gq0KYHZ8PeANkDQEdUalGi0VadtjTbrtHlAWwe03aKNI9o82ljfrtNWizYbtHVIW03a
-6tBmh7bbtNmkbRhs0xbIgpVgCzzSyG0uIQmTKEzQCdAHVqPtB5R00BswAjwAs7RDtKmhoX9gK3jW6BRk
-pLC8/gReOnRGXepRRn0sbY3a4wZcTbhacLXhOoDrEK5Ovvwbe47RgNs8LgWw23zMOdE+f9YOjskMbxqt
-Y+KeOC+zY+Lxz8eE8Qn1Y+KfeC/smC8CC1Cu+8Jo+pJSBy73xYfLg3sfxjw+lsJzl18+v5/JOTP+zCuh
-ncKzGZ/DYK24XC435escvk5ceJ9yGT7Xh/KKspQ9qEPIYHzOLLNJ2OfwcY+PpVy2kFeU5Urdyg4xX8wT
-/ud2Cyw8Ljfler3b4xzlRl2i3NTKKLcVyu0Kyg2Bsi+lM67Vkei50uIZv/cLXrLSnDIyTCLpcwsVIsKb
-NEM5leikUq4r0S5HLJXrBJozKcOVtrrSllTapzIjlXZWo+9Ku1WElW9FRGcZ4hxdKZcVUdYOVS4fllFu
-SpSPKiA3EeQ0C2cqgVXh9CWgxfRLaTEtqsDMMvBV0FQqe1KeL1M8lYHwCiWSVtJPjDlZ2ql0ZrIEmLRD
-paFK53Qj+DO5flYoD0faMiukeJolg58lURXkpgK5WQa5LkE+rIDcEpmsFPoZNxSjnMp6dzMD/Szqsw2+
-8ArO5FmlsmWWOciyisi5qBqwHICiXmWPkjErAZL7UeWLtMCByjfla25f1W5uX4kv2oov6mWQO4ovqqnc
-Fqmch9UtsVtaQCbnATdL1deZtMhuOdf4Ba+8AlIqRV/jC2VPHjllY87wqeQBvxBBMfYaym5Wijk/uFln
-8ErlMMvkllm5oVL5qIxyS6HcqqB8gCjPsuJnEpG0wHS5tjKjMcm4rzGpV4hMOdfcjJHznuNk9ZNu5HKh
-aDN70qzO0pIds5xFuQ/l6JdJUbF3Tg7lblIkKxgroawplA/KKGsKZa2C8iGi7GR07Gd0Nyt039y7VMba
-K1TWZpU7WV4UK54VqFz1PJVTed1UKb5I4Xkdqfz2Sr04j5q/kctpqYenWS7nbSHN+nMq7c73A6Vc1joK
-5U4Z5QOFcqOCckfksoplKhtJ3phUPIuVpipUMUu5MtOCharReSWWybnVy+aqWqnmn1PYUaQZwixrzKmU
-4Wby0iwq1RpzC6zgZnWgctfP9p2pZJcCI0mU8x30Dh5X6A7fR6tHNhOnN9h3i9NNdmyKWfIFNuPb8OR0
-Hrrf1OEJX3jcCSi5p2ROyYKSJSUhHJxcbcH37ngbybtvS3FTOqZEziOcEOCvxv+CqC/LQSC3/NwKODhu
-3+NRAlTAWxuy4Z585u87O6iX7J6QTtEmfM3Jzgn8+Ux22+S/yEGbfBKfmvAJjjryk4bPmvzTyckJaRzi
-U5wLPQsgGXe1klD0aU7+Aqeaw+PSA/T35v4WHm9nKN3Mye+//06at7BgG7J3UauR/wAl9Roo0VBFJuJ7
-xd0A3T0mAZiocTeDqntoCTyG52VDEOIF2akOf1veLNA6eF/ecuGlx1xpCONYeSGCe4TvOzhQ1VyKSUh2
-+RoMSMjj8dp0EY8TmLUjD5Y3we3xq9MCn2xjZMK35Ai0IwE2h33+hqjvhOEXAB8Ww115S9bGaHnk+yac
-9yKGiAyeS9+ABv1FO8bdBthyqxLkdaNFFF9OiIaR3240weT72qbFm9aW1EAUGq0P6Fr+vK7vr6U0FJIb
-hlAWryS5KHpxh4X/buIjX4hExoU7O5WAIZH84DF+p3CDenipcllYzfW679bhVUNfD95c8lJeU+fL+Bqt
-/t4iraIIqeHjq5QqXFWxb2dHEOaH3Kw3feUmNNOP+wnrxKJ3wdEqmtDi1k+4iZp+7KDIse9Z3zLET++k
-Q2bPCRPfKLKnpNzC2NLtbOPwRu8SuTeDN3y8F88Dl23XKPEfHOhoaKuYUu4ukLeUPPEmMWd+QsVvQ5Q4
-rrterOf4fyJKBWD/z9qJ7zIbk5A08DfrOJuD/Di7wYzF77HIS/ZBOyh86GT3OR7RT6/Mlj7xhiX6VKtG
-/kGimoiEL/4VlfzjBPwtmAuft58wJ1qFeBUFagdFiZjB8PqQRFhZFRlxkQ2UOBcSm/JVkfBU0SAkNt4Q
-2CkIRAPx+pDATq0cAJCHhtcAZSW7qaFcrap5noPiyNc7GksgcQm5qnlF1Zv7iM6rbPqUmY6N/oVI01uV
-EnxCM3jZ8nrYoFWsFZCE556bJxEd/7UpUO5wzL8BCCX470xtqqmYS+/M1dTcRuuNuegsAPquI1xc531H
-Dj7uSOsnHGm86wjPElyw6Z9IDhnM+b95MOc/G0zVYf7/hTTaDGlhB5UzTFMrlOmfxS1Syfx1JXK8U2L3
-KnX+gN07bwhsFARukvsPBL5F7qIBzd9qQD9g9zcbUKsocaNJ/kBiq8jNuB9YRczx+BYANwN8V1DSeAP7
-i+LJ5hU+h4yv8jl0eL7le7oJwNzGLTd6u0N+I9u4gQcB/wmmwAveG+3KZg4PCVzAZ34sEMLwDIhH7e9o
-9XoZB1+XzKvsxbivLFlHSzI7fmN3ph2Ut2dkHeP/zhC/xhIH/yvf88aWDVdul7ZsUo3YzsnNGmzfOrXa
-nhsuXSfZLj3qUIxlrVaySn2dIX8JPi5iIL8IWa84sWwVVP7rV2nQr5+Uad/FlwTrGejCULLId1y2tcWx
-Kv7ovPW/AQAA//+/u3qPwCwAAA==
-`,
-	},
-
-	"/static/include/display.js": {
-		local:   "static/include/display.js",
-		size:    33966,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+w9/XMbt7G/+69A9OaVpEVRIv3RVF8ZWXJqdWLZleymHo1GcyRB8uzjHXt3FMm0+t/f
-7gJ3h88jJSdpXhtOYlKHxWJ3sVgsFgvc7tMn7CmLk79dnO6zNx/e/vCCwU82iEIe51h0msxWaTie5Kx5
-2mK9vW6P/SXhEXsbpHkYOyFesKtgOieYOOYTPmSjJGWnPB6GCTt5hXV+CAc8zqBkHg95yt6+/4H1Onus
-mXHOfjg/fX1x9bqTL/MWwCL4FTy+fH1y9vZ1ZyqwzbNgzFkQD1kY53ycBnmYxPA7y9P5AH9nHai4++TJ
-7tPPWQQwrJ8mi4yn+wwgeJstJmHO99koiDKOgLtPx1HSDyL2MQ+jNnsVZPzl8zYbTIJ4zE/naQaNIr67
-IGVnYTaLgtXBkyfN0Tym5lizxf75hMFnaw4IgYxwkG8BBD7COlcf379/d/nh6vb87cmfX5+dfDi5PX13
-cfXh8uPph3eX7EhQckDwebqSyPAT8wU7nwK7Z0EeNPGvj8DPt6dRMJ3x4UmaBqtmt9VmXfivdVBWW9sg
-ykGA37NBkA8mrMmXLaXh3V0WjuMk5UBbGM1TLoAFT1IGSHgpgiEfBfMoz1Qk+STMOrfDNFic5kuAjudR
-dGCUDm6hSwf8NIjvgqwShQGVctSVv0L59c0BUfePOZ9zhrjDeMwC0e+kHmG8k6BiiTpQ+kTlKp8AS0AH
-G6XBlLP+fDQC2GaUjMMBaMCA6GixLPyJGzSM+reLcJhPgIg9kwsom3AaB1RoNojYWBROw5wlI3pyF/LF
-LElh3GQ5jCY2DLOgH/Fhy0A8DZY/+hqFsjc1jd6FWQgo2dZsssoU5rbKxg18xeMfkgFg/CdrLBv7bK/N
-Giv5vZDfE/xm91ZXRjyIL/kgl7W7RfXix7IHP3a6+Ej8QhwGklnK786gV6/yFdB+xLa2zGZgjHKPMmFR
-9+Wy+7Km/HbpkiWVrAxBojnoZDy/LZS7icBtVvzZVnQdPw0cVreDJErShrQ1ejkWzdO3wQyKr2+Mwgy6
-iENBt7NnlBQd05A2yygWin47Ba0HiEajLL1vmcyc8f583Nw6Pi7GcGeQSLuZpFsqeDhizW+kbIJ0zPOW
-wWw+AaNKBup1miZpc+sDgbHpPAODCzrP8y3FJN3rqPPVjNNQqBpgR0dHIIUch2xjXWuNXG8tYGfv3jIe
-8SnMXQ1/uxpLHfj/NIE5ZLmeuzhhFTSb8nySDGv4+0azfTZ23TB6iGo2ekMPL2pvfoSpjZ2MgfF9tsW2
-WRzcheMAOrQD01FKBQoSpI5qv47HYcyhucGXBAjUUL4qJkwqJax2nQMgyId1wftfwtyLVhRbeGWtOsSg
-HkNkyIdZlluoi3p1uMH2ZLlfFqLYwixrHajdQx2K9jBttnTLfDoB2UlLzEY8yGFuzTSKGoMUHvNy2m/A
-jMY2UCfFDIBKNWQT5SzYUPQIBgo4Kms0Xk7Jw4Rn4CTmLJvPaMYyyKsb5IVFhyn29nNGAxzNskk/SfM8
-HiXNrfcEjFP65wBaH6ThDObMGRdOXqY2VnGutKB4NgZBIPsznvN0Cn1WOIQlT+g3gMHKsWGcOwfC6bsL
-AzYEHtnHy3OWDSZgXRwMCuDbeRqyf/2LWc8KrqFQo72i3wBGv3gEVA7d/axCC9FJbq4EM5nwWIHkrLne
-eBwe+qYCqnSgeXyg60meoO3GKV4V7ft5PwoH0i5W+lzMXKfkSr9Psn3NZYzy4O84ncL3J5NZdJzvZqVx
-VJwTXQUEFoB7G+STzihKQHfFs5YD8JMD8JM6RIuu/UZv1qROa3nnbtZZCLd0IDxzFsEQSdHZS+bk8/UT
-6NXMjeKTRDHR6b1/Ystj2QNYAF2CDcJG2Q7rHthgKwm2EmATAabBYZ8lWUg9IRY6tgwkg4fg7P3hD0Wz
-1cO1MlmaDJkNID8lxuMjw9Guwb9zxLS6O6aPvm3K5d5mTwpI9kEdQ0UPrdYytOpVGFWGxOqgpgHgSK+9
-Yy0ttrU1noepogPAjlCvFfTj367mUw4zUFzHl2ot9PEspS+8DtnwNtsqRrTy3BpiQpmOZC1DhbFnPUXU
-ZbLsk1G26qlFprZ/nIEl58K640IFOB/kwEXErfEzSEuzU65pDlzqs2THANxZdl2SpQI5XDcZCIdUo+dD
-RUN62VuLCSVEVK18VK0kVRtpM1G18lElDM3KosoS6RJWfQtbhvXGBHrtahKO8mq1HPFR3oaeQ0eI/oBZ
-m2YTqy6Jfu/Aer7AoexSLadT5KaCIl4lGSL+VUuHNNWuZguanCTZclyBHCceOXptmM3BfFaSnyczL/Er
-jxAnpRA/PVqIw2QRl0T0kzxPpvV0yInM1WxBk5MkW4hZcMeL+ILmWHdGYRRRiT2pDooIlbpYc3mjFi6M
-YqTjfrP34kVb/t/asg3U2RysU0JIGBhbFkwT8EYTMFhpxq67N7BoAvccfAsAkuEismYheuI72TQBUPJf
-ExNzcJeEQ1y+kn8bsH40TxkfjTBUs5jwmM2COIaijlHRxNPtsEmez7L93V0g8CfgL+hME/GdpOPdbJIs
-bqGkMxiH34XDo273T8//2P3TGqw/VmyBC44sgQbxO56uWB5OMXgWD2CBDw40eJ+wEshDWJAEKQfdAXgT
-G/GDYinjbiBO4eAMrWGjdxiwQquaq0KUr2MRk3Po82Y19dBu8ZGDxCZALH8fSUNN5QeSMc0eKwZ3xQc2
-Hz6ucWc1T9PW2MN9DBE0DSIYKjMMyZqRWhqboFNoxtDDTwaBZax0kvCbJNIUxqONUVD4D6cD+nfSLuai
-4scntdS1LpEz5jc+f862Qei9NHH+hZYrxLo8nK18enAr0AJOUIKD+lb8trK0zsratf3Es6S8ghFerSmb
-5PxD06W3bQ95Cjw25QID3eKtcr29Ret3AVCgMCAsnPhRPeQraWVVtaH9hqHcNEn5GCg1Axn4KXYX9KWM
-Y6Yrgv7GAmH98nGD5bT0IRfU94IiEArNvfjEI9lSumsXzTpkub3xTbFeEU0eG9sfPky22IoattiEUNbS
-I7dUSoKktI/NTZc6koweKutsStS9LV3/usTZEUIoh8LzBC7kEuK4WMWL8h3mXCPgp1xyGPA2Czb5SIAU
-waFQHUnBSlKAy24JUE+CGsioKrhosAWGnEsVtisQVYU6O2S4ocdXcCtAO4tSl8uRI0smlVbVDCH8CI9o
-AkaQPCL6xjWrjMZiNFJ4ROTUFAFL8nQWYEVpcZuyMHciL7zf8+nY2iIzeSIBHpexn0nxW+NVf1aOFeek
-oRIRTsflbqqGUKrsd/rTfXrqplVFWQ48nSCphd8Zjwmrx1Lgp5KUPluBGlSb8mJKL/lpK3Q4bDx+HMpa
-yNytR3XC1Gp41d2AznB61evAAGvMlo2Hms069V5DRNlXrtnLS7RRaw3ZXrpl19aRqff5bK70uawt/Dlv
-L9c/uXd6NzD6LykiB8u0aTBDJ1REqzDdZRim+QqXPmAITO8Uy2lBhI9VdHkaDL6gmfChUoBtMrJ93Mqn
-eq8SzAeQaQFLmRawkmkBC5kWMLlvq0iUT4OaBCQ8w93vdXhAKqzT6dwocsKNUSTkDBFdIq/qbsJj9w82
-nFgJTIqhyo6gGJ9kgSJrba86CSEsZKUezGMiQLiNyTvEs5jvdmSIDp5r6REFEZUYRTaM5b0JtMdHsh2c
-gwiheOIO5eGkM0kiRZ9g1UwtWaBV+zAmsklTSgL9BCkInK9ld4qVAHFHFvjeXBPUxIsuRWhIaKrwmu0N
-lA13RUrQDXZGCjkSwkN/fFeSKaKQ4ZBrlDqhLdE5JVevQcWn1KQusCB57yqiNiWNH7endkd+oTf2LNmU
-Uc6v4FOoo6DyMczelYOm9wg+RWD80B8Yl3zmyWx3Gg6H0Vfx+Vge60yD7OjVA3p2JXvWPeglxyL2+jMy
-LewYEv8zcH5XmsTeesaNCd+Rliay0ooh1920i0TqmmFiijw2w5yYRlvssEHTyixa/Gwb02L1x707+hH0
-s7+rs56VCyJbQzqtiU/dhzKQflKRrjxIV06kKzdScEa02AyzgzN2X7my/xxgSiqmw+G1UzKdy7wia4by
-7uT6n36bYTcqssNOWnKFwjgtwOo8kyrcECXjxBteEwJUIDtSgMoT7zqDYPpRmF9RPp2IQioVcR3pdF+9
-M7InEYsCZC9rzMvFqyZoNQy/5TSIu73WPjqoKW9kuBExH2Ny0/nr7h4G7lOOexzg7lPSMS5/g0E+D6LI
-9kIkcvsjlr/KipnQ9vkgwNxs6TUL0XY2x4p+Z6okB6XIeekrjcI0w3/RJ6MdmSybcydyfU1BtBaB0722
-Y2wtXA/NwCp+7AlA1aHec8Dec61UfhGCnGZYS+F2DpwijKzZtzYuDTTL0WaUYdtmozSZ3lIeuH+EyVY7
-EY/HapjxG39lRXhU91ZMc87+bGC0GJNukfaGexqR6xx3mVj7uMuIa1wTEfduGCESXDAJ2bihipRk+rZ1
-Z1MTIG0kz78Hbk8RV5MwrtWrahuiTGvRpiXoZGeB2fXeHQXN/iazFdk7VY+SaHgLzeAXII35Av/CrxVt
-ikx+W9qELPi0iTgBIMGRH2YlYXzahTiEHPyaKQS0RjvXaqYNsLHGidQRsBnErVt53Cu9VVHLo1nuWrSS
-JKk8qK2ilretNeOj2ihUQ81typrBDTWhobjuWfXEXxuNBJjKxOmSOeY+ib1/POhQAlDpB3iymcl1+2zl
-oYqlyw0rD1as7EiF9OFgFHVfKlst4oF/21EeADGOfDzIhmkoytlPT2xu1psej4koc5Fd9KOy9Mepew9B
-UlYeIfH5VIAAA9wI4vADfHxXNeWqqDiKck2YrvdubjbxKpCDlPbVAdl1z1EHIcYp57GE6Xpg+tGcS5C9
-G88ODO12qD1NfquND9O3m7QHQPlK8HUoo9pPC6V6yp7j8+0j9twnWMR9Hd4ACuDQHdQVILg8RTBicw1g
-DwGR1zVwzxCu9+LF2iVurWJXE22N34Qqtqn9mIusyWze3ynzJQsvuvCDdZMy73sMCtkwp2b/Pno0iEeM
-HhfIEhwOtMq4e+uZtwQEba5+5QhEWH0jnoA921HleP1MEwN8HRIx8Gt729dz2iBfikG+pEqhv1JBG24E
-NHGcNT+DKRC7ai0yCt5qNDRnteagAtvAJGjAa8yCBus3DfhZu8+0mcHQ5vJt3JfR5u9tYwg/zIqIRFPD
-ZJQJVQOUmbIKjMNsYtqQR5qMmj28ir227si4VyjebnJ8NMk5PcLa1CgQGHbZPrtIdpIZ29lhQQSuyXDF
-hgmeXVphnuSVsLNOiWPcx1oBOWeEIIWeTEajDL3NX3AF5IwDhXkjYzkfTGI8nRyt2BTPm894CiMdYHIR
-ugEtwcgNdDPAYM4d2M8AD75ndp9gNHueC8QBixJK4uPLPA3YIkm/kAnBo2xl2SxYAfcoYmBxwRvQ3jwr
-4jyCV3HS3NkWperO0qQf9IH6MIsbeZllixwBpU9jHqTR6ikLMjadDyZOw4lLB2BJLCLECX9xtN/2YRxL
-bVkbDyo3jfr4WJxwp3BOASk6r+Vbtj9kpYqq5lupogGTi0jUs99gcMSzDt3UnxAwMAcvK7MihpkzxrE+
-vqENyIdFZQbTYPZrUOE1OJfjvtPmsN+Nzu9G5+c3OqBu/+V2J5UD7t9kdkAVSy1cpEk8/u43bJmWv7tD
-v1umX88yLf/jTFONEfpVnB/v4Fb2t9XhneWACslxpQWGIvu5uFuraTQGxZ0kjpJgqN1wtX59WYXxQ0wO
-fehml8lzpx/GQzI0DgKzFC9pAjb1IpmpARAHOCRxhA/xYAolis/m6SzJlOtGjGX6Ig1mbKAyQvYj5VGQ
-hyIf3bo6qgRVpS/Yd0j/l5KXnnhSjVeVKHFHWN3dKX8VGU0S0N6vcNp82q3wGPlzccMRpTT0CwsKZjCc
-TvkwBNuNdjIXeft93haHPRdhZicPFBeJDfBavVTUEHs7sySKChMtLkVr0uFJF4qUA0SWn8ThlM6ufU93
-oOEteuldELU86o2NFlybY8WzBavcnKd2wSxcgjVps2mQfYFhn+RL+leGlvzTrHFBCh3pc4mc8lN+DNK4
-uaVd3idnH1TmOCkud6kudhH3priOZLlvR/BQWe+0lbesKIQZ231rxLOhYf7qhtpmkHujPpeXotmd7tk1
-FJddiaR+2SNHbEti2XIPbXnsGUZ/EH1lQ3ESc08rgyiczWBISUE+NsncAhz1TwGzdcBPHIlaVHcHVZlr
-x44DKoiJrhLkw7/NrGNvlQvqPNBmoyqYdVy9iB/U7rI15yUQVX15YMggqDg9aB2gqx1Vch6TIgMcFRV0
-3ki26pkCwNS9AxuYhsMhj8kH5Wm2m4nvEgqeg47TTWt1fSxJ0aYud7N4VSBlEGqeCKUUemYdkXtYZB36
-cJKaaJmUbnxqWqSGTeXYQlbLr30K1SROqKqK0DLkdkImm3jps/GtI9A8P6Slts7zxOoS7PQghHn0R+F/
-ln+/kY4o3k1Btd7FkdODFEblJJvBAu4Sp1LaU1Sxsl0TrT32Rn0dg9GLuzaLFgqikurb7oqOHm8jMql2
-DegKo4snn1aQ3H0zkhultEsOLjWkNs+CjR/b8scbm3OlwWO8T5MuQqrrU/xItBscwJbtrjuBbbEOlHjj
-Po/rrIpmvafqaKbrz1K8j6xpda9GxcYrwZIIF+o3xWK9DrdOYe3AsS9vsEMp4HevknlxnYjcmBYwETg5
-4rI/7aCcRGV/yG+QGDAhmcXzaR8DCEqKMXr4mDnLh+YNKg6Eb5IF+udt7HWVSKshcRPqeoTNxsXFxWzZ
-aJk0ke4NAWU+wVvoBjKHubPOSSr38WW/uo5YOqpVh95lRxb1HBWJNFy/KubLZeOVcpHOhlvGgRDYNJln
-sGbi+UCAFass9VIYwyl4n4Z3mM7y1rimsJiJ1UliFOBFiO6prCBfwDjM8sI29maGhX1dAlgpzwpsQ+/O
-ZSEWxbCchnFTN2xtA7tjTE6ctYt4jUHEpvZisckdFw+83qJGjRWjJPoLE0BaD9ZqF5qJjUbVOC1VWndA
-HItEZ3rTpqtLb1qTtxdq0plu1k7BMS+P6DTwaqsGyEGkNKFA2sWfXf3PPfqz1bBZLPGhHpd5HdVRoM3u
-oClIKrB5ArrWKSM3vHuZq2272BsKd/B1Vx/Z9AYkH5+NqmRqYWzQTtHSEyTblHclyFmXLImw8P3MeXWl
-TJjEzxHyeP3ZkYum50wSFP1RA9lTIV1pcnbaJNn3k2g2CTydiJ+adKAyAnknA453vviivuH/36MCzrxZ
-hwqs67LHqMFvTQW0bY+fQwWcbixugpUX3MfsA967TDtZFIZ3qZBtWetfBeLqUmN3pObNI+p+Gv1e5fyd
-3D117NWtOczjPXj4lSND4qAhoe8Kfg0zD7jc7LFKpm2c/7+xMxRUxKtLLOfiZ7RH7oRf6UGhIwQEXAvL
-4TUdhdHypWLrBsuXjG0k+nvysf+txkrdv1kXzBZ5puY1+vhZTDBntykAyhWIsRnmufkJMVdp5LKOU1AZ
-rFbxbURBB7fUvTeDBWApxCm5fSdAKbzyMCBglGcAxY8V/qC/6JdU+aBTrm3s9Gb10wc5fHEXC+LoQOga
-4srk61pKgo4/4/pBJFGu5hqSytThdTSVB8h/DqowmWsDwooUw1+btuWGxC1/XerABNQQhpN/0EEbPUim
-s4jn3tGksVLtyVPlapTUUFp7bKb46Df9LoJQvHWD9sfp7lZ83MCsi8Y6PAD4hfMZ1o3HGb3wKwWLUlut
-MGyO62A1Vh7aHY47xlDy1JxP4LrppAtlzX11mxbvtvNmJljZ+qdd/6a9t6/km9Tt+NKXDGbRhvs0+MJv
-q7uZm3LftM2uy2rX8n1NDbwXKsF/h8m0cdOWxXgDrwhbyjc4kWpUL3Cr0Mj9OsSQEh5Q1xJPhaZ3xiSk
-jkl0zE6CWwAKVrwSg5AtTJQC6w9QLq/dpjtc6ZJpGfLdZ//colG+RbeXbYmBvoXnntkWjnX4iV/3qjCq
-94SVrfaTJKJm8V0FMJoQZodgxHY9IdEkUbxLrEQBDo8knK40xnK6RI7yA1mTqMZ8vwq1KgTx/rES2QiG
-Yq6KtnjrH14aJyOuMhC219nDi286ewq28p1lNoMli2Xgs9jOVeqLZLayMrjrSp+Y1BCwUllmublrm5UF
-sFK7iIc660PtvxVkA6B0U5t7OCDtl/gRsjdeakxk0tc1sSno1He9lWMgy9NCc06Ntz4xeh2UqvUKsvLo
-VEVbgUqGy7Gc/UV5E9MdL+9FNd/1AypZpukYg6kanHjBi0wklbkYIsG0SMYhbDfFDTxYJwqyTAvYu/Jb
-tES9DbJcYPadwP+4TFCNJYXucfdc2aej2D3eIaQ8owtucY1iWlqx9S5enxnjsgq0nLZz0EZiqlf2j3mQ
-Kqe2XDMnUjAxkCzqkDzRiAW5yktWnijj5f08m7DpPMrDHVzZMvBY8ojv4ItZoVfugmiu5AUCCkqF674U
-2xylaOP51LkjQnlzUMj+wPaW33/fJkh2fMy+bclH6uLkwGroWe+RDXmma6v5dYB438BmkL3nbpa0Pjh/
-c3aZ/QQcPTc06fLPr+j5Ale2+vlTLP77O1GNNhsGPIyazYXYZ9hl33bUC3wQ+uTibA20s0ebUFI8B83Y
-22d4mWZ6p7wgQQPvaeC9fdY5/XhJ96S74bsa/PN9dkFbpphgTo4eDMmuzD2PdwLKRcScyEGivyhPDOz/
-6YJVDNBeNM9P312cnV++vvhw+allNd1cVM1KFC/3jZmhhJ1YsH/cNyeCEnjPAv5WXqWDN/qznWNlQt2k
-9p/26aZWv8DRVMla+NYN6CB4ks1g5l5Cu+DrhjGIzFt3pdTtVXVXdXVxADal1m5LLd2W6rgtFK1mAaAw
-14X+VhMsydhob9PVmuxVyoWVQbAiBkS31SpoQvFSjxEeeXVrCb4/8I2iKRTv3H11/uHtyfvzi+/f2Rqj
-8ExEoHKDvBREfroX+pgATZOUuBWO6uB4l+xCnWfQrSDfbZCtT/Mco+kZiDcuhxPMg7HHbDefaZJ9BhT2
-wzzD8yRiYnTTqJuGZ9AfFHXG3oBVJKhthvdCuus+Qlueg7zxLj1sgFadm1D1/GXNAHLAv9h7IPzzB8J/
-q8CryvmyJ+2E0OEmDKu/fjw5A1NHO8LZ9U1Ls+fgmSiZhRQgxSWruKQWxu8xXW6y2tkxp0YCFe81Znhn
-LbggS3eIFD2XJSJa7GFWJeGceG9r140YHieKPBfbWZBj7VB/LWhqvJnHCxhY4VL81EYfKMA8XNItF0+V
-iRLY30XvYFt9G+SSnrkVl0KY2D5eJIEe5TWgvWGHhyjP/4Vqwiv4do99h/Fdtu96bxZ+6Ipmw++0YIhi
-JPIpnqHYNq9WMglLx/0i6C2cXiLOEV4tPqVooeZ172Zd71pVujdru9mqs3ezpr+1GiTrwiy7Ox4/a0NP
-UpSqLNfc+1GSUImSwvutg81lpFXtyqobykrpwWoif6DMik+97NZGnKpfqlkDO78LcwkOAmnYXn368Bqs
-GhSoJg1Am+E4ToD2NvuM+5gY/ctwJdMXL7UoLpX+ibdss4emDmwZrIRWti0zLZ4yssUg9hlA1ahsxC3M
-Zm5uoeA/jFs0JfM0wqQe5HSfvPXd5Q645vFBH5ahL59TLs8r+tnh8SAZ8ia0oSB3H7doAFpKEkL0lAOE
-dw6Bm4t/MPnHipKFioMkMksIVlb3LYyO/l8AAAD//0EWI0iuhAAA
-`,
-	},
-
-	"/static/include/inflator.js": {
-		local:   "static/include/inflator.js",
-		size:    76880,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+y9fVcbR7I4/D+fYsJzYiQjARKvRsY5xJANGxt8bHyzGy6XO0gjmFia0Z0ZGROb57P/
-6q1fZ0YI29lNdlfnYEsz3dXV1VXVVdXd1Y3hNOkXcZo0hs2P8bBR3E6idBhEHyZpVuR7e3uL6eWvUb9Y
-fPRIXo3TwXQUfQNvpskgGsZJNFhsfuSnK6resNG8i0Z5FBiQXBYhqiYBJj9cCceD5kf+3jg7bw258sf3
-YRZc9QyImzgZpDd+01d7/Nxv8GqUXoajcml+7pfOo9GwXBafMi5Xe8V1nN9drcTJcBQWaRbsBdjLu6Yh
-YZMw5votpkhLKNLLomKaJYEuHESNopW0suZH/SRvpK0pjcI3yVl6zt8K+oZwwz1BNYv+bxpnkUtJeYjU
-+mb66FHYlAZDAPrNWhOfx+pZLM8Q6nAviW6CwyxLs8bi8zBJ0iIA/AcyzsHS4nK6vLi02OwV11l6EwxX
-+ukg2lt8eXLw9sXhxfHJ6cUPJ2+PDxZbwzuEN9pD3Pc+Sr93P97d9bAPZ2vnK/1wNGqMFJO0NN0i7mCy
-RwU752fRuaJX3ki+S3aj5l1r1DI1oxbT7k5KYZPqJWERz0WrIfQZS6d7a730abYyipKr4rqXLi8380aG
-hNdowDB/7OyeaZQFhDfKzY8LS1Ngq7zI4n6x1FtYWED4p39/dXhwcfITsEyg+O1tnBQ7+1kW3gbAdsGS
-5rulZvDo0ULgfexqna2H1ztKivVudTXEUvBfCfM8vkqQtRVTNkD+g9XHwywdd1oB/tfl/9ZbwcrKyuPV
-ZvARWsVu5uk060c51KaGViZZWqTY/ko+ivsRD3+YXU3HUQKDGHSg6SC4uY6BzRpSWcaAgdpgAaoqkl/H
-w6JBlQOQ4KDxDb+BSkE/TYo4mUa94G5Bv1cizoCo/6zVllQ7QcDcjbJwCqVZHqTCcrA0nuZFcBkFSZq0
-VVVuX5oBVgqIlyZBnAQaHwGOSPCzleswP7lJXmXpJMqK28akaUoFAYA+m5zrrsL3nry7W1D/UoPCllC+
-t3CHA7i6Cs8GU8D2cjocRkC1+LeoFYTv03gQJ1fBOBoDbSa3eqTz6yxO3n0/HTqDDZVbVJWxQrzhkQxK
-AMpbvVQYwNseoaWK5tPLEEffLaMfN9YEPteygdPznukcgubOkZ5KcFwGUAwRI1hvomLXwn0Q5QUAz/r0
-z0U6HAKPAfBWgG/ot6I1jUfW11iB5FAhG3shPD+OCqd8wzSgvi1DS027KTVy3JveghlFGKsfQBQuw/67
-oEiDNIMRCrNb7pTLTTFopiB+CrDhP1BLDlpnujFg0ZjYJuufKYTomWn2rrVALf81Bf7kToNI9K+nybsc
-sciBS0AO6c0KFMVJroiS51TAkLnBNWz5jIHKQuhJChShEi3odz4dFb0F1WWQ/v4UgEbBICzCgEedXsJX
-wH2tZ7oO3QaIe9yWUstIB48KWHN5T3pxFp+rorZkQtO/Yp+50AIPCaIGbaK4G0XcwCHkutCRz0SJCgQW
-ToYPsFFiJaEQNKK5BBtUPanqhciEIio+R9FgyXibFF9PNv40vCckOQO7Ik36YbESTiajW7QfBXTT0An1
-42ESXo6i1YM4x/8DJhm1mQcwabeo5wV0C7CB8kZTRoVSPdasmBgNqb4HymxeAbW6E0B5w1s9/31nS97L
-bF4qsN6FAmbadt/zPN1Q9pDSjtzjgEzbaoxmIDMDjbkwEC60qO5TsKEMIbQ57lof785b3c+wqWAsj9Mi
-2g3CwSjKAMEifAdmR6f7LQ3hKHofjYK1IAQj1nm0tYJVj4pgkEZ5slQENwD/GhlwDAAA2iBGPMJRkE6K
-eBz/FtLPIMxxTk+z+Ar09IiAvBmDAqcJK4jzYJJFMOUiV60sLGgeEewa9H8roKlVaUnLaOoAfblM8ChY
-+zCETzP4tNYSYcuR/g0p8OzZs6Cz1awsKDrUWFSoHNHWWVPciXhHRTCCrhXB/x8UN2CUAUY5yOB1mASb
-m5tg2gE13kXRRFWA5qHv6532ZYyjfBn1QxyMmwjpCtWRCUA0gI/zFVUHiVVcA7r9EIp+uwd+GxBrGMYj
-LoKYInLPgu7a2lrwHf+3i896ekJog3aWyWOQatXD1IJ/l5GeZ0DJ5eVzpELPIRf8uwxlzYs7RZR2G1X8
-gsAC3Lag1x0uBLXsB7ahhU1+IrhPn+IIMGQoseA6vtC4DLvm8PUv4XCgcz9EVr2CgUNax8MYHsD3SQRk
-v0xTsEuB1BFzZRrcZDHMsOiiEV/HCfJxxJzaBvMVefUqSoBbC6hfIM/mWBWFhTVzkaajHHT8JAIXIenH
-YHCz/nwLKLq2CppyCTDBVOafMckhNGLwMuKAL0+xvYZhfpiOCAUg29k5DYuefYBJYPpJgqdBd3MLvpgJ
-qB8Qazhz1Tsq/Q5K78B/znxM4tN/BMzwXdBY+3B48P3Oznp3LfifoNFngYLR3LV+2KYaYXeW4PzW93mC
-3hETAHGeZxGaNtwb6O0oDQcrwV/RbehubioxGaXJVb6CYxuEMBIpFB6v0CTez/qnQgmLUDhzaQJCEdAm
-fZzDa3QJmjQKkFILMIrwlMwLFjB4DmW4JBKh3Wm6lI+5PEz6QE6o7sz+XBWxIHLtNAFEcdZgWCiS8Tmr
-px9+OC8JkWkRzH8gGkKoEyPqrRaijc8TojcRPiCbMwKrBN3WgOM30cqvORH++/0DaG0duIc/q4+DKxoe
-slIj9AODdhu6MA5BryFLI7vHIzTFQCYfr2oPH8B0uj0NJQbpDWM0JtiwAAkJWImCzIym5JWNwrxoAzpX
-+AZhLaw+RjIfRCTBI5DlLGTjGgzCFknpIIYeodRhkZwesdCn0wIUeSQ2IhoxqEoZBBUbh0X/Ori8BRtH
-+hDFpKcx5hMl6fTqGnCbABjAF6Dht5gs5vA9KG/iKgCEPNFOh+3LUQoODEx/oCVSgAekGbSwqkM79z1N
-AD9fg34fhdlV5LaKOEqz7MRC74Dc+RTsuhi1VaoGr9Eka22B7JFwPCHEQC//JJDEByalFmxt/OSCbQXj
-FODSpPdk81u0RYEMCEzAA9CoPyWhAzuApnjQt6B1gQMwChhkAA/jljSRHCYFKsQ8n44nWCffXdAOPTHf
-yhgHE53nF4fH1qtsvEKEvQCwz2DiqXqFgwrvups7NsyMHrrFvDaR1VAdEi4nichgy8YIOpImwDdDC2HA
-kNgdSINNYyiYh0gomE/CPk4D6rHmDKa8hkMCQXIT9q9h7FANATDmGeTclhlLHljgD5ibCvDJPhRcTgND
-GQVYwlCJQEEmI8RxqlREbwenIALj8EM8no4VNyApQDfAfAnjJMK0quVoEsbEpp1NLknSyvzAtghGJxhl
-t4R6BwhnYcuv7wqqUsfIkp31moIEaAWIhzxWpEUIE/HGjmiNFGM6H1h8VwTaKeqjITIz+CFlhmoheGRZ
-1FokiJa0AdEpOqT91qj/Tikrb1TFeBqgVoIiK1WkZrUCMoW6Uxy6Skr3mbVYuViMTQBa2BQoKey/BVwI
-TdCx/iUTFWZYxUUXQ1Cljab2tWmKyKslibqIfAnzcTrRhHBIIIBsrgeig4YuTVR6fnYwwYZbLKqWrY+y
-15MfMEhqznE+MHUAe4PKJtyZ/tAuV8IJo6oWVLoO33v6lEctRhsK6xkoSIsaKFbT0ndd6zK6qkNYi/IS
-ukow6dXBIHOiGgZji6NEVkdL9YU1juFIgLW6+v/FQ5jRg6PjH17snx5evDl9ffT8VNoYANfU9E7xk+ZI
-sgh+G8WXMLOHA5gyGDq0Hw8F3A2FJatRpnUnNq6BpX6LshQFEafTKYqAKqC7f4ODVA3rfTiKByJFNM1E
-5dqoGmdiwoYAfI8+cDUx2/83v+AS/wsv8wK6GvyvPGgp5k9hDMFyAlDFNZXKKE4f8jSIToHiYoHlYwJo
-gFMM/IOeRT7iuDMXbSFZiJDgkYJrrrt0nY4qGcLlRCxl8SGow/vrkIY1coPqopp0Vp0RWiqDyFQbzFUN
-ucmtNxqH+bvqeviGFNAwzkAmOTIBM6M1z1h4Dx4OyLMPDalhEqghG0y7WQz1xSEEyUOLRtdMJ5UyizWp
-2zI/TdCpBGZp8Txmpi0CNOsDgBQHg+MRMxB5IPNKSqsXFoWjWu3JZq4ymaeJzPyFQ9e4Vo9ydU1FXQVV
-RU2VG5pgFY4MgDSLU/mCF3XQp5PAOWrplswx5BD99Q0amn108p1YC+l2dN5TspNyhEDUh+bYv4HGmSMB
-cExePTfOb8VW1PPP6iphvCfWILEGPEa7QYqiqsEZCkOcNJnsWfMRPqUZZY+qLAcN1/ZoB5vkRdOcawNM
-ua7MCnv2HIHPYYZBkFirjTDRzm17czgBZqeWCrqNc9Xu5jYUq58lcIbQnafpwlb6rKfU6xu1KkW62zwm
-TY6PyV7Vj0lFI91FSZo3rDPhFWkzTXpUgNj1mIwJY7sTjUm81FPRTvhi4LxQ+oeqkFoAD72DkSpdESE2
-gTIU2BpUlEEYViFhsIHjh7Kz6Rl12pW0ncLU9ilZ+JmLyDE03iXP7ej3BsDBk90FK9hHC4rsxHQ2TTyH
-qLe8x/DOgNuWl8+xG4pm+KFqUGan9/mVZN1F5IRG4ozAPGIan+vgJBBk10Sjej1c+QRZ/ksKMhmNcckL
-9JmyJycAjGBi7KO7sfqYgnfY9uNVB1t4v4ea18aubT8hUA0Ny4SFBWY6MRBplWJCLihGg2dqYhUxsLT2
-6uppBgP1/n0DZA2sJPCgqQmwWdCkXvvQXcMVVP3sKTzaHgbfWQDUt0UxFXd1k6q9pW/7S/+dLAa7D6q1
-9uHbte4HqGdQaupFNaVpzlAv4JAL7VX4XCgFdQyp7lTcjLcHEd0eEXWryYYU46n7EqPdFtV4WfPeBmkk
-H+0Bk1fPsrqZZDq+BAuVdmep+dVuj5G1txM4QuS/mk8q+FMSKJtSqq/wXgSkwZoFW2zLLg+nTY+3dQtt
-9+ndLAasYAkehm+nxA24lmu1XKNNHkKGSiJ8QWXTPVEyA1vJDGwlg59BiirXSIerba6qtA1+5tI4Dx2Z
-ebWPx4n3iBLNOMru8qWJSBCTzTGHSAmKM8VqDpFSiNeK0EOEqIaD5mjjYa3MaOduof4XEXeGDNebU24/
-CM4zsrHKPSFTbZyjmbcUJ+zz6iEHJzMYooMX9t8t+ajbkVQMCJZom0UhbqeZ9Lw+WpadR88KZq9j9zlV
-kO6KKCH83WyW2FLbuFUhFQ5TGFCxDpdVMKYQm5imAlAe0RxGfgI5JHZIoYQUAWtXuHuuXGLQP6mFJHL+
-jO3lKl6mzVc0mnmYVJb4Ej7h2rN5BT81/IIfXtFrfNPkQKxe5xjwzhFc7eaYEUgDOB3g5WL5QYrrtFHC
-YdOC9PMlL5b20/EE9PJlDFbLbVmQ9l+8OPn54uj4v/ZfHB1cHBy9Od0/fn54cXpy8sP+64v9169fLxhT
-yKYizrpPkVVh1DS5y0XFqK56UWEhrfUqS1or6LxNqqqQ2n3JdC2XuKt4Jov9phNVFWs7MB/6FvIWb1aV
-9CzlyjZJkrQMUyjhi6kuDxA0PPidh6BCIfqzge7lGgUmRNopslhR7sLs0b0wjq79QbqKq1x2QjD8GbGc
-jHGhu2Lq1xjBDHUjuxmqZFfG7ynZf1YjqAzTcTRbC+JHs2OV0rB2orif0ngqOpgRLVdyuLJZVaKC0yRg
-B5qaXlZPDaa2GRkTZvGwuIcPtCPEw/fUnmww9pWFkyDM0imuQ9fS1R26ZYmaVA8haoK9QEdS3M9c4yuL
-jX+2YV6remdTXvXZ6SyHye7prszwNUS9lyAzSPKZRJmHLDX8j5+5ZWBeKSjz/ZySUe/KkCa+mqbTfJa9
-ZMnGDJn4j1pza3+5WrM2SYJbXGWFljpvNVzf/39kLRnU9dlunrYVv14nlfGJ++UqWefzwM4eM/eXtcfZ
-fGr1BX7UgskgzqL+PXyGElHulYZD+yrNvgTarZBFlUbLvZbeP75CLdv4QlFlxM3LR/ch8XAWug/i/Nxj
-f9fmDUentjaaFRYqDHmXlj5wjdVZYPWH3AnlNZxIVdMOVeG6VXWkpel1TRv0HACsi8BWyMNMJxoR9Jzn
-2W5zpcN8Z4f4qAS5C0nE2/U4IBlxdPJ9HAaLqjeLpTBoKfA+a0BKg2Itn9tD4izffPFwWEOh96rXrRis
-d2esGDiLZbMXWspBJnsr3X8ni3Z8yRlB3IJnXpWGz0H7owWizDSy2LJqEXmpplGHbSraXPhcVqFzREpB
-XZhNTY8esbqnTUO8k5l3NOCOY2fxv4GHrnFnQytQmyNbYD2RcUaxm6vUCS01eWR4GYdqPHvGSpPWuPfU
-eQUVMaRozFMuwbyklljthVVCbzoZYF95cR4XVQVfWbI36++8wt5znvKiulpLd1fe9xzafBdsImPT9zYC
-wo3um7i2zqv0+ILZx1tCRzCaqHhAY3MbAeGPNrWMgPBhWwq2ifoCSq1rBxxMNg9lkVsFrdXJyDu9zXvz
-c09YT4t4hKClUmNpZWWVnq1yTAFP69IZejkxZJdclYeqDO08DwK3DD1UJeydfm4xeIMP/YLqkINbsgCT
-Iafz11j2+cnB4Rv2AvHni8Nj/NXhXxgUxJ+4Ix7459X0chT3UR+hNkeifvYHN7w9/oL6VQAJx/3RKL0B
-4RuOpvk1bm+bRnmPItKDSG38RdY324AvoxEmGEgxhUIR4oDSbjzs/y8XxycXP7x4++ZH1h8S5+NXr/Zf
-nx7tv5D3RDP16s3fj5+bekQ/9eqHty9e2K/WmdLw4uj4SLWDLzbUi+9fnDz/KTAvNtWL09eHMHTmxVaP
-SfCapZo3YqndvhgMBkcnBx5fxf0V+pfeSYonRKKrsIjfR0K3heAxbYWnHdB5S3ZJ6df0jjQdNkI7iMJR
-cAlymaTZGL7CFMkHtOS4wi+YjMD6aK77BVd1DvdfXhweH/CLjnpxfHiIwennp0GJlIevXx+fWMDaHR/a
-69cnr+lNV7052D/dV8/pjab/y8OX5gW+MQPw9gf3zabB4b8OX785OjmW9/Bui0UF9ygLw9mkD8ZRcZ0O
-DEEODikYf4Bwd2T83pzCkzdfRTh+HzFD1OHz4yEdYulYZ1i80yfj8Ar0hdnjKhWhy39htVJbkcmEgorn
-VGAWvfotnjQtGKdHLw9JfOobB8MVj62ZAxUlICdvSNA0D5WB8AIt40AHRXi7IbzNb/MiGpdhHv4NDzOg
-mM6GKVZNRf3T1/skzbPri3XhVz/eJ7ps19NFDLkhmjRJWEWW5ycvXx4enxJLzoSBsxweUClB+PH56+dQ
-/Uk9FsIUeDarVBvF/YhYa62m/UFMKotWuXD7PGskDwQC6Bgq2vVF+7+JigMNCaYCTBViASE++0pHrDyQ
-BycIdN3qXg5D0SLtmb+LJ9IttFA/xIU+IQScjMkDtAVvQX1zevL6kIi2UUO0vEgz3CONsfCGsB/yNOqn
-UYQD2fRgPj959feLwOxmQJirKeOK55PxPc+fjHmajG5lYzCfYEoqACI8m7kRoDs27lkwtcFV0K/q++n+
-9y9olLZ7NYM0uAVWB13EtdkwYhLkHiyQXmUEEfeXYfHmRMvzqwaElpWC9KRXBUj2NIKfUz5iVwNUMMRB
-6a45LKnGBLXPQ4ZEICLAGZrcoLoapZfa4S3DAfWFoLo1PGifXdL7XjwoaHUijPVakSvFQiogCCIb1WPo
-Hn6qw+Tl/ulzNO26hv89ZnV2lBpOzfB9BX2OCKcti873wOOjFdbmSKWifzx8jomdurUcv97Fw/M16hEG
-6i+n1LM6DS/V62apg5NjFLjuEzNGwziJczx0R022cGdCNMcZ1iD4SodhcbwOXyKgjg8oscz9cTROYdp4
-AFS05hGsNQWM0lSfWstvk/51liaSuMEcHcB1BVwkM23zUduvbo8RoofHJ2//8uOFaJydTTF55bHy5Daf
-aAOa3wSYHMyqu2xXUE7iy/2/Xfz8/RFB6KD1+xio8VPw4pftbWv5hzzGwx90SV3LOU/+y5uf91+tdxv/
-x/FXCUEEjUbj/2SvoNrT1wyWrTCfer+jXq+tlQuYN0+fYsnq9/QW2mnyIXCN2pFYBQWPFqKH525VkGnN
-3aCEgf1plvEBXZ6exzoOSPXksMIwHOWRuyJQZFMK2k2ytI+egVgMgRWaIwi0zF1qGSGgcK4xHGRC3BhE
-AaagYx6i0FrAcPcJGk4eShY6llkFmL2PB9HAqs9WcBUdqCGx6Mqm+xqCRgSbFjA5DFHRM/tcnLMlreFu
-AbShsZarQg2zwUV9PBtGqpkSADkaUSDQudc5IYiSpuPl+sDb6cnByS4gfIvHRD/eaaLjqbe9IJmORj0X
-rj6+k+MJD5uEwE7oQBNP8ikFXHB1jrbZPCLxrTLmo/SKN7R2A8lJCBopGjgHCC04cgClDOdBBw4Zlpxa
-KcO658Ah15bDLbWYlA4ccjV19MUl9n0HBDEUzHy+oCUr7PenFBlW58h4LDm6WMGz+sR3Tc3qIXL3AlMZ
-IMlinCwabNhwJ3sCRcoxgpEfxdBQGkcltXNbss5XGBsMD+3h/OocciMomEsrKg9AaT+mZ++os2cGcbaz
-2ccYmJPcVlNsflUNtWWY+SM0jD9ErGGUWc+ZZ6wDaIoacmLJEz8UJ5yaERfG0bVxiVLWQUbWWOq8ZQWD
-VUCrOhCpsKpiB+IiZGh9SN85F6pRqKhbrmqfDWWaKUoxgpfTeOSNhCJVeSQMi87yfBQYlVZvBqffA0H2
-289CpNZVsqa6+7BwqoLcwdf87NxGhLWQr7oFDr40x8PZYI8l6spgrAHHEUPHnRYHOPVZY727hvloYPaN
-xpM0w0kXpTu8inwv0+7ZTZq9qwDW3dlhYPQ+zKLQACkPObEEmkbWYU/eSEyEU+c9sT+/YnIECvNqflS+
-Kss0bWsYUQJJ8D5VBpU8NTBprY3oAoVkuuRZmyTD6wyYhmyCNi0rnsnr9siTKWDw2sFymb9W1hvWQdim
-J3Z14Cuhe7JfBxc3oldwqZbpIdtpLZq/cBtszY50a54Jq8wgDZH0A5VBKUSDc5qICRoN7HCExW9h1cRl
-cJS0C2ZO4XPIAODOsqzFQH6NntVPUTShw7OlDBULKq8uvAw+fQq+MceHrbyubnifE7pWnzemX2TYWUeM
-+bc6Iozrg8b0W3AXopdQon65OH774gURxBwhQLvcLLSjDKeUMChFd280amNqyCjGo+1/BR1B6R3hPRot
-suzOK5+UXU8f1UVb/1EgSeC8BW6MtZsVTXEs1qyFT2PbW0/FyF7vbm/tWGWNSWq6V1pDXSstoNKT1VXn
-WHDgnwbWD5wDyiQHFv5eZSO+lZqAHFNrlbfUliWg1QC0N6tAiOh1rE6y6LQ73EveEVG1IYLCA3oHhGbK
-k596tSz/j2J3fXLcHT9jjZtH2sQ2fagWUlwlr+lWV/LNsDH9PW03UH1Ebu6V+ou+YsT5wrgPWq6+EhUW
-jO2ISUVQpMTvsTerIqrBJMxCcFajzCBhvX1qElhqJ5x/mTLALeaXEltrY4tUtME+exZsNIPlQHI/lhrd
-2DHb0qwXfKLQ7EhRPiEmrtIGjUpdQUsRQ9wYqM5PoMGE83Kk/FWv3UePPDR2cCRstPH4qpcB1xsS6b6l
-I7lxzAWKqgYbkRekT/C5zziBk7VAzbdWhyt2sOSRzj9H+9HV7GUp1T3NjTYCdvM1UqAkoEIAjmDim8X/
-AK3M/prR7+FsVLJ6GmINXZ6AafblUys3kYKGefIosslWnhIRVItudItFZVUPF+1246aaBjW9KH6fUtQe
-tlaMRiqVmrYGwB5fdw7KyPG8R8v0hJZY+BvC++Qnw0FWu2X4dwtWVuuidmgtje3yhT3uOtgpgUS0qWXz
-BSNASY2slTZtuWlPWCxZ3pGCLhxq75wEu0gXxNN1nOcVzDyH2ytGt5IVkFrMpawAVDkn6eHK9cpCcDQM
-vn979OLgh6O/HR7QuUK+iUGytakETXaiQfYZOCeawKUMdfZyUrGU07IpAjJbyrBgmtu17EpQboHTw4VD
-0L8q9RzfJMClOdY0lK0rmO3nNggv0Wrr/qRytsXJAmj6/nWYiMsUYpSjGPmJFAH+j+lN9B5TMFokyK/T
-KVg6GMW6hE7pjSy4pzrEeAMm9pb9A7nKOcuaBuNPOHqCoDWI7+PsCvHCOCBDFnjtPBxGZisMlwMOxdCr
-RNmBUWC4+PgufKF9ij+Xs/Cg1P/1De0hxJTJqvU8wgmtiCx+psHn1w01oUqMCwdWOOYaXLZxmCg46HXQ
-UNFieKPUkQA70jTTCPfEuTnjdixn8LlHFWbZZkdt85bOVpRZ76qUySZ8pfaCFionG0n8rXWMSd3rAc+e
-Bp2NDdS02sjMz+A5b+ve6clWVKdCd3OrrsKT6go7a3UVtmsq7MxEic0CewNfA63flmU6AzOutRBQS7NM
-gE9Et6bZu1bwEae43eDJnUVD4zfORz3eS1yF6WYNpmRmt3xM17stM9CtGkw3HUxT1kO/YiLjVOfDCozY
-8AKGsgx8r4LJ4vgbMuU/qfQilMQ576TGptHtb9n6sGLWpOApJyd6ZGx6NxKlnte7MCtxqBs1RhFhCknM
-m7kgapLV+ZE23TBDPKcw+oAxsNuoaAV9TvEcF0pNUv5UgM5adwHXEWDe17Ye6KERyukt6gm6YCDNuIws
-W1xjmCaC3wqnwZTCt0jxBb2UhdB5BwF2T/b7FNauNpgCo3BM0Fg/RIlOuwqI44xTMDagqBhXChhzdzS+
-3m4eikhLj8zjBQSEua0xle9C8IrWpkgBu3l7Kbtvxil2cYHSTt2r9L0sbGG6UExmvhCEg/dhUoRXkdLx
-RF09qAjHrPkAHv10ImmBaaJyFhRgGpmkKuutk/gZcwdTAJ+aQNVqUlnRLGhNktzkgmpT8tjDP7JgIs+R
-Vi3M20037VDYhXCDuSDGHT2ciRg3BsMcu0BbEnVf1UKVSriOYlaaeCU0lGYwx9PA9HF4ZUOnnmXYJuf+
-qwSkmC2csmni+oAxitVJEm0VV3pvMRnxwFgYPCS+oq0EwtYtbWtaUUF/GankheyJF+L5GeIrWxnJbkxu
-kwpPucKjrnRb7Klsp2E11rS9GZNIr/3sRq2u0c0Iiq9lmUx4rR9neJdNVuoqAXrm5I5THWU01OUsDlFa
-apgo252u2XJ/rDXnpoaXus7zhiWsb1O+7eevc9KLGN6xquND4wijU/LbOBr3J7cNRUimzbImLMJW3cTa
-kh+l9xDymHotG2UBQuRv7wXmoJQaFIN+DZ5uA1RFnVp5EGr875qu7I6WIdo9Q6ao6pzOsWEt27105Exn
-WXCZsMQ3+gSQVZeQeVpTk16qdp0bybRvtlbpx4kyopMANdmQvVyYxrde5Ver7gQjtWoS4uplIV4NPjo+
-OPybycU5qU5+rCtJS14t7DyaecOitOzgZ8u28tg/niPRLS5WS8L6x3PkuFWr01XVLuKkVZXdGSNUOHh6
-dXQelIlXa+ikQ12yDE5bzM3tAqV0rQ9MnspQZqRQNSltq9ZErA1Brn/vZ7ZFABccpqOv6US+vA9H5PVN
-wnfQu0V8tgiw2KAEqRi4S0kUk7knPzc4hYhQJR5YTfCgr4gHffHwwGf34HFPclzltgm5ccrOwPII6XIf
-5Cx4M8jSicENgzM1wDJzvsWi6SXdKejNvBtNtb1ReFZvi7L2n6sr4mS7jco+jGaAEniihlmnJcYASHQ4
-BQNAqmCaDWhFBwkfZeOp5LH2V5vFmznrbLWCzjb87ZBbBP/CjyctTKHfgd+b8F+nFWzAf+BCrcN/8Aff
-OvgEM/+fc8rUuvi5+SlC9umT6F4uLmneKTBrH+fj65vmCPfWBOFt3a4vocBd70arW4dFD05Aqyt1Yba+
-085GZPB2ux28ONk/ABMevsKjycPS+6LyDPxrK/CFXqGaJ/Gwni8tQukjl3Ol1aWeYJd4MVJl8pWlSFLx
-Ov7Jy0mEzPBiFEHR3cp0iyYbI5tj4I+CHrNIry/QwTAxLiLulmdfCpHbN3YRqIph0i/5HK38vNMWDgJB
-kUCJaHS2tDEiUQWVCHPLbgfxYNqq09Z8fFd3vGdnrITf7bbBw81PiGNYmaCwlJjQQXl11SJJw1mL7TYp
-wSwNMaL3YeeyM1S5cGw1Yh2mlgVPtSFx9TFfoLT2oiXhdTTT7KSRTLfnr593G1bdFjVrHbJGBXe2Rslk
-+cQ47mT1XnfwdUNlGTRbZf1T0wo7Rs1tlS556tq2pKGTh/PR8dEpjXXTGxInqZC9elxFdo/X6HDYPazG
-FSr3o9Lq3wQzbXj3K5gxNqvgZYbHpyvkbloxJrtp0rUul+CFb5+4aSasbHdVjYdyJNVikkajYY1ic/Ux
-kXEH+EJtXNbDCL+awbfBeqdZe1y+n2aUW0TNaYjE/Cflq3posFvT2G0gdrzaok4t1qE0Td4l6U1ScfLx
-89HiieDg9ckrwQZnA81EJrPmhqqoDsjrBwRB1+B9arUdXTbawlKVzMvVulKtKs7K0cCpR6zy9YPKe32s
-rbefTzpnMwjFOiwcZ63qBQ4fp27eh4otLEqvdFYfq2so6/Se0wU9CF26mFHO/+06uSTqVI6vcHx14yob
-iz40JZK22a2fwYzQ/kkmMVc1KoNEIdmwX6vDEP88mTaSZTCK1tbW7sNBGJIr5VHxNTCYMR8UbCg2LJVM
-an+e7qx13e78iWb7Cjb7Yvlzzcqjl0a8H1OIm1Ja0QVMIngkpViwRkhxlbBOSNe7f1AhnY/rcKncFeHP
-YrSNCkb7ynxGxbpuMZOb3Su37pYz570ezLcbFXz7+3PtyZu5ePbkX2pamYtjP6hpxzFu/WGlomluMQGw
-1H9U6ZcxJWWdmIsvqWQ5CmCReaOKzDXsO5uBH8jCZSaem43vueqi2uPUp6VsHTuL1x0Wpk2mF3LdigPg
-rgJUPRvPzcj3svLczPy57FwmpEfirxQSKPlN96oedY6svOHPhueJzOnr/TlFBko+RGQoyL3ncJnLXrxg
-G0jyfFX+2pOH8iqii0CZPZVbW8mm7RqEFNBv/FrlDJlysaO6eZ2vbudkOxlu1EveR0mM65LOweYiyjw4
-VSMX3QT7CK9RhbyXFNRNsumtlN7TVst7z2uA3kNaNC53XtIBxRFwNG5JicdxwXtBtjY317d4Balcja7A
-p2NQtE1xMKDLBvEiR1wFJ4nzKtF6rg/oMd13FAjzlMiE/n2bGOC76pe7DPaxny4WqjhPPGJ7S9f2sC3z
-dfBlapkbAO7H+buamjM6uOsulePnrlJEZqndObSgrA/z6rq14l9ukia5trvUjh+1au4/d2agUr2qGYTL
-zpxCXT1nHQau0n+YKWou9YcFZ2q/HZeyD5rzRfVZU4GXFlwfre/icm+HRcxmGdZ4xj4QbvOS9eLhgTzS
-uQtZdPX5RzroiNvorU3BE0Ba7TSSpCGO5Lh6GBcKEJNHjzzhckYPLCRWFKuPLe7GLFzI3I9Xm9Ucqksh
-J72h09YruDD9/DrMnsNoNnwNaejrZFcGHIneTwO5ieSBhsoXyYvrqpR5vlpSTPLn+9l+fnOBaGmOl9hA
-5pIdyZA2l/hI2VkS1Fn7PSXozy8gmGfufgFR2ei+soyUu/NvISKKmrOkRB1OBddlLlHAgjNnkkoP/1/L
-9SS0EDKG3W3WMCnCfY4yAXBr18o4zmnX0lLZqqjOpe7Ev39vz20uFrvGfux5qxLgsT5xw+tVa7N8bMZt
-c8aC1Fpw3zp8ab+DDsJ4K0a8OPWvFI2eQTeVrMuJRPwO4Ssk6lwaBAuWNYg5/e4vzfKq8etDyo6pdxBZ
-/bZymzsXupRSk6sNOn7dmI+df6iu62z1cZjZzVRuv/LylVsd0b/0niydHvkBwvDQ1VlnFXbmMg0UtAeH
-c3DzwVLOYv3pU2A/pAzWM3n/3vbAMyixAyWYLzHB938/PWSGdXYO2HsHiPKPgm1PhNpVb9zdBL59iOkZ
-LShz7Jdan6E5/rCKQ1Hb3UnRUTspOhZLefs3OnX7NzqO+mhbDxTFVeuy2c1qeF013IWGDdGIWdbMVRZV
-H0ri5CaYNSM9a4dE4NT7NscbWK2qLnvI/QiLfDtCczHYDRbrr9LglL41bESd6tzbKT7jWepT+Whob+7+
-mkPJ+e/QacxuW06xE9H+WSuVFn98LaMUiu3XuHzX9aXf5r1uyaprew99qQ9K0ldhYJXHrXvvuLkJix/A
-jXZKtN9jfCjX8iyeXN91J0JvR5NkYL6dzLOhqWbzV7dOeXR95dH1lUcJa8KZJW3Xac2fLzibLMZ6MQQV
-XOLdk6Ht89bPI3WziIvUv4It6W4eJG+GHR13Y8D/VLk6Vdzi6GTZPv+lG+GcZT+NpxmT2fKl9b1z8b+u
-7GImMdvf03SmHO29GqvrCw0sgr1b1+qc0a9Xf1cQZiyLVS11PXyhDEqimW6V5JNTFSXnERXWA2rxg8I2
-fJiQFx8cZ8Jbg+LjBzraY6q10M1o1puRDwn80MmGUlk8vVAqOnu5ocY+nVMQomTgbQyd05WmqaRul8zG
-jF0yG39Q7cc9T/ytxR29tXiTtxZ3N7drzOLNuplt059LNqsnEUFBTsvOwKHzO2MgqRVmbLDeqMHgC7Z2
-0yX3Cd5y/+qnX45eXXz/9i8XP5+8/mn/9cnb44OSo5jIlZM7W+iZ2qR7FqzX7kLFtIvjMLnVSQ+thI/5
-7fgyHX3WFOVdkH6/+PGZPk4YVNqbrcIitUsYcs/FXEpcyu660mg18tQe9RkR3HVL883wcf+wwVutRvMz
-Oup3ZmgA4M9dft9u9lZXS932mH29PhqxXg5DrNdNHHczhqbzpBx/ndWFtbKS03mIVJYhlS3okZ2ZaCXo
-qiuB0D6KE3RA6HY1XC7iLM4rGqZwTGWaSLuEnyzSjrUBZm84DABmOa5TOblYfauvIudkzy8jlte2XhvF
-g6DwQNLUOOXuVG0naZnkwqGL9Uq5cDpPWi4+pXw42F6zDi18KQcKLWUGzd9nRzvHT79ox/z9eslp6+GK
-Sd2bM6d5yYXnUE28EcVS8jbNvIOU/JGD3s54nbn3tToDxFe3nvdkmnPfOUuj+gQ4GrPYCu+E7vlF6Pr8
-hi5Rtac60EfHFSzj0XgKtaGbbQZP9+R+0I/qVlRbn7KSevX2xQv0gksR03+yfi5bza6BrwlSXhT0tC9d
-xStDVxsV0lSrig5VvixHiSyt6+hbGTM6cV/Rm9L1yk73kPZ+B0tTru4i2p3ezq4ksDqA793X7gxdcQH3
-A7mAyFriBE3sebhBk97nCH9PoD9z87N5B//e4b+HAapYwF+wKi9W8acyaGauYuK8CUs+UWat/BK67upv
-mWD2xlGPT1Gtla4HJ/d63brBmuLvyuLxOe3+KGxtHLYmEltF4sob1l1x2X6AuKzfIy7r/xEXB6OvLC7q
-Jok5GK/a1K7oRMncdpFfr0Z6/cGMNzePbd/DY9v/4TEHo38gj3U6DpNtDzWT+YPm9WJ7Nvbb1VhvP4TJ
-vKnE22A929bFz+dMMrOnmNIEY6MrbItIttvVW/gqbSPrnLoL0uxrM17CdZgMRnJbNaNDGau47YoMEDo1
-DfTFsYMNSN4yITfettNhW128NEAhjArM20gDkCYqJ67bBvWqu7l1XpU1oMZNA1Yax3x2wm71c1w20xPO
-+GvfntLGUwnotQ1S8s8lkTIlvhSHUyJb+N2Mglz+Ta5G4wnl8AIbFDOh4kYZzNHI2UQjFQugUVAXz69c
-G0h0SwZlQaXiciGhvl6+hUMHrj3nqOQElZhUWhB4vFrjJD/5Yt+dM+56rrsRrQe58L9P8MSKhVxw4nAJ
-DhBofjRHEMEDVx1qeVikwc3U/NnBBgd3Kx3vlh8eUml8V1C5NKw4UvMzgkR1N4v0ZjCUQm4mR1VkRi7z
-U2InmFSo/Dm4yxqhevaqvCPmofxl0uZ+5TDWUkUYi+NXSzVrW7RVxcL+K668IujKhdd5j/l6h3yJQ8D2
-2OJt+MMCf3Q3d/4N9koqQcREyJISFNEsheSdHHP8qck0x5+6fHP8qc06x5+a3HMK46oMdPypy0Mnb6uy
-0fGnMiedTS/TfG3yPssUEzj2pUn8qdn25G7B0PeUydOK8OtnBF8x9opiMTv6em/sdd7I6xxxVx1/YIdt
-Rsz1nojrP2WRu26RSfcKiIRXB+nvSABArmTq6kSjQaVzJslH1ct04r0yNHbjpPMH7TWYZc9xxY/esCRs
-1TDoLivcmsReagEbH65YBVagwONVPLqgqzbPSyH6f3y83+7Iv0Hs33PD9SjVL7FqAnlS0K58Vb3lm3TZ
-ckWFms2LNTGOGdGN2rhG1cYLhU6psL/5zRMnW6grjlGwtWSZS9QN5MJne5S4jTJlqmdPKV5inew2G+P8
-1cJAX0289G1/6b+TxWD3QbXWPny71v2Ae/B08/V7i49O7zELHdXmbbOsIEIZLbkpgzx2Z+VzxpRZu2Fq
-LiS3Nh7mkpFh+6V7KFXWBoNGZ7PORj7823xne7ho+ThHKReFF061S1kWlp4E6I15MTOE+kffd4Ljt6x3
-rjp2kJCprU9f2I/dhLOOPnJr1yjKSkoqvVT5crayrKjie2YzxczZfFuz2Vac5jCv2XPq8Ck66HNxKRbc
-ndNsVQ5vpd2qXGYxXJ1B0+/+iXbrvLsF/myW6x/YXNX88h979T/26r+7vfpQK8e5dP1L7Zt0OMwpjOuL
-sWv+KASb9QYQzhfzWkBS9j8mUFBnAsm4/MuaQLSBHfevHx1TOma8dOPo+WmJIYQOasEXc3vPLR+4f32I
-t/2En7eoWLNRfaa9pht3LDbuRU10/eX+6fMf5xIbKmkLDQdf72VfWeenoHXbCVpXEFpOJ+keqfvbrGvF
-7WOazlknAdKuSNzirNvfyDknTxr5JV5ZP88y/n3jPHusS+OtxjxofNPkCyh5DRxvEI5zXA/Cq4s5UTww
-bhiPiryF5Qcp3t8XJXzfU0Hmz2VMtzxiDvGwiC9j8Exvyzy//+LFyc8XR8f/tf/i6IDu7t8/fn54cXpy
-8sP+64v9169fL5jIQBAQ+5WZb6WvKCB+uF1HXdlm0d0tUBobk36t6hgbk6iqds2BNLu0e47LfuP4fZUl
-KNeT/UAtTJzBH2+iWHNr6GxH7bYk0fMxdyNFSoxKy1BeL4RrPO3g7811+Z3yHzk87Q2Mu1gUsLT5lwjW
-5c4r7cRya9MaTH3th3OA3YzcGWZaIw3hGxuMYTDz4yga+7a0qsb8ZSjp8YQ0nK2MetWayvWQbbdpnvOV
-VQcSq1mY3zmJyny2tTp2ht+tfGVlFjZ4zs+8XMc/lPji6PQzphIf+SpqIihjKdWt6vr4UCqR6lubZh20
-6taftOp+ZXtPksIufVrCW+QHmOstHQZLy0u432IcvouCfIp37V2HuD0on47oGt08vkrw+l0DGC2tT1/b
-bOTJvXJJukiLkFesl9kI8G0hKlB+iQSjFeRymq7KhDueC7/6+O2rA5jm3LRwLKEIVi1Pl9K0OmmyvqvK
-LadOHTMYA7IZ7AYq5c98FezckHcuMX1awvAff7/L6ARyJDhU4wvc1sZ9hXEC8CWblGQv0kVglnZo6/WT
-+GLXTUXFSQWsrtSnTDPXI/EVxt7lSH+AnGn3naliNqJkb3jJZQYmVpQ5awuVWflAp/zldD4DmovWXA1H
-1wPqAfmD6Jx/XG4+VgIqZnVPfj7DbGqt5c/HboL5g/nt4OR4vjRhWFDxmrruUN0teXzgzIN+Xh2q/725
-xVBVB326by6mnF395eFLqzqnUYNndnXOCfP3Y52ssq4/6PDAfFaC51+VqW4sXiB1qbEKnrY5JArzISZT
-DSYjdaP5It31qEuCy8z3PoK+fB+HwaLXv0W+UpzxeF19/Txd1K422zJb99Mp7s/FPby0YZc0De2vlc2A
-R3QTPGB4Q/f8Y173K7z9KRhMMwXKXLGP1/y3FBVCuXOVAdGu7JUgeI732DtXxjfpWljoThEhIqvQeX5v
-3+rOnMagjmnXcqhyzvJ+b7ezSNAkTdogiun7KEMXlCo/XjV3ivob3Wq2uNVubqvZ1la3oa1yK1vlJja9
-HctSxOTpfPrEhodMfg5eWkuTND5FISmn2lWfhlOULEyTmo+vA/sBlM2bH5ta1yEuVTf9m71vLZeCLRVX
-cRG1lKcb7Tl82btHKlmE+P7UdsUWOGXqlffcWeYe1EWDjmvUm4E1BmB5arQNwRk5F6uNPpflasy/h5p9
-VUDnNAArq5LWv1PUQiPqArN3OXsJ8aCMuDycTGxrA1qEib1q/Sqo3F4IlTrdnYfUwj2yJm+HfkxJiwBa
-d3OLoKlxazRIINHgUAOnzA8vLaXwPpaiCWaPL+RtmguRadL5/u0P9mXImnHx6uqq++gPkwFJDcJZmHFr
-8+pj6lQ2bj/7bZhF2KuggV8uEGJzzaQ2nH01s77tPqi/npmF2TCweRaYxM968BU09UK3j7cVV/T4L1Hx
-I2UsFm1hcgFbN/EvWCdtuAFi/TryoLFY3W/Bs67Dpat9te1ZC45RozvsJfMygJ32C3RnCUlR6ujy7lER
-bKzyDlmfVAvRh0maFfmKkOp1lNvHBuhnr7JQ1yvVrS72UxRNvJL4qFT4KImtdvFXZZGuW6bcqHlfegWM
-b97Cj1IBzSemmH5Ugc0wRVt7Er5LVWmUD5j/j0EH9EM0Un4FG7wJdvcCjB/YC0E8nowiPv3gw3vOkSzr
-VxV+BzFxNkavbRzN41Kll2H2zpTFX6UirzK+48/+WSr0prrxNzMbf3Ob9K2y8KuyyCs8HuKWo0elwm8T
-GIpxnFjY6ke9BTSr7lofF1dWVikP2ioeNUmTxd1Oa3FlVSaexd0u/qL5a3F3Hb8DJNyVv7i7Ib/oiNji
-7tbdeWtr90ypk0YW/d80zqIW6PjpKGoJbs2PC0t4nAdkEgiBg72AaoUwADSlUmPJw2oJYxtY8OX+39Cb
-gqK4dItP+ATaBR7/gqc7m13nMZ3hgeebT7oY5jZvcCHYqrpsl1eNUToYdsvwpzTR4V8KclcKjy7RAdkL
-zlD9vDC79nLMHLay0t3ZDKgE6aB1ughxsxVstYLtVrDTCp60gg7mmunAH7ztwLvONuee6cLvLnxf72DK
-wnV4swFPNqHkJrzdwvrw+wlCoGrrCGMLgTyBn12s2t3coTNJawvnCl0+zDADW14vJ3Q7W63Zf9ve347z
-hxCetJy/7pr313H/EOp2F/52NMYDm8AH9saBPFgDlJ9Y9O3QNWiayttM33XGrgtP1vEdUg++P0GMu4TY
-OuIKFIACO5tIYyi2vUVjg7U6m+tYfw1rrq9tI4w1rL3V2djEmjsIAWB1d57QECCM7sbm9rZH/IFF/Mqe
-zKK9T2OfooqKXfrD/qwLD23I36b8bcnfNv1hSYBHf0/4b2sD/whtFuMVEWOcJT27QQ7NoZnZCvi0HP7L
-B89a3LsWn3OT/9Qr64jcgrIz6s+lyeYreoPfe0hETqsGU0XGV5sNppNR3A8JP/Yfmef54Lhno0L9kDeg
-5CqQAkYnYUAjQHbP7bimKp+1S4d8TldyyZmKY3IoYVz4Jm8bAlSGt/F4OiZnHgrQdycVk4aTpWlRgQHA
-SKbjS7zpeyioEOJIBKrClNFg+tMseyAYrIKXiniQBhltJKuARB2g6kXKxRBMPr1seyDkvFWJqiWMJlk0
-jD+IhJCX6AKCGWUwAxfkiziiI82MAZXXta+nw2F17R/hzThMGIouHyf9rFwW+4hvyGLBMAsfBGVi6rrD
-GKzwGh6iMYuEcekgOaNt6JXeVDAgHggtDRaNPcuDYcUwf1duG59SVYRD1VzOpxhJaWhQgZmByCcYAtPk
-1XVFY5ProarSMwpXSWk54OtU0idYZR2cRPA65Y03Aoh1JPNZJaAIrNYKzlK3JhEiKHhyQWCaifQGz+hE
-gJEZDLgFfNshp4z9fjrsbDXEFlnuNEEHrZ7pn+e9EgPLudRhEIX9a6VkdAO4uvtg+NgALwtbjE1ZD6qa
-sO/a7NnPbEoreVC7+lpqw2jL2lKnY5av+LpI0J3oBSkFqBSXFR8M+mGSJsDVI0egVoLg9JqOcjsVw4y+
-52cwExLZ2p1zKHpo9Yri9fkkTQbUTsFQlOo19bgFV4rjPLiKkiijqOwlzBZxlhdBDpOahEZtUJc6Xyi5
-KsCBWYEtjtLkqkW8Ay5iGOtsCMI/lKMR4ZBE0tBjqoUAjFuggXSUkUsk6QJOHUWIMyuVxIDrb1GWBiqN
-A0LCkowulU+H3CYiFeVq9aJlAsOsLTFSDS7UFWOkNVSuQeZ4r5pdGRvmiuEEszjw2qDkmIBSGg4IEHbj
-BwE0iNivA8DjsGjhs1wmAgSm0oKH/Xc3YTbgbiFZoVwst5EmIXjcQCPB2KDLNI0V2fM0uBHiIRi6aoAG
-gY/RY3OYPKMgyUDEw+wK/k1hLqIT9C0JgnMrRCmEw8SSJm1cV2hllXZSZSnIKPhUYZ5Px2jSXE6LYJBG
-uSTkoBAdrZvjIMogmRkI4RB7n0tr9D6jNB7AuSLkwrkYmwf8xlMYXmyPVuTjnMLiHV0YeZoyBsA0WajB
-CgtbrAAesdM4ChN5i/8YXjc9SPswhzBeAJcEVTpvsxQKDAsQ7gubkvCEoim1vvO0ESVcJ6WApWXokU6i
-vKAeyQC+Ba0U98sghF6EN2MgBqUwfYKUjjJXHkk/Ev8ocnNdhELVhdo2RKQp2qVn5y2WSMKU57nLCOFP
-svR9PCAaECA9XIZcWl3xgk0mhgf2KcUVmmAyzSZpjiTFe/pwHSZeAWyG2PvkSoGtMhKtY2HAggMYd3Tr
-iXJq9YcEOLlVChYaIZZsMY/SEODFtoEk7WcdLsqJCQryQuVCjI6RfBRen9DAk7Yjy8Lj/MYkoZTDxlKt
-nqjaKzxghgPv8YqZng6MCm2IxGnxGaGesIRGkvnQkQ1t6uOXp3sqbkC/l5dV7JR6cQaPdArdOwVAG/z4
-5SmjQD8qaudnxtPBFE634/Pz5WUd00WjBy+ecOa5FjYDzMRGOij6iPgsTirsf7H9lR9E+LE7obuFP+ki
-Hvxm0jXxJjBEEx6f0zLRmnck405CrdTIs8DalCzNwpOeVY4aVnBqzKsk1aKHdgB2SPShrJCsrrLgpZMz
-P0cJDsXWRk+5eit8XIXssOswa9IrMZqt/EvjMHsHAuWBR4pVNtDpBboBcTVNE3LKUwGBVkow1CZNA4XP
-yjTEUM2KpqzlU6WzUlXeAd/daAafgsbWBv7AgzifyAybk0TzdnXu3nw2wlhPu+qmTYmfr/XKfMHT5k0o
-e4yNVkjRBUIzi5eIaUC1ULIzjUwOX54SZ+JXI5IWv8fJHPz+FKv7/M7rjHfOIgepSphF3kdZG/Rc3s/i
-S1Dl7POlGKguIk+HMu7i43Z6rmbq3KOZqNrTp5qUZpem0lg93WEuax+HE8Kr88p3lmj6PdAE1pCe8Vpb
-gxcM9/YkDPrpEykZJGmn6S1mtTuVjpaIqUMfaY9pqyxxy5EpFIvUeDTkpom1TrCw6llHM3AFjWtITBU5
-6R5VV7/PKTOfTWa9qIR8WfINWvjVtvyVIi85YvNOLHI5bMW0ojlaBppMFIV49TREO1tvx/6OFnLhnusd
-HBSYwOnUn5+D4EhMQbSe2W7mkSEziIx4qBrR3fphIZEBAHgNNg1Fm0w4acVYkWJEkT0EFTEEg6DI1BKS
-wX9UQVmyZI0mwL5ye3+YKNC3FGWaIED8goA4Fd5wqAzwy7Qo0rE4K+KSkZWEDICdQ08WbLyEA1bLhHqL
-LTDMX1fAQ+uNXGmvfRvbaVDbjXC6k7R3RaroZNGP+qriPXQlC/kIbD6SLorZ66qNzJHnoIN80Lmf0eJC
-xKIP/ShiFwsLtIxtxviyaz6QVGMDviDLwOPAEXuiQuUFkhh2ppxAETzE0VsR9wh3CePNnshLWBDnf61B
-ySIMERTGOix7kS+ovhQnOBoANKI2QCO/RTl7dkwzpu4xHzELstWqKlJRquh13q7C1Ca6hR5SuB2Jcqy1
-8CQM/cJ4By7oUQggfceGseWT+BEQYxvfkPuAG4IqGlix3YUFZVl6HgPKoHZ+WT+d04GeyLBemBsXDeGQ
-MxQpDLXJja8I7rsomtBuQRgfHEcYL7okxYTT0WmlTZ+XEZJIrg4faMcdQWlP6HFhooGoOIhqNPrSB1pB
-wy7g4pl21q/COMkL7USpVJLOyh5Wcpb0gLpXU3DNVXVJhalcbHbv4yIGs8ziGRoB3QLg+CaK7kl7iXhT
-bAIeU2AjThMmISpwK55iy722clQwhkIPzAxAP/aNyKMajUxwUO/i/CCOoH7bsgZhwnE3YZtYhJyDEhNs
-gXqhJ12pn6PCZK+TcAmHmAeVFDvuekBpyDmww3KGESWRdz+6Qnqhwvr2nDmc7acT7cDR9YE8D66uguJB
-sqJFNSnicfwbL9W0KTAbD9ucCByGSQ4m8L2hLa6Luv99iqfmIsrtiN49aI/3O2LFuIaLmiwlFq0CoThz
-Kk9iMB2Pb1lXt9sYAzHrA5wcBmwJTlB6Z1KU61aQP71GaC24p5/IlIzZ0NUlUgqNkT4rZUdjnZLUfndz
-y0ag0vPCxUSSDcFckBkYZFSrA9MqQWdDUZk5IjcoKkRfXhwAniG4NaslCgMdwTFrJjVrZ9U1xJAS2zkR
-e3yOirahJZn67MyXpdrushbwlLKACIKsk6HqKDVeqmxNy1S5ZmnMr+wukaFCpY7wQpJeNRrRtq5qCxtX
-PrP4CmOY7tx1owyBZ6z9CJKskpEj53VMlkQsTeksPtEa0R4DaAcuKvYSEp0RpejbiE/W2i6UWS6ypgmz
-b8wVKPRAqLln9jSAu/6IbU1h5vhSad7H4bkpHWebXbxHDgPgN1ErGVoZc6jtXVRFDC8lSMyRHu4o29P2
-arTIop2cA8elTaNufDgy5MnCf4oyaSx8k7JDHw6wcnPoasa8t25RMECf1QEljXBm655lA/S8okVUJmeW
-UisXt5Aot7fehRoSw1HsY6ffsm5mNW2uKcCKztpqFssopdmCI8bsQgD7aQOdl11A0Eh1SQM4cSthaJgR
-keMSpAvkLYorP+XwQ8USbmD2HMqxcWiNlJC1IOocnaQG2nu8lizPOPLC10YGDUL22TPGCh5gDb6szOSm
-1AEZRWAdldHU+6SIp073UMvsRvY0QfVCh7XiIgs+dBSMFNM95OsI7aQdKv2IChrGo2rWjeF3WgDojefd
-MggmEukd+/myTbw7j9/UHOXxDV8FTBTmeaalji2QQU07VlQPySM3AoqnVk2w2MVT/HV6zPFTP+cNz2Rl
-H70sO54q8VySoXgMehikZVHzlNiT6Y46mU/cjcxeYZ7eh7KKCIYXbsXJwiSPyfzC2ItxlZzM+Dyl+UmX
-ZKKjyUQe2vnrDS9NcIc5bTN3BMJckupP8ng1ALrnKHJgompBlElFihj/Smb/dFghdno29xWvic65cm7u
-PsB6y9zNp4GXr8MNyVlFraxMJjxXDkQa1BSfaahW4C+ovduAnA1rEsUPTYLLFR168AyLnwfOsvixZ1of
-eQo2yLQIw2oZGzbjmc6w5WPxszmppkPjUAaVot1VKx4ub23+NFFweSkZBUqp0jUarJaltISNteKlYdda
-tyHALDhNo4EtE1vZmiAeavuAZTPEQx3xshy5Bvq74LZGHK9Bh5A8yALcU3TWksgCR4DA64txZyEvCVbA
-bCkXvmLvGa944hEy9PpvuNErnNrQZ8QcJeI6AqWbxoyj4XL0uLWQIXMbltHLPI7Mz7um4wHyBdte7PCK
-Oqsc3ruGbQhUL3esOfHgqFAMj2n8x6iNcuXi+gyFYonSgwDstRLFnHqxZOGuV7cz++68tf05W6z1Xs1f
-3hSggMYNHhu1kYtzl9MN+YQ87TFQCc158xa43X99A9om6sfDuN8KLqN+iI3cyEUG1rUFCoA5ZqfsbBPI
-xLbsnXyhQsK0bx3HU/X5aJcdDlV401FMiowhYxog+vyYhQT2WFJyUJdN8BEf8dq5gaAzxn8ZHfgYosLB
-yCmdFWIlHhZOphCLCG5lnwrcf6laQwEXAk3EvL42xojmFSiC47cvXpB9kdorbwRCzkkvrT7+5QKLcVIu
-Ws4rgvdxHtNqwC3uFlKbbnOrun8ACc1NjC5dTcnnukyZ6nzUH2ecXZVyCG17HC0Dyz7J1kV83h7/dHzy
-87FGSc4tyAZDCWBNSeFlFDTjZiwKy9G/NTrhU9rlLALDEgnitzOP+KF3SbmV3AMNCSi1Cy6cr+JJmFUo
-s4oFV1X6o19zPOmA9aXh+UH8llMFAUEwjvQpH60AGkory9BkY9l4qDVDz7zuX0+Td28wDrSHG+A3gsfw
-H/3Tc2FYUgKg3gL/7+zjrfoNF4wNmw+sfc86cFM8EeyIfYCpoVto+bWarCeliyvgwgOnM2OIX65Ox5u+
-49C3+NRgCzxfEOIiGtjWi+mQ0n5YpVfx3tJOXiUvgYxdydOIla+1pOoCoHKAdSkaN8205qJj2iiwcbGU
-U9KsfAwTtV2rcUN+Dei2X3EDGaqp8XRUxDD1BzQmsgrPEz0VZGUWJhwXsIFJ6B/pSOua6DxQ1AyIXKht
-jAS06Vi/isxgNHrM4KVFK3GcqtkrF3s4z7EBVjuQTHW3pjUENl/aPEmsZKddkUm8CiML5xUePToUUh5+
-wfiuxWAzPAW4WyHBPmJ0XNC0pcCQmJT02scjJR7yxTI65tJTi7ubrXsKG420uLt9d44KtHW2c95s7DQX
-7pq9/xcAAP//pnkHIVAsAQA=
-`,
-	},
-
-	"/static/include/input.js": {
-		local:   "static/include/input.js",
-		size:    13944,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+xaX3PjthF/96fY+iGiHEWSnctD7fNlEtudpLWSm9jJPdzc3FAkJCGmCBYAJWsaf/fu
-AqT4D6Tks89tp9GLJAC7WOwufthdYHR0AEcQi99+ujiFH24n198A/oQg4izW1HUhko3k84UG76IPJ+Pj
-E/i7YBFMfKl57BzxNdz4y9SMiWO2YCHMhIQLFodcwHffE801D1issCeNQyZh8vYaToZjwGF+vIHI19i4
-YlJxEYOnGIPrHy+ufrq5Gup73UcGo4OD0dHvKuKxhqkUa8XkKWiZsgGsF1yzU5j5kWI0cHQ0j8TUj2DN
-41CsB/Cr5pHhsPIl/INtpsKX4QAmIlXs7ODAm6VxoM3EffjXAeDnEHtAackDfYgjqGk0yr62HICtUGWw
-8OMwYjIfZL63Q86hYB6ymZ9GWuWT0EcvuBp+vGObS7GOr7nSSPH+w1neTdOZVjGDkCWSKVIhDldbDrs+
-yMEjQYHPcDa2AV8ylDlJNv2DLRPS0FAx/TGX0SPBBpD/HZREpk9P+3LOdO8UQhGkS1TDoNo/w2YUtWdt
-tO176J8Vk6JkgWRoeZKLFmVVVtZnoaKsFdUTszXp94qUf8kCge7k3U1Ds4SJCPmMM3mziQOvX5XpNyb5
-bHOx8GU+yoPREfyuFuRTyDXwk5IX1fV4K/3gDue90Six51T/lUIWLOdudZhL/stsaiQeTtEpTU+/32BS
-bSn+9c8ckpJijVeX9HnHWELaxCUsGNpZCVgzCPwYJJuh9rSg3qXdbzUNG2/+wapZoZ5rFkf7pAnZs7Qo
-VMevSWlFgwYJ7r+4SUTO3klmPL1J95aa2wmnUSq3RH4UIYWqyFf44dlB9lXZrsNECi30JmGV9aNiE8lX
-5KlLphciVIXSa+Y9Le131q/pEHdg5hMizldTH0Mf48qXbJrOvcNiJBzCl8h0aMU7L9QL38Ih/TiEUwSu
-5LAPX3ahw+HAAMhmeWo4sqH9l31hy6FXb4/9JaOO/mH/rMG6viKvxnEADplrfB4Kywwaus0cZqdq/2Il
-yaAHR6DT61TGZqec4RwtxsigZZjJhsxdRkEnuEkT45gwTadTPInmowwfwTdyNUHZ4qoWiXEO5NxUXyaj
-wZ2aUoARFjlF+S6KxNrgpj2EcGMboRgepyFMERbRYj4Z2vqOHYUnZbBwsVvzKELgXVkoDhAjcUWIF4Su
-qD4/VoQYYZvwRsGPMqiR6iUsmliX/NOkn92kvyYvYc80+dOYn8+Y20OzbMq6tsvH05s3xeG5Ja6fElUj
-ShYxXzHUkFcbV2b8+vUOxmW5UUNvU7R40Dyf59Kfdi1mNGpbDlHWV0IJRICxgV2RDYLPDpqL8MPQelQw
-KM68gSvQyk8dlyrqXDD+auWRJvtwsHFVKxPT3a8tCJX7izUZeDgGcCbamja5gkgopsDs6I7p80zMhmju
-+amrOXebT9StU3aHNP50q1vaT7W7ZEuxYs9gegejx1vfwWRPB+hk9enGbDoSbm0TjSITX5vUlMeIi6QW
-zL8R0xxAsoUDr9tdus1adhiF6WLn4VVFMBpeZHnlQ+OhklYYYZb+HfuIeUXCpOZMeUX94f2W7H2eTw/M
-395a4K9eKJa9DwOjt8ufJ3jYsKU5SkhTmAEisuPO26bNPE5SXeKYZ+CGZU+uieNUiIhYIscLy8AcR4rF
-pqhgT6ESeL7vFWE90VsupKfeB2KSmdtUe4jeuM8oA3jD5UO/UT4xZZeO2ont369wsqSx2VJCICJ7TNeG
-hQJPB3aBB8TdLV/aKkIaRWe1YZGv9K1Ig8VbobZDyofMhYhnfJ5K38jlay35NNVMvVQdpdapAj9i2HU8
-HNd6NK3i+1RrQdn3sbv+slfabzTsyOKNlbrzeEPaqBkYwq6qgSEjtHERTrB9B+l6wVjkon1HHTuIQ678
-aeSc+tJ27VFOMMP3ryXkXR+zTW3Iu0+vbP9jiGsCwqXdUrEykLBmgD5VdKSJ3W0twbV1QfLabCO5ouuW
-ofUI7qGB+IoC3axgq2DOMYRNVVUuhWHqHCEsQvAYNOlNsMEgxrEyx72wWN0gr3Ch6SyfIux2YHgVMc5r
-oXElGM5wbKc5GqrMCPdVZ3V4U6W7F1FLTmqrQHNd1gCwazl7QqYjCTSasqhTOU0H5jx/zoQwFprPNu2a
-tf2NfK7Gj6I6tqKSu8fgW2BwmkW1Q+M9jhgwMQeDQXm0nAmH8KzgtE5aZtms+T8D0vVAhXhNl766O2ss
-kA0NeOM+/uMPYEPM9eI5C29tW0via3rxkFnxgB24BiD0oU7XAnLeIghSiatFGWP4ZjyGpaI7BuYjH4H7
-R5rAAOMxF7eAgn5JgUgMJ2NI+D0mwsgaV20owToP3SkFd8CxS/L5nKGvDhvsaMkm2js/h2PX8vJBbW55
-bh2zjZQ+ztMdTdlM9unTmtbnnwC3q6TZRapbxKrbu6ZAaw5EKcQs7ccBIiTTa5Y1lu2EylNLCpJZLNJ5
-szZQYopBWGDrA0nmkmRR+h9hpGKK/+Ql9gqgPKaLKQ2dcan0sH1B5Mz3apsdlfU8vIevaKrhvVvXOfmm
-hXyTkW+6yQkDJ75eDNU/pfY8FOYIJepTvXxDvzeqv8Mit7jOufAj460id1igKA8CjNx93CbJYqN4YO4V
-Q72g/d3cHSWWdju+pc3xCwWMeAriea3o+PNhynW+jTCCpFkRskAkmqO1m7sk/5jdAq9p1x3lWFWfp2sn
-0MdiWFPZ7Sp+cO8TZ2vr6YGnUHXPOA+mcnhGuOQoyDXnNUhaZOdF7NskRrv8OMsIaG8x7SrpGRxe6aGp
-xzkx94gCDLkh+JwDuw9YouHHK9e9YS7cMbx+TafNcOoQrb2UeERseRxEaUhT/bVrCq9gD1/A+P6YdkC+
-7Gs2060WrlOe9NG/Toiakna66t+b9FUfRnByZied8BDjgq4zuHwnVoofdl+LlQbbmzFzijz6Kuze3oIZ
-jKIrrgFsipaNbTH6ta3mZ8clWEkqz/AcWEY2BBo46R+a5Zb2krS7HN0WjO28N7NylxOPevzZyIOy1WG4
-c9xSTqlmevveClSC2iIUbESBHQLV4SIHmiKO9/YQ2eSJn+0u438wdK2zMhn2pa99ZEi7P2R4PkYkRvHn
-CL46RqEsitJwFuH4EbwaN/m1RMLFNG/Qsg6FVaD16z0RtUL0qlPJj8Cm3fv/2L359yMevzhyUKFl9x6A
-/89N0HAM0tZOt6BBFbu+4Dlga1fPDGr/ufwZw6IbVA1QXd2f23Isys9jxUNTDVr5irJXvx4u0SKNDbAv
-uoc354QsX3wB27bNtq1hzBLd62oFR8xmGEm+o7Sgm3LjpvyBUYjVcq1dDnuWpdp5VqoMXW9ynnavfeDy
-tGahrFzTrF2/5j277uH2uFcji/VEbKJ6TJal7tEVUV4uH+Y/ruztSGvcWLkJLTFzX19tq95tum3ebNqM
-PQ67ODau6TrEe0ZWppjewYv661jUdnw2pyhuCJ5Nl/nNwdPX/8ycPkGTTm6eabmK5zxmiITBHWbt30Lv
-8ueJgeubQIoo6iGelq80Bp1pvfM+Z1iQ76jUH8E7Ie8QM0Uah2Bfd1MJcGkyuKyUl9XxYcoW/ooLqerw
-Wl3o9k4Leoa+00EsmnU+Ythu+qkIN8QUYYHda2xKH8f6cY8FXg6k6hf2T8cp57390/DFKeTzcnt2wKrP
-8mTMcur1SWDjFPFZmT0FvGoMPwt+wX8PhJWX+0wo5mL5yUBWIJn51fngZGJvSVtfm9CnJysvTrqfnNgg
-tP7exKZsTZ72tYh9xvK3HGO1QHZRRN+WjmoK9obXh3KQy+lyOGB8VXrcWHvbYueqvG9xPnCxbI0J1Yhs
-sSzfEL/PXlMU8m+fu0TC19mrmd84WydCajCDMXgOtJAwHo7hK3qFUX05U0rniW3l9YyVsfx+xoqXFVKN
-lJWHNBWmE7uTYU+mjsWWH4iUGfEYlwrWA7LiqqmXeMcDOBnAq75hq0v3jQq8Mcb9fqyAz2MhcxXb1xH0
-/uehT1W3fwcAAP//lwioL3g2AAA=
-`,
-	},
-
-	"/static/include/keyboard.js": {
-		local:   "static/include/keyboard.js",
-		size:    20840,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/8Q8a3PbRpLf/StGuqqQTCjqlceGWq2jteNEZfucip3dq3O5XENgKCICASwGEMV49d+v
-u2cGmBcoJpuqU7lkCejpmX4/pqk7XrPbRfpLk+Xsko2XbZE0WVmMJ+zTEwZfh60UTDZ1ljSHF0/okYFh
-sl3IJmvaRjwrU1GVWdGMk8qsxK/jY3ZVbNkvRZYABKNvBCfZZpUlK5aWrCgbtuJ3+LauhazKIs2KG3Yr
-tnK7ZqKAvYW0MSa8YAs41IZXlUhZ2TZsWdaMA6KVqK1N2GLLeErY4M2aNSX8n0nW8EUuOozIgY4SIEsC
-H3oK9J6f3k7lA9tkzQrwr9ccDpCXG3b0N+eNSLM8587ak/uz07+wOfx/+pWYBq++1a+W02DHd9NmYEfr
-zcCOXKH9+izccaFfnXdvHrRcLWYACxyWvE+qDxcdUC2atibxs6f0fc6SSr1+8FQkk695MrZVQi8u+F12
-wxuQ22efsYOD8fGaJ8fZZCbuRTLuXs6qnDcg3PXEoPew/zMr0nIj99phkxV/YIdXWdHe74U/R8h9dnii
-RfyzQtTUrWDZknG2LtNsmYEOK+MAVUXjAN1lshIJvkpZsgIRdYBjXqQGTq6yZTPB34AlhUvHisu3q7Ju
-krZ5rdeOEZP5ZcqSFqyv6N5Km+I7tSVZxkOvB2h1Y3IgYsuyYicK/AIaxxWvpbgGRwFrJuzg8pL9z8uP
-b/HkH1/58PiF274H2A+wt4+fnl84Sx56rfaVeg0YTmKH3/fo6igfYqf84gvAP3wSGwtI4pnFeE8KuMfE
-36GztzX7G7PhZ7kobprVRWQrkYPf3oHmxF80qJa71A90TXMu38a1bgetu3iOnIpQyi5BYU4A0BCz5EDn
-hS3sTq4ZyRv++2uUZyCzLCblg0DL7NXvsw9RBXCO86ge1D2HfZ/wo8grYGy5+FUkYPo1T24xfnUcR1OT
-DW+EWYAO4EYUooZnEg5wKwhG3AmMshDyIHpUopDwGunLGoCGFxg1yyWT2yJxZWYofQtvHBH44jkYemkA
-tO+PsQvOjVvxZMreVLTt+OrlFbvKlf+CnCNlXIJHdNQtQGOfAKT9HlwJoPj4atAp4BdZhjpfFz2Gz6hB
-puxZU+dfAPY/43xTdHrPSkhtynz/0+pINHxWApiy67dv2CuQf87OGXlWYG7e/FD/p6wFvB8J77ly2I+f
-Ozyoj3Zv940q74Ufevbe4SRAeCbYASnF2AEQkLcDVgesXSCvRcMdiN5Ddfkzmpi4a6Y62fUli4RDRtyC
-0oWscrC8FNtxHAd+aXfzSUHM9W5ylpflbVuZhVPWbCsx1xSohx8gwRvBj+jaR5Dm4c9tNXrwpRbYPlA1
-S8Bi4GAU5NsiFcusAN377LPgfD5wTLCQY+mKQOcM3bsYxXHVsPa5iDAJGT2rWrkaG5Y620x2qbuhmefN
-3iRbsL6WBtTS852Udvrdo96bSIV9XwJ/qHm1+j1UOgt22FtAtQ+ykwEx+/UOsDdDgo334g0l4HszxoEO
-PUvAjB1Jcswv2VvsTbfZYy9y1+Dh9qbWBg58ZECrerGT1N6/Wuj3JlRvsJNO7TYVhliO7bvg7zHnUg4d
-HWbMnUNeByeGDOwl0YvA3hl0PYXMuCTwp/h9ph/NWdHm+cUTv1uwzGoJ2/KqgkQcM3bK/yDbkyJfTlFk
-tcjFHS+aQJQW5xE3CsMNAY58h2ViwC+J+D0YG4S/i1j0N8HLpxhXY9KDxALZdVnVGeYIgGtR8jpVHHBW
-6UA27wRH7O+LiVCMmKFPLh6mPpq2+h1IKP4HWICEZxzYTR0pauZwqOKLI/f8bCzbZIUJ27rETpxK6yeY
-1+OvIN++NFA5ErzBI6zqssh+A9YU2w3fOjvj66tiu5MCpZhwZP/MGaaOUrcS+r2Rr08d2EjHwdrR2u7x
-1gQRNoEqL+AgdU2CkhQPM+2KrBXphMTKJykLmUmsfsAmqFu4yYCLpqED2u9swOGsd8KuYAcp2FHmqtOD
-GTu5rzZkO+P1S8EfRAPUYTF3/Zwt63KtfvO1GyBf8y12RDk1aqFapG4oy4pG3KBsBNR/1AU15nLHsxx7
-oAzNRtSNabB2Dk05KFICt+jDBBCbvSNsmsBr9Bb4zPw+0LpAF42EfMFG8xF8178jpsHmRWy73eh3otu1
-cLgXgjJAlq1L2aALzYht2mXe8bwVbCOoIw0ss4TkygdIQeEf4zc8JFmREcIUpCCWIKdmVUph6+US/cOC
-J7do1ID0WNN4TO3BmMRMSHFbd4npzl84sqR0GG0HZGh+tvsspz63Ozwml8YFhqarZjzZKUuzAoH3wfyo
-clhSNyRgBUNnR8UBtko58rcCcVx/f3oCplLCexIuMYGuDcDPaoxTaqyAZYNTViJBh7tsc99mhkjYoY/U
-3DLgA0UfYDFlGmqVvkEZR69cOlReKoH7AKodJSG8fbxbBRxDHS/bHNt/AtTRmDNwRcxsuFfihufKLkDD
-a8FMVgjmu2qaan58vNlsZpvzWVnfHL/7+fj5m9dHlGgfnR9RyJTH/wWYjxSKoxy89dTeQJZ4lsNfW9kc
-skIAZjCNNa+6Cx7FtClbQHS6enF1/VIFWPhXFpAa9a4PToR6MNW9dojRJYr2Lkvh6JrAcOe0LEYN+xx3
-/hwjylY0gU1pyQ/4qmjx/QJE/FIts1FMnSx+ErUvsyuR8Z/sSQj22dEKl0HIgnhZKA+Y0PmbeotSAVNM
-s6TRvNbuE9i3zm5WDYSumUFwvTQBO9F+si1uC4rnlJzCA7W9SGeuAwyJ6g5B5PyE3sDNX5F16DHKpYFV
-NxKjol0vRB24joDw0E5IPTAUJ0mLHVl1M/kb+Iuts60h8G+X7OT+/ERXQPTor/To22FRItQF7pZmN1kz
-oBLOBl+eBht8xSOOsRZkSiXLy42oEw6Oti1y4JtioXO15J3L5jHkOmaveffTF+z8jE59dfS/+5z565Ap
-Xw8xBQq6lz99PIE9OhxHhGJCO4I4K56yk6NvYwWGhOQ7WfUa4EUmZMLJ/dnJ3NpMVjzx2vwa7ms+dw/1
-GmrHDIqyOPTCg75K0zhg4gG+FRWv8XYxDp764O0CrxKaOLTwoJ+LJFvzPA689IEzdJlR2IVDXpW3Mg7m
-EEeX3XE4h6p1Vgzhc+iBWJ2V6a7SEqouTN+BPaLGrPeT9r6d7j4EN7dZ56ZUQUQuisq3xGCil2Mov+gH
-M+0gJDoIc1/DeuiUN3xi0OuDYXVnp5oz9mawZun8oE+NlzUDdhO98c44W1e5WEPcBav1Y9mBG8wc92dZ
-rm5YkNV5qc+wdX8TWvdfvhm27henYNu2aX9zQpb94vToxdmXEXeijJrZVh2qCs5l9Hv8HRLttwOGfXru
-gKob0osIym8duHd8EQM6+8aB+l4mvIrt+uXXDtxzkYtGxBCeu4A/lusYuvOv3G2LmK85dyn9id+Ij79U
-McAvQ8DnQeNH0eFufF1IiIdROlzGvBLLmMc6d+UWP50riJ8xz4id7MTlMJ0/In6Xv7pLGtMTl4Du8iAG
-6lJBLXjSacxE5/paFLtB+qY0pkdnrgxUUzOy19mZJwGvva3iMvbJIxp96tLegkN1TqpvSI/sfLlffRZZ
-/fPeq889+oo2shQfT9kzjBtQsmlu2bigCOEQhue7Ejj1PWg2RppTcKTI06m95Jnb6fGe9KD2XTvA2b/2
-QKqyh9fqB/+F6gp3P/evg4SYzcNnPbgXN7BB5T7pQSNFKIBHnirOXjx5mGBv4AlI7h2/xcqQQd3n9bKw
-3p4jyBHIC3aE+C5kUDDUAqt6rBMDUKo6rlMINim8z5ZbPfdH8bdZcag+oJqvdIo6dqcNaTRQ2E0Wq843
-fTM8NJ11ojaX2wIey+w3qhjNvIXdfQ3HNqA4aDYCiiRFmQFQ1TKwuuW5HqVRHQgFBpVnsypbLJYgMRcq
-C6czrHl9C7tzAFJsVK3kgo0EhZWRoWPrj5hxlczrHeSK6nsoXQ7VwvRQbdDPlnB2CCTk+aG5XCjISlFI
-eCarnIPKPAE2c1X8bXhG0mVS6Dk305zRiJZlDgWHlqAh7R2V7QUOznE9iscSABRJQ/0EQAxU6jmZbl6u
-4iRdLc5C3DfjSZcbzdi4LLBbB4UkDtloWXapk2nbQ/4LClCPDa/eouCmhC0+FotP+ssgUaRXeY6Jl9N6
-iQ0mIcyugSQiAIHeZx+CWtwbVQQ5I9P1xQWUtf4EXzdK8Eld9ON36yZf53FgQZdmInhm9YEv/GTuOrgL
-0ndtHRL8P5p4O3dc7l79pZgDDS7UGhtxBDOLXSBM2L//zQ4OXMCwn2+3LLH7IFW3iKvwo/QabI/Gk0Gn
-UiiMwDOU/a0CUFCT0vMN304ZGMqKOrmgmqj26FpQ7hqclP6pveMhTdZxsCm6o8izW4Ejz3hv0PAFNvKx
-/0v1pu49oXp5BiSdFhlZGmSAbFGDUaFriSxEc4ZDY0fF8Qp+hW9dEEK+Tt0S1SK5NUMhwGcjwWjpAe8t
-4UVmG43OaG2gHwa1JpNqoskpNNRU4P3pCW5mqhvqAhPwyNIl4M9b5ek26OGV/0HpaGapFj6IL81N+CAY
-R2jPS3wnS1SEzmexMfhDuiYwfJ+YDWQvL0K/ELjeONbU6zJSnzItVcgC/vf6N3NYIdtK4bxkB4YtwzLa
-22R2CXPicvJ68PbN4cwUSTC92oySf7qqAu3ze7v/gGJ9uXXSJyyt5SNhhELDushuXAbhDsAd51JAMQT4
-9LhroLnuXexwuRG/i9T5xpTuhwbuIjEs9YOGlIfjUvGvNoO6nzS07OYPJw6N6tyBS4x7Ok92RrH8U1vJ
-hxhhVoLtcvJ+OtdSrCffp27EnEY5EAnGwCeIH4VHwbgW3Fyk6V3CGcTAi40XiGQEQjQOWOcmhy4qvAwv
-0tJ9uMFjSH3xaW55Zo5j08zrpk9iVzGZm8wH8374ZUX2PrQr5LuCu3sMiPCkpOoos/iJumX+qfpRYvvL
-HbsIp3gi12Wk9C76+P0RuW0ljj3VL36WB7dLREYbaU13foMmnJ1ENMyYdIIzIqjRQ/QWgwAVGbZpdEPy
-yrk6jb+gOrwdGChxTmTyQZdFeqlxqy5vaRsnn+tcl02LU+vRcXefZAixvigdwhwZdtmPwLb6PeS11cAR
-sKDqpvH/yFH0wIt/GGsLHI6CjA+WehMejjppKK1TJguH8pZK22flekGFqEkIeJio6ZTSKY8o2RCuK5Qz
-xPimUIncm7dQzE1j0QVfN9la2HPdwXAO4XoBPkrcc2z3Tlk03ACXymKLY0aqhsSAcwRvZ3gOqNh5kclV
-X7TnfFu2jV5/dMa2mchT3P87dflqlh89p7Y3dptX4Iqf6KtU9M50ZtoL/fPobITYp91KvHJGnoWRQn3s
-qS9ZdcU6UTt7uEfPR1MMuOYUM1VdCpVoQdpuJjhSCMdwwhbJxLpe6PI2eyz90P2LuganR32Jf+IJNtik
-T0RGEdZxWFONjC3Fhq0lUAARRBiLMPEKVc/o0kzlWt2OOJdAN9ZqU0gv1qK+EbSu2ZR9SRsmVOPdZSyG
-sX+1ohXWyDk+Qy3DQNP3zoLCM7haxSVxHzSY36cC9OonhRCDrdljHNzLgSnW72AHUEK9k+tmwgObr+68
-1imsCLBZZWCOY2KCGYc50B87CtKDpMU9CPb9iTehb9ioMMkqzxIxPvWOaS4qANHML9qJTixEdSCbB2HY
-DozEuogu9SMPygfNQiyfs1/lCrt4eVlWyPO5+vTX58cBrGGrFI3hvi2zKftqEuYhsQ0oj4rt4GtIzwUT
-A+N8kL3VCHWnZVnMU7R4skntNVx78fGh+oYKgLmREfU+o0bW6V5kNQjmngKUdD2H8ldFqVwckNEVhqGk
-NDZZ0khvh8b0RxULJF/TJBOkzqnKnG9a1fSk6tNUZUPInSw6zVLsHag6odgysVyKpFHVgeYkXiP2Pjie
-rhp2IuN0zoilKn7WzpT+UHz2v5mHyPZuoZsZDzGb1AUQdampt3Gonfj1EH1qHS84RxzPXjYf33ABNdKt
-nyX7abDccOyV6tpWd39IGtj5pIYODatJuwWK0DciyP6Ny1HNAuViYlylHAiAd6Tw/XfzQciBJE3xhabx
-3aai55S7rOqlEJVUn4PESrm/D0BbpjSAVF7UdxhrFlCPClRVq0dOwZirRFbND1FQxpjJ0fViJL7h4EYb
-1fyjmTO33qXyMfLXCcaSbgIynadRuoB5GvpdNSYKe2yRtrRNwKrULIjpHAKoLOO44aQqqVi3au4U804l
-3aXJTcBbULKBu9SiwgJbJ5l9YkReznTLzbHwTypsKQv6u0g4ZgAqARphQxDyIdndoGh20wKr01XWzhn7
-06PWUbenz0tN5U4uqM9L3qFAX4otJeh7pCTm83+YksT0jPmKhotyLrFTSGtdR/5Uf2bBfnV0+iH8eIUJ
-z2a41N5iV1CyA3MBWZ5iIVgdnUniBRCNheAlTAY+FT9xPAss9ICgsS2nu5rXKf6GT/VvSE73Lma8mgef
-CGDew5oPXUBB9enhIfRPijNkqIhirw8EDfcsgB1rrNtlC4ZJRzKqUzRoesrytKsdd5p6mJc3WaK742Gt
-rzFj7q702DYw60YO/1iI7qKhtf3jv58ZhxFll6ZDvu9p0v996PvO0QhAq7ObAjIw0O2fdHc23psxVzs7
-OevFhE7jVL4xjyvM/6caHOzWA2x6ljlYXHkz7uhQZVphZnfnIxpCDbby7Ut1MHWCIu4rvAfsEz4VFHkO
-DEy3Svy9AwsUwce94Jgu9fc8qiXaqzBe895mlVJbUrTQeHtOUE8tohtDgvrjCtjPwPeO6U9WvbaK6J3j
-Yi9jxRJ+xTL7h7Djmt5HyzYceC2tpjVFbx0vVYKgOiDqdiX8QFJ4/9p9FnGfLq0KGNkHc735mOOldURK
-Fk8VI/leyJCHIKosu2F81PtlhlquM5lxhpl6hX/tYQL6L2W7pmt5jDKoyOQEkFv6UiIQI51Xfw4wygei
-xxH2ETvdaajE8Easu3U6JwZUU3Y6CYpm/Cwjtqp4P/ZhZLvYdmFCxdMpS/LSpGfqr3b0sySsBLuu8bq2
-v6sNy3da7/zdq5DqLsd4htAAEebuQTZSLn7F9iGtmEH+15Rolpfw+KKbQoK0QGO8YP4n6sd+19T/WzvI
-U+MnYqKiD5u26POJxoi54xdA9AWOjTLyp3XwizwHLPr9nsPqnXqZUtcbkCsOVhtvPcSGJ/YyXZ9vthkP
-8c7wr+OMv2iAOx2HTJg9MeF13n0uRbeSlSN9eLQwfHiEV9Euip0su4i6supHajpLfaEGDvMQK4t132WP
-32a2erjGVDTdRWD3MVXTDsaU30w5Q3723WT6xNwNOg0GKnBw6ghv8ApxaBpY9s3ddyqYH9ZCgfAlFGYb
-Xqdy1lcUaoq2uyd4pKZ4pEw18Tt+l45vTHth12ejB+Js2ALt5HusLjI7OXRvBoaH9DEeHSH65KjdlA3p
-Z/cRoR43Th7Fb9CwHSF0Ad4PsrtpeoQFNN8eJfJxV/DnsYGE+WcxYrdZktH9XwAAAP//Ya3MyWhRAAA=
-`,
-	},
-
-	"/static/include/keysym.js": {
-		local:   "static/include/keysym.js",
-		size:    22527,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/5ycWZebyJLH3/tT5NMsd2S3SmvVuWceEEsV10jIINmufvFBkCoxRkIGYbvm00+SC4FK
-uXi6z7m2ux3xzySJ+EVkJnV/JBX68uHrpzLP4tfjrizQf6M3/wx/7ek/A/TnP1BriGpm+Y8///iD+C6S
-9Ft8TlJ868q9h/fUtzVEdWs5QLv2z+mBjE5UiMgm2UndeyIPg9YwyE94j3Emt6aGCR1NGA5Q4PFB7AKT
-AVXDUN8dHSTCl6Y6qSypYUYHYYYDhE8XLB5lnTS1fC2E892YOlPDATqURcZd47Qqi+JrUJLFuRWgrhM6
-wfi1JpP8rhiFGk6poVunyVk5G2rIHtnBBb5oDXkEMMMBqppd2VxoEJD/ajdVXVYoLU8X8gjo39CxvOTl
-ScTIU3lULwlVnw7Z68X7i8Hwjk5jWf7AqCDWA/orSqqq/MmXcXvWxBITGYFIcx6Q/10JRPnLQT0NKjAG
-gaq1HrDfrmSc8qcyiLjMBGQyYj2gv16JrKu81AftdMrCqTUcoHOFf+RlU3fR+ILVC8LdW8MV/mVa+Rkd
-hxr2xJVPyX1oGJ4UCQuGcyruhgHXXuCXXL161IVjhbnQMFzmdYr2zSltg68W0ReTkE2VD9dKzYZUihkO
-0DGpvsHyn/ShMLtjj/gLp40yg6ghCzpu2GYQfd98IP9U40o/SRZzzHCAcuZwwBUWcX8iatpXOJtyvhkN
-Z5xvWTlAyUuSn/gYS3xqDK5zOoaX6945NWTvrzUcoJqgOT0IUCenFEtKUc/3gfoyQ+J9KUkWJ7uyXRj8
-KxcB+oQLDQuoEKsW1JAHXoUTGX57PgyZyzLDX+uf+YXMW07rOWazJHUuSdsKUeML4h5ssDqt8vNFpdLT
-sIo8qdGeoODYG5aJrJqjqmYIkf2A5scH/HpOMsiQAfrG/supOe5wVaO0wD9wVbyS2lzW+IQuJUmGc/ub
-Fdu+LzLqw/qruuTTEe95RlErNk3ipCnz1InVeGLo0oKqVmfl1+2VXeLk3Wlf2wOrHGuP/E6srQF6//59
-z3uk9x6JuXljveG4M5zoDSfCUFMeqeFUGGrKIzWcCUNNCaSGc2GoKXXU8F4YaooZNexenaZgUcOkM1SX
-pmtDTWmihrsrRflErw019YgapsJQU4WoYSYMNfimhrhbR3WjRQ333Ry/N4mCga3hjqdAa1VDFC+b4pKf
-SQLLiZR0i2pl2iVIurWK8Zng6yJ7p9Qw5aWTWw1Qub8QcqTl8ZjAvOJmd2khqJpXBuuT5kf5g1NDWMj8
-R56pFzJpkccsh/oSsBsKSTU/mOGdMFSjghl2rFCjghl2rFCjghl2rJgaDDtWzAyGHSvmBsOOFfcGw44V
-DwbDB1qN/kCkrDW/clLZqleoSf9Ep5IkyOVAWuLmXORpQv4tw/v8lLOuri2CrOcnWUy77lZJ+LcVjWig
-uKF/3JVJldXUNEF7/BOVRJgU0eTUkNAlu7e26h0S0n/XTXp4K4ReqrI514j8WzsfMeqfZAas3a9JCJK/
-3tO/FsO9b2U+438nouRRyHwbOrr4a0Rq94GU8ap9yOSExtPryZNqe0mKVoQVW01ZEwvKkkJTwYQho4um
-gnHDlOWEpoIJQ5YTniYsuSHLCU8TltyQ5YSnCUtuyHLC04QlN2Q54WnCkhuynPDuNMRghrzHvdO9GmrI
-ciIwvkJu6N3p3iE15OcgxnfNDb073cumhqwmBOagSLiiLiqoISsegTl6dlxRFz7UkFXkwBxmKVfUxRk1
-ZBUnMMdjxhV1AUkNWRYG5sDl6Xqni1xqyNI1MEc4z+s7XYi3hhk/ZjGmAjf0RqZUyO54hP+moTcy5UzG
-SBEZc4YbeiNTzmQMKZExZ7ihNzLlTMbYExlzhht6I1POZHxrbswZbuiNTDmTMZpFxpzhht7IlDMZw15k
-zBlu6I1MOZMx7EXGnOGG3siUMxnDXmTMGW7ojYw5w7AXmXOG83FsTAV+zGzOGc7HsTFnUq74m4be2Jgz
-GVf8TUNvbMwZzBV/09AbG3NmzxV/09Abm3IGD7ni7xjSE8cyy/d520SKw8ZDvr98DTT7VczOIehevm6t
-xek/9Yx0nuwEke3Z+642O3dXDEtdxzCoOKW/9pUPTH0nvWHfOCfnWn32RJ2n/KSOtNFFa9h/WJUndWRH
-kNSw82RHkJdEucDMdQ4PeyTW3dklcVStL3O87z1pz9Mq1K+Uez7AkElx6fkpB2R+SW9EcIybM670UbTr
-RVFrfeWpjaK0H0U916dX46AZDHp4feupHRT3Bu1c6cF3HH4N8A9cjFlQXIsQXzwc8yPQy2PFL5zaHVaQ
-XPITumv/+B9E5E/ftdH9/fThXcvA7SlPywyj7X8Nh6Ph+/ft7573n63x4pVsKFsGDbstVq0+zKRzaDXo
-HJgciteW7fJnx7/SIjmqo4o43IHvHXK/2IG1tDZ+uEJLK/rAZb43ZNeb7ZQnPsR3BDIj9HEbbm5E+DFu
-/iI9s6IiYxAZo9V2uXAjFPuPK66QlUWhviSlChNQmCAnDALrSoG82hQr70yowhQUpmjtRra72vQlkiMR
-qRPFIR2VmIHEDFlLIhJbK0f4n8uaQOp8kL5T6j8H/zmy1mG8icL1k9t/GZXqaLQnkOFzhdsTCnGFe04q
-fCpUx7TU8x6GvkeB623Q2orICjy5sR/3ZfQTeACZBxT5j08ynaRun6NWXg0Q5wR0LGTFGzfyYxFP56Kp
-dWc5xGcH7gu0DrZx/0WyQ0BtXqXgbyM7XC4t7nvMT5rBqW8Gvg56eibvb/Vu6a+2MYRiXmqOOYkfBgkX
-edsgQPEmXIvbmSKpZXc7Pf89+HsoDgPf6UbXNXfMewxQIT2j4z/6G/SXG4VcQLsBYgJAFtIiMoFwJaJY
-u91h/oAU0hAy/81nMb52c8P8gSak/eP+T5ErZqDdzDAFoAlp95iCF24jLqDduzABgAnp7riA/0nMQLtV
-YQKAkvGMC8T+F+6v3Zgwf0DJeC783U+uyAHtRoQpABHG91zBpQnNFLQbD6YAMBg/cIWV3wVCWhal7i6d
-eAEFxhZJwyAU06/xMVf7U19AwHhBHn3p9/0LXBsQMgYEjG0CxDh+t3myVn2OYPVdiBABFowd5H7cWsEV
-iV4qnCivFJkCoGDsosfItQgKb2byvcE1PalVAnUMSBh7pEy78dsqnWg+tGAaEwDDZEipSIqkbwXIEjFh
-GWNiAmyY3KGA9AorZFtrf0NkAndDHg4J1C7MYgCKyUguthBbArMYUGMylovZ4hMasxgAZDKRi4m2wDWL
-AUwmU7mYyCnPLAZgmczkYuLLtEezGFBmMpeLPYpm3CwGwJncy8WexGcpZjFgz+RBLuZzsX+ZxYBDE0su
-9i9xqWgWAzBNFnIxkZLqzV0nBpSa2HIx8enS0iwGtJo4crGl+LjDLAbgmrhyMUGv0CwG9Jp4cjHRHqyN
-YlPA2HQoFxOt1kezGPBsquDZR/H9nlkMeDZV8Ey0ILFZDHg2VfBM9IQbsxjwbKrgmagBW7MY8Gyq4NmW
-i30yiwHPpgqefeJin81iwLOpgmefudgXsxjwbKrgmejnns1iwLOpgmfPXOwvsxjwbKrg2V9cbFcl6Td8
-UewdqRjwbLpgO8eYNDqRixaRZX9wRVy0H1erty5UCWA2tVFEutUodt9sX/h8FJtQqgIUmzp8CyqdUFKn
-eZ7mVdrIzkmoFDBs6iLbj+zt0gvcL8iy6eEA02lOGa7qtL05V+yKp4CvKcFX+BkF0Aa/VO09v66LnAGw
-ZkPSA1pkH3E1A3owoN3ec4mbgwH1NrjzBMDNBODipRXctGu6T+W5FOBtNpJJiWYtNUsB3GZjmZRo1XRf
-+HIpQNtsIpMSjZrmW3EhBWCbTWVS4p3vzVKAtdlMJiWatBezFEBtNpdJiRZNfajQSQHSZvcyKdGg5WYp
-ANrsQSYl2rP/MUsBzmaWTEo0Z+oPaDspgNlsIZMSrZl639dJAc1mtkxKNGbKU1qQAqTNHJmUaMs0H/YL
-KUDazJVJiaZM8ym2kAKqzTyZlGjJtD/4QKXmQLj5UCYlGjLVD5f0pIBXcymvRDum/vGFTgp4NZfySjRj
-mqMEIQW8mkt5JeqbdhPOpIBXcymvurpklgJezaW8Em3YD7MU8Gou5ZVown6apYBXcymvRAv2yywFvJpL
-eSUaMNmXqW+kgFdzKa9E+/W/Zing1VzKq37zpa/rc+DVnDdf9jYKnm96L120UyGg1dxGpPPa+HbbDUKf
-QiejPfyfA6bmovOSzYY2Xpe8kH8nS5WAUnMXbfzAccWl2KnctT8Kobgba50TgIk1RKvw3SJyrQ+S27FM
-8fE4FQGMWHfIX7Ur4jqqazLN1ZLQA5ZYI/T2aqm9Dynyk6KWU38AiDVG63C7cq6uNJqqwqdUEcZUALBh
-TdrXErkr+7mv8Yp15YNqAC+sKXp2r84gd1X5DZ9UkUbdgRHWjMRE+IEoLKyOojhVn2EyASCDNUexa9P3
-0L8nzBNc4TqXA5lKABGs9lTbcqPexVRanl+VIc78AQPWA7LD9TPfYcAkyirb42N+yk+y+KYiAADLQp67
-9OmxeBg5/ooknb9ySPJtQrEwL01eFPhYyndhVBAwYDEMvFuH/oqA5RE54XYRkA3D6pH8Kr+fLS+qy1mh
-D3SwbJJSV897eD0f1KFD3QEMFgnbkFCK3YuJUoxf8jYF5D9ASxUACJZLtoaPfnsl6F7lwDFJK/WFAlWB
-jsXyyArYUXchkOGXCmt+0nQ43AFWFkPkuI/thVL/hrloavXtIFUApizu6K0kvxfsqVx+lvTjB/lPq1AV
-IMlihOJte8lsR/66f0d2OZCHUepQFeDJYnyt0rspSzQ/PMh1ACuLCdmVbjdv9qZHbRtCJYAqiyla+uSt
-XK1rUiVkj3xWnxvsgCuLGVr7AVH4/ObyPy+zlP489G2MUQkgy2JO5uA4JFmccNPxPSMpqNosUwHgyuKe
-8N3xg0DsjMuT+l1wb6DK4uHqbcC96ZGUzaaQQ4WrAFYWFgnv2N4Gv8UVKfKoInBlsWAV/f8DFvLg35uk
-Utxw0QEALAvSdmyDR1IOPNItUKn24duTm7Yn6hQPSaHaPlNFYM3CkSo+Wd3P3tM84VO8zVsqB+BZuDdy
-LFf4FOM3l3Hy9oKKAocWHjQXsls5S3s81IqlwCVbcZxtoc/+5omfHHFdbWpTXaCVrbypo7os67kuO1Db
-F1jWnVNd4JetOOTmur0DNy6ubBo7ccCarTj05uJdU9nqavsGqguYsxXn31z3bUthVcq+TmgD/2zFcTjX
-jtq0sxYhvEXt5SEVBzbaiuNxS6jZWsxRNcCkrTgft/m7uyKga45i4KetOCx3b6PYNUcxkNVWnJu7t1Hs
-mqMYWGsrjtBdRRS75mgD7NqKK0JXHm2+eaGBuLbixtC/XWjfvNDAXVtxeejfLrRvXmgAsK24R/QVC+2b
-FxpAbCvuFX35Qrsb3YV2q50Blx0Fl1sRLnfRHb725G5O8FdGJmYAckcB8tUtE0NjJGUAckcB8vA2kkJj
-JGXAcEfB8PA2kkJjJGXAcEfB8FARSaF5kQHijgLioWSRjRGaAb8dBb9DeYQe1T9zLbSB5g5perfBxl8H
-bYf4Zlcdar84pEpAb0dBbz7L9ovWD93jl7siJ+2SZop/T3hrDl6oC46iLmxvg3drDl6oC46iLmxvg3dr
-Dl4oCY6iJGwVwbs1BxnUBEdRE7byIHs2LwjUBUdRF55vF2TzFEbqb0yoLtQFR1EXmAgXPJTq/++svuAN
-YOv6kFTKKwzqCVXEkV6ExE9WtO6O+BNjcGIoHa70OkTS0CfGN4GhDriKm9yb95AYAxNDFXCllySqZj4x
-MhVDIXCllyaSVj4xBjuGMuBK708UjXxibOQx1ABXep2iauMT7dUylYYy4EqvV7omPjU28Riw70rvV6Qt
-PDZHLbDalV62SBp4bI5aALUrvXeRtO/YHLWAaVd6BaNq3rE5voDUrvQKWdG65+YFBky70htlSeOemxcY
-AO1KL5clbXtuXmDAsyu9Z1Y17bl5gQG3rhS3ipYdG3vsPXDXk3IXGvaTkV57wK0nxa2k6S6NMbAH3HpS
-3Epa7tIYA3sgrSclraThLo0xsAfSelLSqtrt0ry4gFpPilpJs10aI2sPlPWklFW02ln+I6+1n9/vgble
-++MYn/z4TZNdGpvsPfDVk/JV1gmXxhb7b8k25kAFbntSbkva68YcqMBtT8ptSXPdmAMVkO1Jka1qrRtz
-SAGzPSmzFY31q3kpgNuelNuStvpi7IL3wG1Pyu1+U/1qfvz9Pzs5KaufJY//fwEAAP//PosEvP9XAAA=
-`,
-	},
-
-	"/static/include/keysymdef.js": {
-		local:   "static/include/keysymdef.js",
-		size:    21512,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/0ycT68dx63t5/4UGx7ZgBFVkfXXRhDgDR7e+OEGuECQgSIdJ4rlI0FHcq5h+Lvf4o/d
-K9FAp7j/kKu7WVxkFWu/evX4r3+8e3n8+O790+Pt08ubT+/+9vTy+Pn1x4/vnv9+Xv/04efHn5/fvfnw
-9ukR/3388O7588vj84fH5388PX56+vXl158fv7x+/+Xp5auj7JvXz28fHz5+fvfh+fX7979+F594PL/+
-+enl28fT/3x8evP56e3jb7/y5f//f//P4+OnD58/vPnwPr77/z7867x+g/nX65fH35+enz69Pl/5Pt5/
-Dgyv/vzy9Onl1T9fv//x1dunX179/O7V84dfnt+8+vL53fuXVx9ff3p5+sM/Xx6vDohX/13rq3fPb95/
-efvEOOG+ffrxD//46pfXny74L48/Pr758cvzm0D9zbe/ffU4/77+8vL0ePn86d2bz1//wCvXF7iY843n
-L+/f//uN/7g1f3z89rXb19+7ffe1+/nr5287f9v528/ffv6O83ecv/P8nefvOn/X+bvP3/3d1618/X0r
-5289f+v5e/S1o68dfe3oa0dfO/ra0deOvnb0taOvHX3t6GtHXzv62tHXjr5+9PWjrx99/ejrR18/+vrR
-14++fvT1o68fff3o60dfP/r60dePvn709aOvH3396BtH3zj6xtE3jr5x9I2jbxx94+gbR984+sbRN46+
-cfSNo28cfePoG0ffOPrG0TeOvnn0zaNvHn3z6JtH3zz65tE3j7559M2jbx598+ibR988+ubRN4++efTN
-o28effPoW0ffOvrW0beOvnX0raNvHX3r6FtH3zr61tG3jr519K2jbx196+hbR986+tbRt46+ffTto28f
-ffvo20ffPvr20bePvn307aNvH3376NtH3z769tG3j7599O2jbx99++ir5Sg8/8WoxqjGyGJkMfIYeYxa
-jFqMeox6jEaMRoxmjGaMVoxWjHaMwkYNGzVs1LBRw0YNGzVs1LBRw0YNGzVs1LBRw0YNGzVs1LBRw0YN
-GzVs1LBRw4aFDQsbFjYsbFjYsLBhYcPChoUNCxsWNixsWNiwsBHuVMOfajhUDY+q4VI1fKqGU9Xwqhpu
-VcOvajhWDc+q4Vp1oCWQhnfVcK8a/lXDwWp4WA0Xq+FjNZyshpfVcLMaflbD0Wp4Wg1Xq+FrNZythrfV
-cLca/lbD4Wp4XA2Xq+FzNZyuhtfVcLsaflfD8Wp4Xg3Xq+F7NZyvhvfVcL8a/lfDAWt4YA0XrOGDNZyw
-hhfWcMMafljDEWt4Yg1XrOGLNZyxhjfWcMca/ljDIWt4ZA2XrOGTNZyyhlfWcMsaflnDMWt4Zg3XrOGb
-NZyzhndaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3
-WninhXdaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3WninhXdaeKeFd1p4p4V3
-WninhXdaeKeFd1p4p4V3WninhXeahQ0LGxY2LGxY2LCw4WHDw4aHDQ8bEf4t4r8FAVgwgAUFWHCABQlY
-sIAFDVjwgAURWDCBBRVYcIEFGViwgQUdWPCBBSFYMIIFJVhwggUpWLCCBS1Y8IIFMVgwgwU1WHCDBTlY
-sIMFPVjwgwVBWDCEBUVYcIQFSViwhAVNWPCEBVFYMIUFVVhwhQVZWLCFdcId342At/lGUFBqCRIKv7eY
-542nFfO8cV9injf0xTxvMQMs5vnEN2KeT64y5vnEc2KeT+7BwAbfwEZcUczzFtHCYp638HuLed4ibljM
-8xYzwGKeb+7zJGjzboTpiAfGnC64dkzqFuRnC4UBa6GQEQrjKzGpJ84Rk3rmu8F7XHpM6sHkikk9cbGY
-1DPo1eAXHjUMw02NST0iwFhM6hFT2WJSjwhTFpN6LL4RN59bCdvwMOGbiZa8kMgx4Jwx5+wxdRziSZF3
-g9IGoyC1gOoxv0dEMY/5PTbvQt2Ry8RcnhElPObyjgjoNdksvhKTeTtvxz0K5/GYzC3Cncdk3qREMZl3
-53ORvRjfjQdLYmRkQKE6Jm4jeYqJ2waj0AesmLh7MoIeC18JhcENHjO3RZTzmK87bro7tzo+F/N1T96F
-ecP13MmpeDuyoAiCHpOzl05mtxmS3IWHxHRy8rUgAG9cfXwnpueOaedkbeFeHtOzh3c5mZvxWoAlCYzp
-Obk3MT0nFxDTsxmjsBFTwjtZYdyHmJ4tpoR3bIQWcjmupGMjEMT0bBEqPKbnbrwbOUlMIo/pucOnHBou
-PIqYnzuc3uHhAlYmKIktE5RnERO0TV7jfvFa3PbJiNsVGCZW4rWZzsKLdjtjTGKHjlMMOHAyIkAg5hQD
-YbDzMRf3a+JBaAjLjdciNPAkmMk8CWYyvslMjvDozJTQOoJjGnxnMetave2NUNPIjVKMlLxOiZGZ+z2t
-Bom4m8TIx10f7nx4SQxDLgidpF6GYg62dYszaLitKTEybk3nFTd02K1qBQuMfhtapNGV64/Em4kRtDnj
-Gz1m7nTejc85fhUJLep3uOImT92Bf5OeEjt3ySyDz5JmdD5LdhGTbJOOwhK7prLQQEK6A9QmI8UzNinp
-Di7Y3Pwd6dDmxu9wkM1Nx1c3aenO7DwzGBJ0rJGjk5lu0nRS002mTm66SdZJTjf5OtnpJmUnjmyydsNa
-hMRNClAi6m1ygBJxYUP9pVARkCfF3N6Qf4mJsGH/EmnRhv6JyBv+L5EYbRIAotb2vGd8gAuKfHi3vH3x
-gcYFRS67W94+PsAFRXjZjQuKKLBJBJhjm0ygVF7FWkUZF2Qo44Ji1m+yAeb6Jh0okSftnjkghQ7XFsFn
-kxGUiE2blKBEqNkdazEXd8daZEu7Yy1m3u5Yixm1B9ZiNu2BtZhJe2DNKacy5QzDA2uRNO2BtaCJPbAW
-0XMPrIVz74G1iHJ7YC1C5KYS2BGp9sw7GRpm3smwNvNOUr/lnaRkwzcm5VHBOSblU8E7JgVUMaoCCqfC
-FJyUTsWpPiieimf9gU7PCoRCEO6ZlFDFqUIsi0HsUkYVxy6FVMFPJgVUwVGmYQtPmRRRBVeZlFEFX5kr
-y0psjfw8tiirSsMWhVVp2Fr5Xa6R8qQ07FJUlYZdyqrSsUthVTp2Ka1Kxy7FVenYnVnQYpcCq3TsUmKV
-jl1Kq9Kz3sJWz4orbWGXAquMvF6+O/J6+S4V5KRwLHDXpGwqVJGTAqtQR04Kq0K4nTt15vVmuZ12wTzS
-LvqzoqRsLVlTUhiWq6pE51VX5mew29GftaWjP6tL515lfelgzgqz53ex28FAlTk9y37sOnioNKdjl1pz
-Nr67sqLFLvUmCfUZY7dhCzKZLfVjt3E/qTtnwxaV52y5yIDdhq2ddvnuzuvlu1Sg07G7s5bO72K3c+3U
-oeTAZ5zXmzrzelmeIG+dnUULaGh2FiZK3mcWJEpeL0sXMBG5ds21EhLXmqslY+fnscUCTK6YTJZgcs0E
-fq25agK51lw3mSzE5MrJZCkm104mizG5ejJZjsn1k8mCTK6bzGshJmMCttKvLJdbEgOY55WnL1+5AFMl
-o/vOhpbjz/VOh46M3rkkg3duydha0s9SQK5VpJzLPFeesVrehzvRODL3Yt/6Wy4a3YnTkVnWueuMIw/k
-Knkim+SF7JJzSUn2cxGpyH4uJRXZzwWlIvu5rFRln9jI+sIlY7/pffyImvqSeb/rfWIK9fAl5/vCR3yj
-5r1k8A1dHzHMhq6POOZ3arY6/kpFcskDuUqeyCZ5Ibtk9PntD51Y4d4lV+Qh2ZCnZEdekhuy8DFHXfer
-c79c96tzvyh1Lhl8TfiYy5Q9KRMrKX4uGXxN+OApCqFLBl8TPjiKouiSwafn1XlerufVeV6USZcMvi58
-cBglU8rwGIXTJYNPz7vzvF3Pu/O8vQsfvEZBdcngG8IHv2VxlTL45C8df3H5S09/GcIH9/kQPvjPh/DB
-1a740YkfrvjRiR+qtRa1VlWxtSi2qqqtRbVVKaoumetVfOnEF1d86cQXV3zpxBdXfOnEF0quS8b+kn1i
-tq/b/iA+++qSuV7Fp0F8csWnQXzytSSDb23J4NtFMvh2lQy+bZLBt10y+LbwEdN9Cx9x3bfwsdDuW/jI
-F13xcxA/VXguCs/aFD8H8bMpfg7iZ1P8HMTPpvg5iJ+tCB95ZSvCR27ZFF8H8bUpvg7ia1N8HcTXpvg6
-iK9N8XUQX5vi6yC+tip8cHOrwkdO1KrwkRepvF6U11UF9aKgPrLwkGO0eL4niGI7nu0ZYzee6xljM57p
-GWMvnucJstjabMjhRy2e4xlzD+IZnjH24vmdMbbi2Z0x1x3P7YyxG8/sjMNuj+d1xotxZbwZYxcf6QW7
-+Ecv2MU3esEuftELdvGJXrCLP/SCXXyhF+ziB71iFx/oFbs8/16xy7PvFbs8916xyzPvxNQGH3TiTXJb
-J+9txL1OTpKc1okpjfjWiSes6pyxMQYbcaQR0zoxJPmvk58k93VyXVbIzhhbxLBOTGnEr048YcG4dmJJ
-I/fuxBFWD88Yu8S3TvxoxLZO7GAh+IyxS0zrxAzWbs8Yu+RKnVjB+u0ZY5cY14kRjfjWiQ8sJJ8xdvG9
-Tlxg9fGMsYvvDeYvq3s1V2VYCztjY5wbXs6YzSrmay/53c54Mw7MrOCd8WTMxhjzs+M/g7nZ8Z/BvOz4
-z2BOdvxnMB9Z8z1j7OI/g3nY8Z/BHOz4zyB37ZbbcdjFfwbzsOM/o94xYVHDDCuSsWNVMraUwyxymKEc
-ZpHDDOUwixxmKIdZ5DBDOczquSU4JXPtymEWOcxQDrPIYYZymEUOM5TDLHKYoRxmkcMM5Rzr2m6UPebD
-EGcvfHyIc9fKrUi9jy8OceDauUF569/4yVTM3zynqZi9ufdTMXYTY6fd+tnCiFWAW6a2m8oxNznm1PWz
-LBfV3S2Tc01d7+Z659T7zI2pHIB1tKjOJefnZY95MpUDsKwWlbrk3DwVfubMVA7AKltU2ylvltqiEpfM
-mvqVc61TqLNOfuVcIXdW1atkVt6v5xUyK+xXjhXyRm63nKv3V44VMvauHCtk1uqvveaQ2QEYwrNyVV94
-WOlul3+EPJCFb4FvCh/r320KHwvgbQofK+BtCh9L4G0K3869BOHb4JvCt8G3ZG/njoPssfHT1m0vN3ja
-6pKxt4Zk7K0pGXtrSU57WzL3YxfJ3I9dJXM/tkkG33bJ4NvCxw5H28LH3lPbwlfBt4Wvgm8LX819FuGr
-7KcU4au5+yJ8bFf0InyVvZYifOxV9SJ8xh5LET42VXoRHmM3pgiP0SZUhCf3d6rwXHtAwpP7OlV42P3o
-VXgMPFV42PXoVXjYGelVeNjV6SZ7tCl1kz125brJHjtW3WTPsWeyl3tMJnu5S2V6PrmT5bLHXkx3fb7z
-edfne35eeNmB6677xz5Qd92/zv1r0s9OV2/C38HfhHeAt8n+yF012R/Yb7I/sN9kf2C/yT57Y73Lfu7m
-dd3Pkftyep/40BUfWKf0rvjA2qOT2zi5jZPbOLmNk9s4uY2T2zi5jZPbOLmNk9s4uY2T2zi5jZPbOLmN
-k9t47qyS23hunnby5EaM6OTJveQe4mSMXfLkTlzo5MmdmEBe5LnrSV7k1x4heTJ5kZMXee4Tkhc5eZGT
-Fzl5kZMXee5skhc5eZGTFzl5kZMXOXmRkxc5eZGTFzl5kZMXee6Fkxd5boSTFzl5kZMXOXmRkxc5eZGT
-F3nPDdKK3dwXrdhlTpMTxUxgjF3m8jDsMo+HYZc5PAy7zN9h2GXuDsMu83YYdj03ZrFLmyHbfWeMXccu
-+8fDsZsthzQlduYz+VfMIsbYZR6PbFBkDpNzxcxhjF3mLrlWzCDG2GVHmRwrZhJj7DbsNuwyr0dDD3Oa
-HMtZkzpj9PTcUUYP83h09DCHR0dP7lR38LONPzr4mcejg59N/dHBz3wmf3PWm5y9UGet6YyxyxweA7vM
-3zGwe+1ro3+gf6CfroAx0D/RP9A/0T/Qzz7/GOi/dsXRT74yJvpzZ3xyXZPrmlzXZOf45pDMxZvfHJL5
-+JGHZFpDb06p+F/zm1MqPtj85pSKHza/OaXii81vTqn4Y/ObUyo+2fzmlIpfNq/C5+Crwsc2uVfhY5/c
-q/BdG+XC5+CrwufgM+Fz8Jnwee6sC5+Dz4SPFlo34aOV1k34aKl1E75srTXho8WWnpFLBp8JHy2397pt
-yOBz4cudfxe+3Pp34Wvgc+Hr4HPh6+Bz4evgc+HL3gEXvmwecOGje+Betw0ZfE34Ovia8MXcmePOCQ+t
-pOySo1Ng3DnboZqBXCVHC+6set9oza1633j/vt5Gt8+Ru+ToOphN7w/eb3p/8P6dgx8qMuQpOVqE75rl
-yJv3p97fvH/nrJ2N+iNPyfH+uudLZ4f7yFtytByv+/o6e91HrpJpQb7nS2fX+8gueSNLP70Vq0q/o79J
-f0d/k/6O/ib9PK/VpL+j/75/h0oLcpdckXX9EftiO0Iy19+Eb4CvCd8AXxe+Ab4ufAN8XfgG+Lrw0eK9
-uvDR6r268NHyvbrw0fq9uvDRAr668NHDsrrw0RK+hvDRGr6G8GWL+BA+WsXXEL4JviF8C3xD+Bb4hvDh
-f2sIH/63hvAt8A3hW+CbwrfAN4VvgW8K3wLfFL4Fvil8G3xT+Db4pvDh/2sKH/6/pvBt8E3h2+BbwrfB
-t4Rvg28JH/FhLeEjPqy7Zuw0tsdSimTwaf7R5B5LJ5LBd9eMnYb3WCqRDL47/nSa32OpRDL47pqx0wgf
-SyOSwbeFr4JvCx9dT2sLXwXfFr4Kvi18FXxb+CKfjKUJyQNZ+CKvnLsIH/1Wuwhf5JdzF+ELvp+7CF/w
-/dxF+ILvjyx8wfdzK37RaH9k4SN+bcUvmu7nVvyqxK+t+FWJX1vxqxK/dhU+B18VPgdfFT4HXxU+B5/i
-XyX+bcW/SvzbJnwOPhM+B58Jn4PPhI9Otm3C18BnwtfAZ8LXwGfC18BnwtfAZ8IXfL/oqDd6chY99UYt
-v+iqN+r4RV+9UcMv9sWN+n1lRz21+8qeeur2lV311OwrO+LptVjZE0+tvrIrnjp+WfbIjfxMZ48D/XTE
-0/eysiee/pyVXfEr/HdlNzy9Oiv74enVWdkRv8KnV3bCr5Kvd8bGGP07v8t1OXbpfqf3Y2WXOn0+K7vT
-6fNZ2Z9On8/KzvNV0Qkf1MgFlnLdTq67lLt2ctel3LWTuy7lrp3cdSl37eSuS7lrJ3ddyl07uetSbtnJ
-LZdyy05uuZRbdnLLpdyyk1su5Zad3HIpt+zklku5ZSe3XMotO7nlUm7ZyS2XcstObrlcXErdsVxcSu2x
-XFxK/bFcXEoNslxcSh2yXFxKLbJcXEo9slxcSk2yXFxKXbLYs/aV1y4eZb96uXiU/erFfnX8H8esaBOk
-r2jlGijrcYv1z+MHlfHCP3h94TeRr5z0IefAYMzrPJtOWyXrTmfMnAQXa0bGetNivchYa1qsFRnrTIt1
-IqPnabFGZKwPrZ6+27HFGQt6klZPP+7Y2tkRjK3NuY6CLeICe4qL9RFjT3FRZxpHJRa1pXGA4qQBnCzh
-mNmUX2/8mpzZOLhxxqb3FrJL3sj3M6fPfNHK6/R3ntQBe5HnrXnXJZ0u9CNLb8vPcg6Ge0WObhwrOekG
-r+Nf5OZnzGdoKTX8kn2EMwYPTaUcWFlTuST7CUfWdQ6uje5QjrSc8e2b9GSeNCYxoH/m/UCn8iHy+yPr
-+iP/OekL14yPLGIsh1PO+Lrekfdm3bw7aIA9Mvbws8U5Jo7fnPSG1weve14zuqn7yXEXOaCX/O7I+18Y
-R91P/+za+Tpxid7T8xleXzkH4vM7fStfx7c4/LTpTfPszqW/zDjYtDmVZ3a9jp/RtlvzxBN9u/U680Q/
-dZ51qjRU52knWoJrnnKiJ7jm6SYafavnKSnastMPaE2uPG9i2BlPxvmZxRj9tBFTA+/kENYHdnIItf/O
-M1XE2p2nqlgz2MktrBfsPIc0rzEnkej6Za/EODC22Rcxjodt9iyMI2Wb/QcjVm/2Eoy4vNkXMGL6Zo3f
-qM036+9GXX6KUT7TaYPmXlGf74xBxPPNmp/BJXtkjKDrfCTX0cM+mDP0rp4xsYmG9sE9pA93sx5mrAFv
-1r2OHvTnta98HT0L/TRYs6a8M6ZM+tpZrzLWhjfrVUaf7Ga9yliT3qw/GXx4xvk6eCZxMGqa455cS9Qv
-O2MBvbF7co2r5Dgx8/meeQGfJ95NOurnzs8vxplTcAyW5z5ptV+ZR9BgvzKPyKOyxOtFr/wiRq889lo4
-eLjyXGwp+Sm6TkvNFGOmMHiH3tcrMcqm1Sszyo7auw0w4lm2a9/19sw+wGJ3wT1bNq7aXXGfF7Ij/C65
-ZxuX0q4X8kjvXXSfFyxfmHohu8fvUDlbtmDbHStnyz7suyExXkikXUizg9u6kGbrs3UhHVfvupBms7Z1
-Ic2O7butMV5IpF1Is3fbupBmA7d1IZ1XV7yQZiu3DSHNh3M3R8YLiXQI6dVlP4Q0W71tCGn2e9sQ0mxO
-tyGk2d1uQ0izbduGkGZPt00hzaZ2m0KaneE2hTTbvW0KafaL2xTSbCy3KaTZRW5TSHcinUK6r7MDQroT
-6RTSnUiXkO5EuoR0J9IlpDuRLiHNw+Z2l+azX9Pirs1nv46e38X57HkC3e7qfPaccHaX5zNbW4rd9fnM
-/pZid4E+s8ml2F2hz+x0KXaX6DPbXYrdNfrMnpdiW0hrIt1CWhPpFtKaSLeQXucxtpBehzK2kObpjfso
-5MqjkPGCkN7nNYT0OrRRhPQ6uVGE9Dq+UYT0OsNRhPQ6yFGE9DrNUYT0OtJRhNQSaRXS66BHFdI87aFS
-ZxLa4wUhzTMIWqifPQ8iaKV+9uzO11L97HkMQWv1s+dZBC3Wz56N/lqtnz1PMaikmj3b/1VTzZ5nFVRU
-zZ4HFlRVze7XaRghzaMLqqtmz/MLKqxmz0MMqqxmz5MMKq1mz+MMqq1m9lsXLdzPbLguWrmf2XFdtHQ/
-s+W6aO1+Zs910eL9zKbrotX7mV3XRcv3M9uui9bvZ/ZdFy3gz2y8LlrBn9l5XbSEP7P1umgNf2bvddEi
-/szm6+LiqOy+Li6Oyvbr4uKo7L8uLo7KBuzi4qjswC4ujsoW7OLiqOzBLi6Oyibs4uKo7MIuKmlntmEX
-1bQz+7CLitqZjdhFVe3MTuyisnZmK3ZRXTuzF7uosJ3ZjF1U2c7sxi4qbWe2YxcXR2U/dlGBO7Mhu6jC
-ndmRXVwclS3ZxcVR2ZNdXByVTdnFxVF9XSe5hDQ5ysVRPTnKxVHZyF1cHJWd3MXFUdnKXVwclb3cxcVR
-2cxdXBzVk6NcHNWTo1wclf3excVR2fBdXByVHd/FxVHZ8l1cHJU938XFUdn0XVwclV3fxcVR2fZdXByV
-fd/FxVHj+pUUcVR2fhcXR2Xrd3FxVPZ+FxdHZfN3cXFUdn8XF0dl+3dxcVT2fxcXR2UDeHFxVHaAFxdH
-ZQt4cXFU9oAXF0dlE3hxcVR2gZcmjso28NLEUdkHXpo4KhvBSxNHZSd4aeKobAUvTRyVveCliaOyGbw0
-cVR2g5cmjsp28NLEUdkPXpo4KhvCSxNHjetEojgqW8JLE0dlT3hp4qhsCi9NHJVd4aWJo0ZyVBNHZZ94
-aeKobBQ/LwhpclQTR43kqCaOGslRTRw1kqOaOGokRzVx1EiOauKokRzVxFGjXQcvhTQ5qomjRnJUE0eN
-5KgmjhrJUU0cNZKjmjhqJEc1cdRIjmriqJEc1cRRIzmqiaNGclQTR41+nRMV0uSoJo4ayVFNHDWSo5o4
-avTrfKmQJkc1cdRIjmriqJEc1cRR4zrHKo4a12FWcdRIjmriqHEdZRVHjes8qzhqJEc1cdS4TsKKo0Zy
-VBNHjeu8qzhqJEc1cdRIjmriqJEc1cRRIzlKzatzzOvQrZDO6+StkCZHqZ91juQoNbSeFxKpOGokR6ml
-dY7kKPW05s8JxAtCeh3yFUeN65SwOGokR6mvdY7kKDW2znEdDhZHjeQotbbOkRyl3tY5kqPU3DpHcpS6
-W+dIjlJ76xz7Oo4spMlRanCdIzmqiaNGclQTR419HWMW0uQoNcXOPCRa1BU78zRpUVvszKOjRX2xM8+P
-FjXG5o80xAtTLyRScVQeJy3qjZ15prSoOXbmwdKi7tiZp0uL2mNnHjEt6o+deYa0qEF25sHTog7ZmadP
-Lcu3/HUpy9Itf4jKsjrK36KyrIzy56gsJy6/JhRCQ7jeQRuPy3Ki8bNRIaCA52Y5wfgZqRCcdzwFtJVL
-6LzTUhi8cwmTd3oKi3cuYSMkNuYLPz4VAqi5U5azgNXMEEIbS4YhoCDvTroxC7IhoKAmNpyTJccQUkEi
-mKkgEaxUkAhWXmkaxYksz76mv1i93uF68ufU0gssf1EtPcDyPGw+fbt+V40nb3kSNp+65THYfOKWZ2Cz
-4dnsOuCa2lYK+Z2dAtcDBVs2HfOLViHwHc8Tr/A5v2sVAgigXMtGY37dKoR8jNcpWq7ULyEfY8KBt1m4
-DyEdKbHlz8v5JSSCBJo/MtcSaP7OXEug10/NJdD8tbmWQKFhfh+rWnYc5w+pGVtNlW2XEHJipJ2Wrlx+
-/+Erfuzx/p3Ix/sPH3768vGbn759/Pb49PT5y6fnx0+PPz1+y9+U/P7x03f3r0V+/++fjfyThn/56a+P
-88bv578vz2+ffnz3/PT2h8fv2LjU5Q9RYvTTh5/vH+H8XhC++fIfti84//4dyr98+eu3R+F3UpKfON/P
-Aa+fq/r922++/eGr/w0AAP//kxEHKwhUAAA=
-`,
-	},
-
-	"/static/include/logo.js": {
-		local:   "static/include/logo.js",
-		size:    27610,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+z7x87zzLcnit3KRk/ZAKMY2vCAOeckcmKIYo5iDr551/P+d9u+gTM4QH8f+D4SJbGK
-tdb6BVbVOEUW///qp2r6r//nf/2//8fZ5Fv9P/7Xf5EE8j//63/URVPVG3hL4C/wNv9sH/Dm39//1Qyf
-qoB/Y/X/yD5rQRL/s4k42zsRXa4mFvxn+WEthhV49e+t/PBsAv7y8E1+mL8znGLxfuSqPFupJVt3zd9J
-tj99qX/AC38D/4iLy3FKEFd/1+F6xItqJMSYIVfy+juEbI7nuDH0+we32uTN9cbA3Onr2B0ONMqDi4Qe
-FynNl7JQvOSO/nTLE39hWGoO2OHkqsxLHUvI95Tc8soPbQdRudutioHBCgbnG0/E2GYEfVn0OxaxWC+q
-hsRXrqqIssa7Xd4+nZEPcSof5fGmJ7sbNPGGf5ZN4fD7IQhhM54H8wJqLsfxzdP720jDS+jnMh/DxK1V
-B8razBmh11Xfv2cZwpJc5Mf+vr7tE+X722LgMXDu0lvMDW7BBwnyCl8jbmAl81phnDCcmBjgHyllxg+m
-8KuAXqM+xnu6wgiV2vZ33NfrNe7fTAspjDLGYr/WXhjygLtGGLOGfb3FPfWpTjEvGX47L7nEAzsa3CeB
-dtipHXPszK+/Kq9YbcD/dDUJKid6YqrIJhhi8Mr7uOf/Ofd/zv3f7JzgL+Zwj/Y6i+Bc6GbTF0W4e4B8
-zVQOBrOapNKg44J4VeQPlLYqk29h69WbX6H67h9i/rvm46mCKiKLWP5op6Y7PzKwm9LBd5q/48uMjXMR
-Nrm3cxLwH3RG5xBWCLgUiJITJZdpIsj69abOI1tQrrnoKd5bYFmkkr4GQDmtyXzm1y2shqYVdwmwwGh1
-7XLnRhHUaXOsMZP7ziF2L6Wx23svu+H3VOvliDwkjMQhgmePAMIxAtObapJdX22G+iu74hKgzFdZmGLJ
-pH3m/Jv0xcCiXX24r3FfAg3DG6v+9LxsmNu5M/hZLNgEWhOeOA3W4iP5L7VrVcg5IedSudlSDo463PiM
-2OXcsUCFrl+rvkSVq74K8f5QxNslwyiNr4ZTPdF/tOYqj/H5dCX2yCUHz+xhZ/zXpByIwKJHfLVqSsS8
-Mxa49J2JQIGTI3+IQKMMZugeXJGGGMnhciFcOLxGN59kte4tkTem9J46zvkyOqn8TLf13UWxmDJAdR9Z
-n3ArmfEpylWwSwLLGuoZAnx7FYH4svVt75szDg0vqz5cVGVnvKKuWrGcCJuWWifvphVMGCvYba22fvW6
-zfDpVCCUAMa785s81AjpclE+7NOnu3ZgBYb/4FQfCyz9wihmddP3WVLbLB6miH7GHu4v6TG9Axt11N4Y
-ZB0Abj/2BdsdchbVKZ4VXXyW7eJ5VaBqCtJfrhaXoqggQYPTq1YQGgJfD0+b0fCup4/MIqC/mOAjFute
-ayYzNWLGyv1rrOxJdwJqiZit3oyBKcP7Z7i6XZ82apXvlPIgQWUNh07pljZZW0DkUw3z9Jb612irw94R
-UPTgbzZi80oM/q7dO/y4nZzyDnCcZr+sVxUnxa5k5jC5wDpwu8DpmyferPhk4lyjBOS0/tEGciL3I/Op
-ixVfnapGtFm4BE4kO4LHy+srpKawMF+tWT88fQh3hqZk8FdTLs+zf0ciCOov8W5fLwP3A4PMgt8T5SM9
-Z2ON4NtsKvjaeH7zpVOR07irm2U9HOU5RA4Rh/EPedRfb43rhzjs03Db9J+UYL+Z7ywBwESDF8hyzLow
-4e8dP40lqF1XSJakiEt684hCmbI0UzMpWfupLEoeNA+OsBHZqpKmo1+4F/LlSIe7w157s+SYcZ8lG3ah
-VnqWtatreguJ6wuuy7GQJ/Jy98oh+8pdl7ehvqYpXVjqd3WSBXzU92MUeUj2/u5r/FDLLBeXKKLEME7v
-J/95atfjT5lSXmx1PxrAjfKDbXOF0CCBBEdIpVH+Ty6UigA3OQhxbFdys/dxw3FDIv9Ej79THCpRCHJQ
-aDDRIZK88JRJ/EW+IE93Sa7jLZ8KIN7Xz5dsNp0+1DwLXvMmuIYyFTdRsNVX5iqOpW+MC1Er8NctIWOt
-dgvxmN5xzjt5Zq4pdqu1PMECBX9+2I4UbZjVX6NyeTaOyyWU2CsS+Xui/VAhRVbBd7kJNfrbfwhq63ej
-1L8yFBDFbpWnqHGTde7RORk3owSg0XbYNE/+1UCBEh7LI2g1n+jgEao+sYoz1oW4VRTlaCJfa+LvslPs
-40QhP/zmTzhCm/NY1/JepPC0GhsSQ0Zkm0mq+PE3Zy7OQIchS212ySexruRgvCmNfhlVQxV3z0C1KkjU
-mfMELezcy2nzbylfA3sPQQBzCarqVwW6df6lr9986D5Zj/VliYiHnxVeGwkN5wQv3maZWzMvnqJriSzK
-3e/djZ+bYMx6HcN+qVe++gikjW9RNj/3SQSro32U6KD4if8JUkPieJtPH7GWVVRuq7VDKqFYOkUIdxoL
-c93F43GqUfo3DVFOj24m1zOPQbhNtsoT+gD86PQbzYg/mXCV55mnddzYHvSXn6JwSIQ6X/aIlvJrU7kI
-fRRaSj2GwN9LQJCwdVmtLxekeCKeFBCyy6KG4Nay9J4MAd/hxsjQO7L2QqmSibRbLGBOpeu34W3uT/xM
-UudXsMN1QzJUg4/VIVtB40mzoRH5pzofpM5nFwzqVnao+sslhvqBjoX4RsSsrhPCdSxXYdsO7sBufCRG
-3q+KpsJU9lo3YnsLKF8yhLwyx2etsHBhxjHc03xNFJrq9/7LZ/nnfTLEqmlTrUP9tXiml3qhoOrDDvuq
-19wvTSDEVr3N1yLJdspLyI8hMeO1G8ZcYHA/zJzmInRAiXVIuAi698CzOIBbpfqU3e9sbCUnGwayF+Vs
-t9NVFcr6qtu4Ar6FdOjDWin0xUMf5vZK7RiCXzYmjS6cZvUKn6XwVXu/tRe/LHk2ZSz7U0ACN+hQyWcs
-ud8xOo1Ix9KX6IfyebO9ZG4vYiJgfUFErU60Kbsj0FtVN5njV6GSmwdyzB0gvkyIFauIKlfi8t0or1BM
-u56CzEVTk059i2dLD/dinpWFfrUZZJU6hh3b8C6cjp2nuZSg8qH0WmxJUTrvZeTBBfCPsIWKGjZJRI2u
-CfqU+MDEzySH50AzBBWvJOQ6R5MJqGv6/s4FVWoTzNHhxNZ8DmvUsn+sofBFVNpNl/PfQ0d4X89TriLq
-5/dFv2XZ5dXIdFn/45qCasc59LNExYk0JfGYFCoJSGhJCN6e+O6aNmw3dkhYjf8Vf/3toaVQMeH3e5lA
-FWlN1QCJxEYr6wc+V6Xs3e/o5wy/cN53vOORx5t/vY1zEuPQY2/es1qWDXtysaOMXVd46aX9C71PiHFK
-nKY1seffKws03L9rjUf0Od0VJjR7D4RXKZAYx2k9nxacCuwoSN6Zl+KF4x84GSeVso8lOavubgWuk55v
-V9DHtBsSKwKKwXWp4qISbfq2dy7IQcwqTD4X/6pVGXYfh3CnEnVXkRJu/fmtcBeaYVsV7LhRBaOEKOtz
-GxlO/CaytGmMEzXsXidNNgqLU7fe0IMVHcKrmwoAU/LOUVylxbhkQGvX0SIvOwGqrCft6DasLtPdjukA
-NbgWENhhp10Gpz/0XBFkiN+ZmvKIr/66o7sxltN+LLWzc8xHyi1VaJ4jILt+giFWphCiv7erNLcOY5cr
-uJ7gIpvuk9jS9W+WWHhwK3XKqr/p+BurjxwrfNQwxunJLCy6mOUEFZQNKagLdRCx4YQTi9kqc7MJBODp
-aA2mk+LO133F2f6D9NR4VWYSu7y2EVzs8z31Syp5WRiLywj04E6VY5wAWYU9aXrtw5rXzd9C6SKB1IBa
-bia9fX+FeYG2ajVjnCVEdl92SkwO6duv11RdRnYqZun0/9980uvZjkAbBykLk+9DinvKSO4E5wU0sf+m
-jIT78tpP5QFX6PFBl4EG7n13KRPh0RFIJBLU7Yq4vhkOlivoGSt209g3rO6vdHG7axm9zW81pyTwMZUE
-NMwgIJ5SCoAzKJYcpp+CSh0LakazEpbvFm5JNdlZDMxKdyDrmhmt32e1tjHoWqMgjONW8h7m2tycv+9r
-f+gJwAMhuKSr/dVP7ttZGt+M4X7rVaJYM4f9M3JjHEvUn6wHrctO5F87oiCIABFHrmJ9L25jLFqhd7fq
-V7G6lBLzff0cLjnQR43Z3DXwOggHVkmP9XnrS8KDn8Jcx/ltuW5yuh2tL8bRURDoMHEXeh8V1TVHjdg1
-o+Q44FrPDhH/59bNW2bD1qWI5GlBUugKwNH5Oz/vbzaMdOjyZE+9xR2ExN6Bx/OljDm9RvX+4m6ynu9G
-Kp9oqf2+CTmIAhpoL00K5jw4+hfFIa/yYIo+tKvd1RL6RnLDCs0qCpK1JljM1dTIcRMQokiYvgKav6P7
-yAijHrJsGssDza20yc/HyJHVSjoX4/D8KfkkfYNE7GLV6qRRw+/dq5CwlFqWD6II2Lzf9GabHLntUXXZ
-Du/pNGU/GE6rdWF5mxIgtAkwLGxJkKMgn0QvoQF+eKjLtmtvKL9XXTUdtMQn6sqKYjPCkpI1qCfH/DWI
-oN/rxpLpCQgL3K4rabkXhtIHKKReRo8Ie/GTJXKLMwxCE1q3JyR4jlmh2PZn0ovfl4dZl1AIE6m+JpHr
-FIMn336zLWmMlSl6AzMSnqd3UAsKJH6DfxXsd1SS5X2M6VOM9S27dL988JC/v8FKbCBdpACRXXNO6QOo
-PE5IdMxpg0AWj+LvHlh+cchBOD4xwwFbwGbR4raeIccUF3Hqx1U6s+UAz3SgL/p8GMATvCP0Xj6wgXxr
-hP0A1J7sdks73n42Kg7Vn3GHQPM9K9cgbY+znhg7bMrdy3yvBo+IwZEB71S3gi5debHVK/tXz5LweW+k
-j7HV5KhI+lHT1ECkTvrfvxdut+9TuVgW8vXe7kRi1DdnAtk0GV9+JnF3en9P13c68LnFyiyvNTXxx2cW
-AizmxrGE5Qft420tryzhkpQFO99QAJT3CRfb5qcaaY+/Mw/C3pgiNZhVhqcqYAcOealcS5Kv5OS7Q9I+
-8TTLdZX1bP1N2uUrchs+o501sn3KREl5q4ALVwPA+18sOgMDXvJOz5QF3COyYv26IAkIbhJy6o73MJ+G
-PubENs78noCPG4R+CyaiRWb5/PvOBHiv7THWczy3FQFXvm5Q3z8jkTgLFoYW04ETTF4cN3N9Xk6hIiFF
-d1QvPdBnNAl+EfuwiprKutFcLKcW323fyEI6LTZZHUkxgVKfgST2XPskmpWMeNJBETtOgdlnAlEM11dX
-+tL051f/k0e/JV/WHIgMkLssISzXKznvAPZnxRWwExbBNXSQmoQEHfSMG43M84MJajky/9UyPx596oeT
-Pvz8DOhov2DboJRMe9Rdt6Wn6hXNYPwQwIygCn1y06lKcGBnYA1VUv7FoBr4B8sBn6xOAX6NMOSP+noa
-cjoqSCOOCZRJZZyenrePsKlY4Kr1NHkV4EwhyYQEsYLwR7xg3hmEtsWck/lUX7aLKqzIkVnodlD1FASv
-Kdt16OxK7qudWUClzh83h/oV69eUya/QlR1RGHDiW+HFATXKSZowGP+NL7q9QsnjDpJL655K7tQqbipA
-GRMVfTp04ZPLZH/b3q5KNr8J9e0AVil+diCRJgQflOyqP5IyCu57XpXoSS3AIf5ngN9Gz5cyfXa2glNP
-s+TXJstbVSWVb51ZEuDvqG2oBpcPPWhNGi5u/vOZ6Ta5UwD6+TlB/Lnv1qwfXzlZ/iT979UaUFZdAmF6
-ovW732UAVAR7VaWCFqgyuzlWELz0x91GB2pAupLqb/w/V2wJPlLpeQCGrmFjrv3BommkwStKDDZqb9GT
-rfQOynAC+vW8uZ6Lv1x4iTwdsj77djLh0+5Z95IBDZ72p7xaHnNhxC1sX/ECP+89gr/oTEZ1RJECzGZ9
-v6ljlf3p2umylcleGH4BlxjDmIFeYilaEh0c+mYFLnJxnCqnbzB+tzSp/UTVf7Fvkif+ACjw2IWA8TfS
-14njOAnOHSFM8/nRRGq/xL8kZ1u6aIsWm2xFTGVe++gs0B3NNHTnaD1fXBOBSd+Os6k3cSN7WBTG//Zl
-WZnQDnv2RFO3ZgvJQM5wIhpSgLOBC0gCPUOAkplWJ36byYXMCl3HuP9rnMToMOj5Cs1a1NgEyT7DpVx+
-/nfsm5/hfYTz5BxirnfZAm2aWEP7a1H5xxZOX8b/cqbWJ2qjiySjR4brqzlXNRJsvbjmpPLvJRhYXVb1
-+qdPifUgcsBWvrSqA6jNJq6KQoybaUwBavrsBhN4vYp/7YTxSgffvIpK8/DOOcZY85e+qy8o5KrTuw8t
-94pnXpXJEQG7QZCaFGzE+MxsFIVuO+d87OhEeGptcutWcSzrpuU3ZdlObyly8Bg+csBvK/szvA4PYWzB
-510+dnUMfhI59jSvfOlk9XlemaBsRDchCqFWrpb1i3pynK1vlSElsPyVXYBvJ5moyHSJPil4H9Zlb0E/
-4fLrv3loduiGIcPTiz67CNoFwyNo0iqsteDYHJ/MWXWzP74nD48CMUZ1XnVNymna2qBG1IW42cuTael9
-duCxuAIJAbSQXJnVJyQaIMlNwMG2/G5H49JImB57Y67PVAlN1aiS/3AH2wXptHM2SYeI4ZigJiKuDTNW
-6eLpj1+/XCd4xo+xe0Cq7A6RHuIgiIF/QvgCwKNlDYyFn53447VVUOX+o1b+54nTHqcO3M9bShOBx82K
-FT9Ju0H+8DqUXUulx5BUfvNn2DiegICLfSf0w0kG5C9r5mvJu8L+/NwE/T3cH4A5p8ZedfdvCxoJR8an
-lYcV/mEvUEtYU3pKfF+x5jFjwgApYb3rTtAdqM9PrXM+7M16ZmyBEQLY3ugf+Tf71c69Q0YtnC/3910D
-G3EWSoAO1j/qHy8eSOfOQvTp31ge0H2SF8xf284fBqanD7DHFP67/WFKpmao10w8jSpA4Z4SnqwnVD1g
-Ovtkda4hRKAzzT/+Fzo19AIXt2hrWO+RKn5QRJuvUlOFt0tPl1FZgsceHOBk4NTU6o+TdB8RmRYZk29R
-/uHwRqkFK3RaDQDgzyM/PuuxHTBVP49Z6m+XOZDAScRbjwpCaFjPcF1BtcSVV3X27/nuBvQW/NJFKoMM
-0/X8YGRz4B0+vIObwi02qkJxWVxPrsLADn5CyslVhEXfKT/IvxQO3UJ4z59b9diXaVGmpeld/QnmlBeS
-R4lt6HoNP9zy0937w3jvuVxOtdgCNM9DR0sA2UNCemfb11tA0qviTQSL0+JJ17t8o9+aopO4Sk+y+dJi
-/FdD17pGiHf2IB4CEQmYkCF7whyb+a2/WpLe1Z8eE7z3n28aplzkQZ0tzNdguRoK6Sx+0/EJOybJG78v
-OpNn434q2Ncg64UYBbINMUyzRuVZkX0mrApSH2gcQNdVImsNW7Hn2o0y2sPyap/GvriGbmLFzJYfA3PZ
-R4i8h4irJplSB4s1aW72pSL7H7A4zFoPcrQdDRb1iUB6MXDdoAC+f3lgzjz43qtPY/C9vY7B9yBlPXSM
-PxsrYEH4Q5zr+EbQh20BxVkVJJ7KN2QlaWKrRQT88viXK5H1r8/3bHwwaX/3r++MpdRuPgFkqF6mJ59Y
-fXVSxfLu/AF4AgJI6uRQabDT35TSPZSVGkaeoECAnHaP6NvWUnQ7gQCRaiCglp9/eAUYjuuvP6y1otV6
-r1ChpCNcsZCpKTQ20EhqPFAZRfn7R7xZOkJua0N89/FdvLAAgJZw2086nnqv2ZXdm2ZdyQM+hEWeIXxc
-slANdByol3pjodh7iVwL9KzVWzQ9Ed5ws07uXhdJdaYya7TJ3isVCMtj6aEQzrdwcpdhVTLgjGTKWFKQ
-tWjiXl51wgM1OLun2IMnarsJy+cpyyout1qU+/o8z5sGjEdhBgFk6x/G0tbuNoUwga9Vrybe736GqRAu
-UgG+m43qAoP33ZaZg1DG3g4qzYyP2Ue7Cq40h1KfzvFFMfSS5IozuJhQfsENEUwN6nzYdiR4VG8mRMED
-UGSyqNGGyzZy0R2LV50W6VCZtHFHh8xNa7nh5SuO+k8zz72Olfm/8Qxd2i+HUheyQcBZenTHbDrzz6cP
-akJvAzGRdCx0MHzGX7faVE6Jer9xjZqQ9+bajP90pakWUF6+m1OkHOB1HB0yeF1XlxUQ0TDNYuVU2V9b
-JLFaXyop17JbbHOFsQ9P6NUoWFwAL6J4T8U4Fn6JFsx4fFGA05igsh/trHgZwGBfUPz8VYjMer9yEZKv
-icWBmBJIKwNAYV9rKr7by40kL56W+RVvB7LL3nbEfaVSCqNVe7m95GMH2uMGVEzkRQvUXDp0krO38aVv
-Tfs44RWW3F9afwQWwAYD8v7Ay3If3xyWljX5yi7oKFraCbsT4YAEQzOYzOpcWAJ357qDOC2c2BpaCl9L
-vm3aopqCcc4J82Vud0eRuDZlDEqMvC/cqb59YmKpAdcOcos1HIigwAKuDLcYKKNe8o2Yc4Pm/YC1vT0t
-xOh9VHdqfg0grJKpv59/c3O8+ojoaT72GaoQjuYdH1VIE7WkEqYZg9S/LPz0vziaspncZ65In9hJKCmZ
-LOfXg3hIlS9b9O88blzAm4CNFGoiiBcIGHBc57BLt6ciZQVNjKy+9mxYjDIGHMKOIaG0dwEVYytylE+3
-DN039DhZgPhghLJ1HTqWF3MpMlsfd/myP5E95N0aEXgrOdpibsiF6S+nWRfd/5vTKWvaAcIJ6D9opYLD
-DrmD+/xinq+Y4K+AlCd3JQh3bJTJZjTWsd/yQeO9LERgwyFFVydQ4j9f2uYc4/GWKSF7Kl8iIMmFSyrS
-FduXAZ3SXpOBaYjjkUfWUvLvySqXJGATUe1F0cnkFMffI+26MX/5KMyuho/20sN5oqfHBkpDWQlbmZ4x
-yj2jz5illYOU1mvtHO3b8y6FYVY3UEu3lxQH76WVOo2SLXRvXrDyArmVyqF42uoXSkyf6n6ordGLfv+C
-uNrH2dnoXot0cD3mCBzzlTUZNLa25s1motSkjD47XCVCKihwAL/XAORERn0z1XcuzCBU1XBAem9vhbaJ
-to4p57x0UplWxRVFF7Gowtmxhjic16gtmjvTFP2gg7MEq2H7j/fB46W7aHiPZy7gS5ImYtecsj8P/Hc4
-P9r5m4cBPGiXxap39CA206RHflJKvM28J5iExjnsf2nzVVi3C+suWulHntDT5v7m3WDnTn+w5IURD6JM
-A9/a8e9qKSEjSSKvJpbeYb86+nlRmuPSHUOR13PoIheOQFAPtNQGf7hoJAJFwwfzN7vbyVFahFQDP5+M
-StnAySveForbMJ2W9tlE4FW/8dlP66vgmGEL6PfVOra/dRnzdpVQxG6VKNDKIlEM9JJAP0v+Zj9V9H6T
-PQdpolWpqdT/zZv7b4HRrxEC40tKOG3HJzeedcZiaZwJf2+cLgF4lTpWiry8VGClRzWBFzdv86/9GIBC
-Z849iWsdr7K8eGLKimfbW/5+J7JSxrBSos/phUvt1Zu++VE6KxN3nJTGnpsoyzb2vpjOXxhdjL/xlx2B
-iibsR3/cRD61wTn9VzVVeoVCx5M3PASwRnxHSP6fOXVeB146S9Vr73xRFdfJllAe9Se+9yshMbX6Qfy+
-KrWv+ZxEWqWiYBBT3JyhJbjoq6AIL4yzUwlfIo99cIxRCrwi/9aKaI3b98d9ApW29a2NA6EjSyDYtKMf
-hnVJHjywrKbrpm+4YtR7oQJUqP6GtKlqjCsxfKRXCUV9fSOiUCNTZX3RTXtvErU7GUSWVIRXa7pLB/JO
-XaXpuEdmaf2pm5wH6Jleiv/mLiMpioJyp+qQqAYbA5h6mZm2LGaRUqN8Rzaz382nIb5FD/1bnDahNQB5
-8Mrw5T+L83/JObw+Eu6HN57mNlUY7sQyjD84/Dje7ewWsridnxhvkng7L8TsxEkqFrSE/jwmYh/oavWr
-KEaQc4fNXSeyq81PzJ8y8OwCMAXXJevXx2Nak6vdMJI5+tBubhWlTg5T8TKDe9hzeUkkYGCnpqkOgygF
-4ltfgctYXRMQ8PtFHNHLDLoX3w/vPoekE1IFhYQj8p9uk0l8Rkdr+oUqnvSP7oS02IVevuzMxGq4xf0k
-lePYv0PjNM1+wfcdnRB0ZXdGt31KPn/rHqLP9JaSXRrLRPT6himdHU2t1x7QEQdT1lA8zgpnbTIi0lPo
-bE5R8uWgxgDp5ffIn9dBDjesHSYTtNW33o7tAmIf0m8nfdjkTx81mX6b85uG3pT7C16HU9MI/fQ7INWC
-viVNXDPlgpSr4HjLG3L6sKTJEyOJ807bQxK41kugr0gUVlii1chirBxI8cPeXv3GbDRPzpWJ0ZnFDzvt
-79l2IrBJOj/vXP4AuwLweevJEvs0uFSt9SJfZnI77QUjNxAopOKSvVa8T6hHnnZSnz1/PvOr/4HLDH+T
-WJrrQ00D//FpQ4c80Lzy39HQESD/4helTPhZ8DdNjt8hocBAt1yCoO/xJG7CZVmV5TRPlMIMyKfTVuok
-ue12zraZnJBkfd/ifH2Sb6lL7zQEHvx8BJounEcTs5sq7mVb3zOjjBGaZ0JGuTcLci9XUgzLs7jzgB+4
-ITTZct0+FsIdj2GUX3ZAJozG2ZSPbLqJynHNBmzIzzK+Pd8N1lBoqJ1yJ8V8RoEPB5g6PUaBB5gEw62d
-Hc9TJND9vjzpb37i3HtEWbQCYaSZVS9RratMph3xAvThB0vFXB9D2OSpFqsOLgdNGuIROYc8aIsxwb4L
-a6aNWQY4g1nA9c+f9OSGEJKRN/fJBt4tvyzR/2HDBHKEngTgtYW5D47gHy7jzUp+p62uWMJpSRs+XlBa
-HiuDZhX6Wx0Ps1vow/UU/sP5iYbtRKWoa2THlWArp5V+WYxK/if4fF8onoG09ybWQ1KZUD5UX2DPMz8s
-dEO/a/9FQnMVqSpO2r4GfLcRRMS0/+JQPhuFkWbLf6hw3peZMXkEPzeN5eX/nlOb3DJLkushqArfbDvu
-6HooISYIJqqFMOrwtqCoKMUkFL05loeZjs8HyOB/8xt/z5la2HxVoiGsH+GIF/UHF1GDIpPYClTJUwRc
-k3H5LZz7JwYPFZw0XxX+m4/EEMnbhNePyN63Nr1NlpssDojBfeNpNwjRYVTS2w4J6ufd4yjd9jAsbTUx
-Bg0Bi3AoKnHbBA0R3OFkpGcth7jDKarYAhvxzHf4klxlVdxEo+9skJT/vW4mxzbHSZ4Re8S2hB5ZWHJK
-IuDtLngGxd3qnWNBpasb4K4te1uYMZ0hjhmmy9mBeAFvzbFYSd3Sa7ngAkJ+RAxVxCilyjM0z3bChCWw
-toFmoI7t3ZVK4HrkkwvOF12xBvxiIpmADf0DgIFStOy8mY2iAxrPmz6/QFzf7sCtQIaxXPM3f5bjH0T8
-bdNHhSjmfvT1Pb4n86NzkxqM4wFE8wAf10bthd3GAFenLMakdwszoSOtHM0D7uxMwUV+EPkiyhOHiGvb
-PvKeH8PufQcYH5vxmxd0RS2/kVUtgfahLl3M9G/9Ehh1kdXrYg/M/P9/7Z8w8Z7P+vmYYuoVGy7pBl9I
-gAw67R1rpV42uE6S3k/5c/xhsB8sF764cVdQnWYse4psNy9tRByE/oWd+nqXeIu5bqHmpARBtnUQoGNX
-dsx5MIFoLR9hnLiRwF+cyKX9Io3PRfS19DDEe6GJOVceY7XbB7lAbjDQgPUz3e8bae/L8IpfBB+zM7ve
-UPsjgS6W/56xSHVcjcfxVkkZ2gZ8d0qEGxUb6tCTSb4sy30M7otbT+7EWrpyiO1ciex1njXCZEgjhJ2U
-ikvZpl+ZLGHWo6LY3g4+2A/0I/e/8B2hPJuxQwJzp2ciRjj4yLIc1DE+6zhIzi0U/KGI7n14GMfDooja
-waARhVC3uY8iXQjK6fc11uYRiFxoF7jYB8Uh2JNn3itbltQa/l70h8J064o1HchpQBs//+yZfHFHmKZQ
-ib+XozqiCDIPwGaZJzsQQR89/YdCD7OnI9XdttBMtQE/D3nw+ItJnE95cqdgBXFCM1sRzfps5uQqs0A3
-4uTua3poVVS7D3X2/TaO8L7lH6Cp+lj2+MQ+BgN74sSIwvXjAqoVWWQJ6LJl+Kls8A2yHZ2OXyn6XWfj
-HkqviC+V2zbmsIb3QwkskHOE8pNRZgtd41tqjanu1It+1G+B3PR90y6vt9AZrFfnnQpj2JkA9MZaCtFv
-++QxMVDwWCGBs/GTBe4RGYgn+ptac85iQcAtOM6y8nn5VJ9oP7412rGnLBvLu+2AJJ50xlzSTwkFmbVl
-gGKHrL3fDFPUNvxFdOqz+v3T10xetfB3jdz03A20OIZ0xL4JK/efDvDWvdLlbqiYioX7ewgkOt6DFTUd
-a96cz6Uf21/Om4shwa9PTCLk24FRcPMZBX+P3XxSf35w7mTfb5S38uJb2Ox2Hivxmk8Dh+U4pZuo+Y66
-r7750nMqoRIZaJEVbonfRv/LKumhmXAbsUK4lUIJBhAw8U/Hsabga0H6haD4JVxxDSvKjnlvAnnTB1cL
-R9W/F0c30r+1Vh8/4pu864ifasyPFVNr9zMRFrgZtlbmzjpjzv0UCAFRCMgPSn/UR2auq5xHP607vXZJ
-guuRHjpQgHXodX+LzB6IAB93RSzf372i36aoVZWfGj4TbUH29dPfzdBMC/yG0n/2wEj1y9WDbP8G+qc3
-RggpezRCJ0bByjINnnA+XPZZwlwjB5X9sZY4oUpPVc+CotHwY/8mZPzg/vml7ApBjIhwLkX5tQJDP4ZE
-3qH3a/g2quxln+4Iu6TM1lE3EYR5iIOketpqmnd+Ac1qTzN0BK+Y7SbGx5ECxCV5yGskTUrJmLhfrBiE
-zl+MdTNIUDfLg5mswX54Vr++Sgs5mtg3OsYWf+PKkh4Goy+Szu+Y+gEV2Id2GnPnZ9/fURMVJD3pLE28
-PpjSGk6Ok82oof0H3bc1cHNrkgx5ct6HkacX+W1HqNiZO02fDLXc8M2Tb22FRtSAN46kTFz7qiAnFwsI
-OlZMGvETobM+AmX3NvxFIx3JcMj+rRmX+oV+Jiyzwo/V+wMxRlv93dq7gSvItn3i5FGcnaabMm4KZe4f
-i8gu9hnt9IUBJZBTScdGPbrVElni8ZBpFDYKLFwep9pr386dzTg+zYDaMsVr9cgwt2Oeh49RFpNYle/j
-Zp4k/M4MXMCkCw8csDV2J/xk91VIDV6/4kwziiDDdx+N16g2eX/X6Pec9savygZNBh3or92wkh2fW6Gu
-M78r9jctUU5G2F6ocoIbsUOURv0vvx+ViHinHjSv5uRJPnn7tpSb/Q6WdXdG95kRwResv3UtOsuMRCCe
-2/qqRt+78IzvigJnJb/bAzXj8Q39PmrAcY+pj3ug9M5ypGWLocxR+t3mO9aZsbAp/vlMKaPRmbsomER+
-PZX9KlNtC7ynbPuvHYSGxug2elY8y/94TS82Rr5dv2Vc/elQ4EhTdno/D/QyW1O4jX9rC8NcStbPCeTU
-TYt1+Pt73t1PqfjjSUcjtPe7Owr/mw4E0MPlv3540qtoWv8QOpXcQ4gZgPaTZVcH8c/XJ1u9hg4UlZtM
-Lo2j3nxuAf3nk8HvyKq32gcl+SWM9EJxedyxm9zHIM6VBZjysuJ+R5J3BvJfonOd2uhILSsxrIQTubnR
-tZX3S607sWKcDOVGw4JE/MPIKDSGM/xe4RAo9r97Vzx7uC6HdQepdYHFMs0q9NJtP3L9T0vUNsk02bsd
-guUNv4AX9ptp+Ux4osNOluhtgg6s87fuDs/HLDtNTuqvec20Udih9KjIjt6dAULjbSR74nsfuEuLkVx8
-Yuwi7FGJBiWvPXwiXaQRp6H6kd9/a7hNrjL3D1GEX2WmXLyp01KmTd1I8Pb6BaIpJGVQ7szfnpegY3rq
-7q3hb48PMN6I9RpbteSbJ28zJWQ7sZOO7GaESQT8ydBVeRgP9etK3zAxy4uEETtIDLn1aWrIvZ3+nln8
-SgR6XSenetriYXIr49crq0tzJztujBDux8fXng2JaihGK6JS71Za3zPAf8pWqwIjE5Lk4k4layYcyI/O
-YvyMpjIpio1H5Ste9WZnIoues7m7INJetDiMeVcQhSfkZ6hnBMiv5siwnPjQ7MrGbiP449ImBbvlJa7K
-+U4mbht+ImrgWyRtF7KXYwyogV77xOmBEN/XiOrrfutrnvraSKGo6HJaa6XLp5Duv4Uchv/TfrP6epE5
-947f1JnaDJmmbBuKqgN07mV1WPj60HsgKSv7Mw3ZGNgGme/Zb+y/icWobcmZE/nbfqpwGF+VfUneGrOs
-xmvWTJ4noQ7K3zpjpBRdWT0kxAJh+FvjKP7NrfKqZf7tk+S1Gs3rlztRoFeqwH4E+0OkwMZj//ZsuSLQ
-CE5LZ9B+aUtEZ1K9RpX59whfUCUx0tyEVQeVaboNLeyLQ8sKk20f1O8G8jhsuYptktrHbJQESoT16wt+
-K3cqikAbgv40QKKYpvk0SaVzp1mJuQT53Q8pnBaSgvaqncqieNBKz5QKAj73Qovr2GYaxiQTJjq3mHtU
-NN9OuI7X7g+x42Kjt+c/vPgqExlFuKFR0rSaAjASCvn5Di4pGK9YVTVd5//lGBsBdRNndPHa86/9vvyn
-+dN2BP0Eo8v7RiL/0eKMTraqa+bf/U4Je9r1Iuzn0TIFE3XWNBAM/eptZ2ckV2g22rv36Pyr5XD9G4NP
-UwkspqwFxQv7RXgMfqaBru/OBvywk+8pKJQGqGu4WOKKbqbuqv5qvWrNW17Tl4S/q6XA57qB2vDrleVI
-I08LHXzF/U25R8bf2uZ51O6wV7tfsHx6lpPHSFkdCtRhcwf5X3usClnW0UN6QzOWMJnwGFJh4Ymucbqc
-Orf2p5vL/DJd0W5Xiigj8otWrd67RXAG45vRuSc6QiFiyCMRSpwSwx5Fv4LPFuxxUNDV8c2nBuaA98mB
-ZF5u/YUJBBucr3SGVcf+SqNOyUKZPH7ynndbzZJuZs4IK5duknAumFjWG7hPeIUBsfh2QdJ9QwubcisP
-vLPsqzPH3uxPmB//G6BvDJYGEuRl+UP77wLd317LlQhN4npqLJy3dIeExqnnxJfpKQqXRscJ1Wswbyn3
-u6zzIqGYYK032iwUBPpvBnKdtZja2H/Ldbj7twDtYBzwxp4OTtF8c+Up+/WyMDgLAc5W7GsbOof5wItw
-IWoGuTDlQHOODRjfmyor1MXnsS0a3RbezkP7CtxXY6Ydicyzr5v2Xn9rK8z5ZSIMTizhu6UjuHyv3mJl
-xBWcn/ajA5ML53iOAMcQM9BpRsLipCOX8HBJJMbyonn0BaXRExV/TyKtDcobF2J+EpnmMHuygnwx8cZd
-ipcJf+v+iFPuIqCHoyFTftsS6KdS/e27yVw0j6XzK3F/zz4V6+PSv7eWipNRRVZgRhf5ijKaJMMrKtaa
-d5RrSWGyUO07A8q9+3uk4qg+CE3ebT+BLpYlcFpGCY/rxdvL13L9gdLgTD5N4J3zQ2v9jfvb2gG/QSf5
-yqt2mOZix4F4j4lInjXO7rXaF/aF0U+7ytf5vawm2Qc5bORqAAmPVKYnSSyHUWZrsct3HNFG7l80JbUl
-QwgsoTgKecnqBV7ayQ4Xh1uJbDv8JCg+WESrQlONFGaWy+ces6oSKw6GaYGj6TfQRUd2gtegGaWXXCDO
-pr91JRFUHkqPGzFBliHvHOXPGwHcqqvz7jvDTdpDOD8CZmy4cSYyt1VbIA+mN1hAGN+yJj0oEaMEVA84
-Ob5fRPl9E6/psPy/xhyBVtpQIC3Rs6ya1V0h5s/4VeTwkT5UNO0A8639wBANPpRQnq8k60usJSAnoaHC
-ia90CgKqpjk3NnXFOZJ4PJ5stn8oVL5waZfJDzqHVCp7YpKy6joZf2PIAp75d7DCRLlYs99fON3FoiBO
-AcZ/mBD/1XBFjKVCatks7dRMYjxq8rPjNXY2pMD7C9O3L203yCD50pA0XOgNz4ZW2cjXEmWp4nVZlc9H
-qXa92KsT1+lAlFfzRA+P7l4ghB4MfwTgCfvdCNAnZyXZZM513Kf33/pJrw3T5y0egBs+guojoteowOFT
-IYa3ExIrEQmrJY49gpAzfzeoeMj3sucf1NyuF7r/2Rece08lYNTqbmUBy79cv2mckL+4QryAhgLpdaHZ
-vN/loLXs2KIU4UiEam63MGwqFAuwyDUgJ1oxpbL2TAo50mHDouC4AXmF6K91Z9C9Bpx0153sar84jro0
-4JOAlYGIUoPHYDozwmEI/+T49VYpkKy3VVL41sTT0qo8y3vauQmu+LePuGVfdjq2cq/vsnvKm+Ejp9yY
-4wVdg3o55ZtQqAWFQycmSxv7SMeD59f3h2EkLt9mS465IV7A+1o6AipEDFE7+M5wNG4EcIqgI0gqn8+w
-Y4kosmXL9tl7wgw2HmHGFOGALIz81DmPDxDa+ZvfUn+S+refb/8Eb5JRvrh2xmwLU793fr4eRJi3stdR
-Zr0mGCooGcCeRMisrvlKaFaK53qz6Qkuru3XsuKtLfE3nFe9hJdT8ttRzJFhZjRLioENEZhA704z+E0i
-0Zje5TdrC6oOohW+2MkPdIjWIpw2C5jRt6IH3nmrOjCq1ZnmR0vHHBE72NWEfvd2ARCJNbZfWNSnb53p
-jwyO6uesgAac6N2XNKieu95jlvMyzYVOytIQI6HMziz+t5/6NldpS4hLrU1Bpt30wy34tWk4hXuvcs7L
-0dSu40Iuulzm67CDBLtqoCP031/tpn/rak5IOnVra5NL+kJwlNtOvLbvlmmT7ovuj2ss2CTXW/lO9qOc
-Q8kbHDpiIeWE2o0Ko/J9QX/3Pu81R1B5jhMJVTJzX/QIAwhRBGDVeR9fOj9iy/D+T/wJQBwJ7vK3pnrW
-oaNGyDGE0w0rFiEu8u3cC2hjHUGFOxwgDLtISX4cRUnl7YmMEkFdvQ3GvqSQHIx9LntImRqfi7N/yG64
-Ln8q7/dBbCWMQgc5xi9odVDIejst6scWN5UKXVaTMwWRWqsxkC6fPNV9ZL8+ltj0Whzp5dbJWbvgnbw6
-IyFkC9QKQK+X0+fnFUjsKEHJb8/zwqLpEyQCK3vpBcyADqDJhpQHemtfF9WzYM6FP6595M8DCi+DqS47
-4DpqIYB1ORflWctIdOfawt9Da5FjfGC6tT8tCwS8XSP2FkToR94WH93WuDks4nfgB7sH7dIKGSDpndqV
-5w5o5vVugryIk2M5GFFunh6Cum8j/u2TPQKMadTvs0JxYM42GRpVib8OAX/dHwrkRzbOTb/Qry03Jsu8
-OE7zmH/PBe//rB8G3mKZX/ZefGdWfkoz6VEJzyrixUAyjAhQlu8qCwDE13cYnxZ8rHsj/xw+8jqH9P56
-Uv6WQB17DaeF1Wvx5Z9uKEMoVwZMnkW+291YoPFxw+uxfKUDhr9T6g9tk3Qa4xSLg+xpowJzdLObL/Xg
-QskWV/6IvAYMpmWlppShZjGCyZKiNp2X/Pl8BvomIAQ2AFUxOsURYfnYGqWuJ4RiIEKPiJKHRX9RzQg7
-d+DmGU6cbH94AYPncEH2Hn2FbQDTadOZ9VipjDIzX6P/Kvqme9z4t5/O0IAB/JsH/FtPMY6JsX9HTTao
-Bw4QgshdnLaeZ6yfmLYE27EwDUUJGtwdSoXAeBV68NYNT3eAlvvP/iNXF5jMbIbnDwdBWbRx1dB0+mEg
-h3DsHowP8V5mmB/xi9xqkS8fooTgkfzzZvrfXPNdN8Gd+HFfA08r+F3u7vCOr7qEYhDnQTTU4AhTpU9s
-2oJ5/aS3c/TpcNE5SPydqj9YQKNWEu9LQ5iCi10xWgliI55McphGlmPEc5Q4aZFvENuZ9vPyqT/x11Bf
-sH1pwJ+ZEzA1gmETb65CPpo0681UaVxCsA9LIJBNaC2emTxQfZdkan6ZfGbomYJ/ujx4MJLvvtsKnF9+
-bpTDUSuWmYp4Oa8dIuo0+bcGdvqAca+yskAnB9AqmjHZTFOvS8cdZ5ww5SOdBlO2wOpxUs+xZpUm4dT8
-rZnsUqp4ttX67bdz8SFt2tk+Ct/mA7fzJ1e+aCu4z/Fz4oiAsr795qYjTI4gxrDVip8WUGPigjBZhMHB
-8qP9zEvdWH8Ylx2GNqYoHZzM/3IY7cdo5yH7VXKZ+P/bz6L2kwsGR587H//+ef7zlKs/jYn/f8j6jp1X
-gSjN/TzGbFmQk0azIIPJmOgd0YABmxykefcp/tstdasX/8LyNVSd+H1V55ybcyFOEspJsXkzU+uukZOt
-8v8Ts/UTriO1xNVvmqVYhd9zD2CuE0M6PZolN+BFFwHYzTlhHM7qkYUTPosu9OgHG1dhPF/qOffVUSJt
-f9S3iYBF6Vt/8fqvt+6Rrap+ziOSrfIAQ4OSP0u2XWH4y9u8q1LKmP50DaPgzB0gpnDTST7qEE4dgOkA
-8zJ9AO3+9GQEO/DyNOiElq62WZUpiszGatQqAQmY6SBE3Eiy4I/T/iqCbQE3tUWO+/S/50qEvkG19ax5
-WbbiSl+bGGGraj1YyY6YNFu/1Xio+/+C86LrvmufVd4tr2I9ynut2MsyhLsWGdomalEdTMTh9TjKrXTL
-508hdGAqktkeCI4/uqWdHPupp8b+4oIf7qWS5weHfd/TvC1+DOEYWyWRppqsOOAGcO6ctk8P+LTNS0Eu
-qg46Ou1SRURtxdbZ4PT7q7yhdqXn7KYKdJ0srvb2Uzr/RIQB504iOlU4sJLTlASz7SdnToGsNz088IDX
-qjevtUPrGXwNjB1eQRiGvPywB2JiS2W2aH+YRRhWCrwSaeayzO6VZ8x9x8KUMAy2hdbkf8OGpphg3eyk
-gZ9o769ArdOISGrrVIlDoHaBa/zLt/bn6mRJ0F4YEfz6N1gHEv71gdt3TfU518ZCl+hPZl/f0Ey2DILl
-cYo2IiOAbh5AN1Z2n3Ne10kqG4yfJ8CJ3xF/fcZnrSu1Fns3XRtBFgFUzTOfE+Dr9W6/CkasDlx4XBCt
-Fsp3bdS9FwPbcbK38HtG5tt9IsV+cPKjvM/3UuWYPfVuVRhBbOV35QFTsJrmM074y4QvFyZZIkK4b31d
-RylW6ykm9r7MOmcl6o9W3/2ZXwOCVZDHLRNsSDrHkVoMgZDhKnITFd4CjKgllMP4Ec1JBkBBHZXL7NeS
-e+BJuvfm9BROMhHkRLps1+lLTeFGoC7i23ZsWrhaLPirrsQd3km/mObSgYf5gDecmf22+6mPbmOryYuK
-4cEeq8UWq34+0CIG2g5QDL5g6uDeEDT3OMBWvE5mwN78ew3e7gG8Zjjq3UvnHZx0z9pILKXxon4xDCVp
-xQUiGX1cUrj28dweDcI8sH2xC115HGTZ+DDg2p+U+iAcseCCdmNvexr31ftUH3L/Zv00pagchf1r3nAp
-oByCsOJM4qhpvehqHoMOsnDYCGO0l7wA4+9eY/jHxt8717x/aSA/ftYDK2HMc4QuZWGeKov5MF8W/pcD
-uXXNuFRB2aKh2AKhjPy6gAeNNr4c+dqBhTwF/88+MJGRnA0zFbRgSQEPM7xITu+vh1u8Y2iJgvBT4gud
-Nr8xke0Ovaiks1UZK/P1kKvNTorWmC4TOV6T/2NY4oVlavuzHwf6+kYnDlXX3Tf6IHL0i5zjve8y7k8M
-hl8V/TvIpMQ1T/wM/qjpIgPsz+B1gdk8/O6DHwkd/SjwcMWcnFTwMa3QAzXYMiBe/eKtewhvcU7SG26Y
-k4v0u8IRu2L4Emr54VerbJIUKRrINmW23LSk6JMBXBPKD9SuAV+1pye2zI/Zajb6YICN2SQIhaLeR3Dz
-Z2fpBZIgQi/HY9sMLYJh1bK61qycOezJMVXapvD7mK69Ss2tLowA0jlu23F6OTy58XnfmUfokt2QDtkm
-eaGxpYaHyYIkH0che0/Vve1nIldeAM9BRYj8w3M5AU/nFKOpZI7Uv3r5edrgXwzQCalNS/84P83fuYp6
-72cx9NNsjnQRmoXaEY4elregr061YyA9/WI2CsjXuJJncv7dB6tTB/MuMHgTRYtnI94178jHeOsUZBGU
-gYNQ43Qkayi8ArApxwWz6lPx8/tns+icGWBf8wJvLaQoNEQrJULS6hNiegPCX+3WFkKxQrNcrymhvMI6
-DL1g1M81pF10821RYAFEQpnZfUrPf+f4uTVbo40uXoyyVexSpI0f7Eim+zeOje8LZFQJg+qrjh+Jy+rp
-OU/6wosE+Uk2wFuRnCXW14eUsPm+14mGxXCD5kiW9+L8aoHTzo1S1WP7l+MerwzY7bNM5RIZadbFRPVV
-w/ZGuSB6g7waux6wE/GW6yOUgiC0/DedYlbXLGclo++AM3GY5R9sWrLiWEJJTtdX8DHSu+aeD8PYEC7y
-tVTjCZeik6vfvzMV628eQpP6whNZjOjGVu0kg8wlrMI50lnTvNllZ+nV5QBml6e6EH9eHGJLprA0RsCX
-sU7cqdTQ1SXDd9X7Af7UnhY7RCxyrwkguDgTCVj6NpcI0yXUlg4hizR9HtGLAakkmmZUjV5RMvnE3ULI
-YQbZSmtRLHzvOLT7tIpYieWXFTCZelwS5PzoKa3dMPC8egl/f38zOzzRvu9gkFXUqFd0bfyO40+4bQLE
-6WxumeQziL4PZ03vFPNuW067Pvd6dGWyjLtR739BllWY3z8Kr8ASRXAl8vnNpf7JG3DVQmqd/OUvdbh+
-+JcucRm1vGAaF4W/+4csLb/r4e8yvpsN3/UR2qE/Wsb5u4d6oyUIyJZdQEavHrSyVJ9vetd5vzkO4DWR
-03i8QNgaT4KitI06jJqvBUVn+gkn5qkZxrQS5ZqoyO96mICtP9nmvBK4xBuBewkCYBw7Q4jpo+OZhJP6
-i1YT+vn56Xft2T0/rYvomiRpnHBqP57z2FlWKcOpRfbTLPumz8ZO10nfzXdzT1zjXM4QBhOAtLgQeBAJ
-X03+Uoof22HdH66dzXPpq0a0+nMXDZo522nb1JxrW4J9562mRK/ksMVGC4RGugckLY/gpauFFERubCp9
-pnHNd9IPqcTnv3V2DuY12pFl0Mb/+YKp/GEK9/z1vzQC+sGWMAMZ4F3s9DsoKGuIm88jeHIub984bjkg
-7DBmLCYaemtDqQ9nim0TRf3R5aEYgD9p69M3zA8wGuB39+UDp3C7YgHX0lrl+CRJmw885CCiFl+Mya4H
-PWv2ZqCt+c3Rn/II71XcuR546WAR9WVA7ZtW3TGV00nsijL+bjTdnb2vU9nwOKR7xmjbr32cabNLVb6e
-Yn9DBPXgpz8XMUK/6Tg2o5ZsxsJU2kRcLdmK1X55W0mmGKPbZi5+ilSwr0mAHqyMP98zMdcfbsruegTY
-zrAvKX4hF1HesEhWRr90UvZjqxExhvfdF8re+PeuyQeOje6lvOvO2gZej+OsooYRQEw6SfO0vJ0C73nt
-OxcXP0bPuUJ0GpIsEnfdqsRz9NXJfmJ0dVVXMMU2j67EPcTSShTxSLAh0Vf9JhpcxaUE7h9EqycXt3bz
-c35IE7c+lnY5VfRR8WlZNfo1A1j0OuuTxhN6m0qp4IAbiEEnueHzvksFnI0w/+6p3vSMm4H71OKlbxiU
-gDSDmOz9npfSEYXXPBsdIRL4zSluCthyv4OcFmHfMe0e1PGCCATZ/BadtJt/NDmz6UH8Stoi+7CT4O2p
-CIJB7eHJ1Srf0Ab8/GRJHzvxnq6aS3jxkq1dVR6Jx7UDeT5eErA3f8x94P9LWuDCp/FbFofHg5paC+Lx
-Cvf4z/LefG6Jg04X6m+iPNyn3WrpAa+3PcXFANmplOPVWYcYGZWAvxd7bxT8pWWvWoQ47sV//c2jnHve
-A6s+kZ8RGf6OybOCxiwkBwycNlZV9WZdi2mXdI+bJihH4Pb8FyI0tIMYJVyxu+cyCacg+4SPZ6bRQKBd
-bfzMJ/GEsfTET+7ul2SiBol/8ocoYlruxp0dhix/DPD2FN+nw1jzYiSX9frMxtsjHd4uKuSrWtHVYh03
-OhrHTd3SDXcseGL6CbBmjN09tfLI3fXni1HiOl1h3KpPRMGi9MlHKs3wCwt7NfZGLBYXxswO0PDHOqza
-AZ5SXoCAldIpxuX5kB41keWV+r1aGJnxZDX7jI0sw6zCkmb9VW8rFeXT5RXkg8xeQxxIJ4lxV+VC8i3H
-N0jZavSbUlZNsfd42fHHMCgGB0SrZATStlfT2pxLX+0JVerFiD8+Wg7xCv1AJIMXJoguK5fLDjss/sFr
-zkMd2WrODiMPP/ABjNRTZf5VgPz7298QVZI8+pgH7PAHfAPP3QAfKhv8Mchkjp5TLYKFsaRjnwGfN76v
-Z1dUL1kQApvF0x5X6fbTzyvNiSoMIxj0rgS9i4azgrvumHQ4Xh9L97l77QYYRoM+2YgtZ029ZTIjTE30
-h2n3rMDoUyN2aRCjYSyQGIoFAFDZVvohNc8w0u29e+pP9VoAjhS0spt4ykHF/JDy0DDOHJbZt7CKwiOc
-YLKqu/6usZlvHDc98YWymU08FpgwHRFzLMFbdgpD2LG7cvXTkDWujvUjnM8+4TbHWjO34DSH3u762qCl
-9/+8/3uyr7SXrSxFja17Ida74b5TIvBfzcXDsm0eQ8zCYY8j369jchyARZcKIZh691YL+J9OQVIRU+z3
-jZomGRK4tVm4etcygJaq45T0A4NthH4sTtTmi8ypB7ojvnK07IqVwKF2YKG75DYGrIz3eXQzKk8KzvtN
-5VT3ZwCECr3s92o8cSt1Bv/po3A8HC84kvxORh1HjBpYOdGoi+lY+GLMMWzWgdw1OrP526y7rtQN76k9
-3xibVvqHix4ZbvuebpXbsxvDc90lsSNFMt+m/wAytT+9UP4drz5vbQXiiaIvzWyG7XjlynB4MxL3ZhT3
-E2tGl4eNG1vXfGgCiIOJscFc2F6wu6ZQaB0Eg1KdwNZfS08tJF8jsFrkG5QKUVXqPJBm8MnfkmkDmh47
-FwUANuBlv0G0CvqqFiXsfrzb2KP/RDod8NtKBPi6t4oPDK+u1g6Dwy8HcaKE5JLDT65J1GMfaXYD9e6Z
-8Zz2zlJ1NlCcFn8kVfbqPc+69Z/wuvrM62E4hRx+vpeSXDcu6LSF14S2/sOYF1RG+FvDDSOsSRieP9Ur
-HIFpEg0uNLGN3rXeq3GKGClrgFS8tXC99aZfFCK+bJ8J+O0fFuzOWN+EeRGParFqTvCed5746+/tF0AV
-vvEL2BuR5HgBxCIBZJ/L7YjVG1eyEC6lNAQprg+ew+xyK2WqSQ2ZmBHYwnqpeTrb+FhgldmgLHaqwf0y
-JkFT2n3cf/CRY71aKxM7Y1Z3XdTcLq7idzYMZA9P1av5AJ7t0AZNeShOlQp0691XF7F5b9ul2yJOIspZ
-SrIobBbxNQx2FWSUNKv3Kwi7pCTqHtmQ02RJELHGtCeH7apiDeMHYbSebyRiqjolDdacrNdUTypSbug9
-H7jOu4/bcO5b8GUDo1sJlRgIgG18uT47LU+q8N4GdMZJKrEN9lVt8udR7aJ7jzJmihTGsr0Haaa7e/Qa
-M2zA38dxJkKQCGh2T0vMnM2brg7tSYb1VroThAaFAFW2duYHEY6VU378gNtyQkrrN1t37+DcXGoMMQMA
-ZNbZ1n3rmsoOnTCJr2wQX5ODV20fMx+O8n3qdYSceQUUJcYUBTifz5GKB4vY8gl3EfjXINZQGLheQKS6
-4K3+7gJ+pr2Ua30KZAF4YRYAvP6Bi1bOW9x4PaoNrt+cQIm5O5CWsHZouM3EfXYRBRylSKfNQRfgQCJe
-QT/1ttHZDCPb3i+ergd4VZWXByf1u9uY5aXfdXipuwQv5jfHtcVLioBGq4qWjWqY48NTXIa1v9dKv0Wk
-fCtWG2Xfwi0jDfmc+IIUO4dXGIFWIMBmYUdeOWznHe147puT/tXXFCLAGlLSPsqqD3KWJeDmrsMiyDRf
-65VBeHcf2ZVmQtXZPlj8WV7hnP0KHRh9O7XsC3tg0aHcPVo678PAPi70eJYlo4iBzAsgEP/ClXmcPxyA
-D6Esh++dY05xOgvzPnidlPddm5wSAFn7cn5dRkHGG5e/8i2+6MUS0dcwDBg+ymVrfZiKntSrTOShx181
-Ps2ZA7n5NUSFHdtsNfjOs/InpmPay64S5IjJ4dLvwfQM/mlfowExFE77plGbGoCSPy9qUc/o2Hpnu0/4
-V6sU1nQmDLcsaRa5atYvmdLBLSE6iMrV6OJDdXjctz/dlQBvRwWY3nubrmyyivG1uvYyPNVMgH/SU50Z
-aN6dH9p5gBN4WFaVbUJLsSovEMLB8ERBmx+Ncg/hlXa0HxbFHJO92kv381ycnS5vpBxsxiIqVe1KnYbZ
-3obar8+KX778mtwWUpxf+zujOCLk3M3lEsB7pEIGV51dVBVc1sMI/+ZOIazusXbyrXj07slRLxSNm0V2
-9MT3jodsr2dsMA20GgaB18erUpEldXzhPemItQAdjgKwTYK2ssnliA7eSGNtCFETXjb7Qkhf48y3aNcF
-vB2s6EqC7lERJJOx+E0KzuBpNAzBwvrgtLDw9+U4wK4EjlvY2dpweC329IiE8zvqbyKxNZWDRP4kw04B
-UJXHKLxkaXhbegRWAprjdO4hfZK/RjvI4g0zee1JqEmcH6BLMm6eQlIT8us4IRmfYfkawpyfRVONViqv
-7yGD4qAPEs/iI/usqyQT/7hJgCo+sp+mz/lcrXBHU6geEn6U/dazP1bX195Y0xXtWCEI1bxLVARkyr9q
-cqUZTENsifY28U5EUx+hntO2ejVYldXWWLhMUXxmqWAYePXKoZG8rK/gtwoGWCPNpJ+hhmAQFjEk7xuT
-+x6NALTl3HPJ2YogJ2kJw7Ia4EJra85/M/YWFT4+ya9cyE3fQauS4jqOw1cHp1m4Jsug24yT2eRZT9kN
-q4SsJGIhIdUmMF1zLweUFQPW8aHKMqBhSB/fHnbag2ABQn7HbzKFaQaqHMa5GFWihuBqCn+Fob5hq+3r
-i4TH2TVjhRcK0EQi/lSchao3BXaA6AdkHqoGq1gFFT8Z9i1bPRhfZiA4WRyRgGoAcTc4g8xT4nBiqy24
-soA298OEQ5b+CgEn2GT8Zu4yABR3kKuS4aYzpcSCp3hbU24TMfTCwHpUZ7jguhRPpSZKtd9ZIm40EFZp
-Z4sZZ72HJwRpWdKUs/oJUzkuGm9fVDAF/LdcHXR02RhxLa6JvGIbn1KjMqdM9mOAAvQQuBSemRyB2mdt
-V2y4nSz64bi3aL7M/hcjwHzfvAmMejPXQmkJRnM5gRNStD79fOA4iXtMevQooBxQT06xPukPJ34gQ3Km
-rqP+hj65muMSwbMQ6ui/TKHywBf2Bx5t6zOTCMBY1So5F3d7uBzPKdS+pF31BCmFk49fg9pwd7/EaN1u
-LCBgnhqnCrzhXVvefMCjxP3t1VBEwtTPvaO84KzWSZNRxdZv8GKNN5HrSUclvWg7eL5DXST1H8/XfNaH
-/61Pl39hmMoLhqILswHDBHtplgijSyou4fne89sGEQ5bg/Tv30tfiE1mD1PjIujgJ31vWBak6mGpY2HD
-0v3Z4O+6hQ3AkBgom0uM6mnCmITDDNiEsOsoHm/hsMoxvf19L1ZPMqRrC9mBzIVMpthdh4K+xedbNO+Z
-1ekSwRmjAaJOeKsBvyQ+SMkeOseZQn8YCw1i0nLLd+YGIbt2QeL4ZEtfP3fZ9G5SicF1ubDZVtqI+QT9
-9/2QjasL4PzeM829t4jDawA3/h5zvVOndIEGQJAIw0iuiVyVaOj1t1416at5BqIUuUzzK/uWt8KfRYhV
-Le7VpQvE9qjNTd/uAufpnuD/tqTHhgf+/ZtUH8d82FT+/p3Nr3nxi1E63jGgJ6DX3Hfq5bMD3evkMyxi
-0n5URE6/5Ls7V0YG8QKmjZ0JWAjn/ICML1id1p6uJ+JHg41xXzk3nT0naIIBihfL2XrS1Q7WJNYbqbdT
-tL1Dl+ME15QbyAy7GMiTfwPVcLE+QOzVScvrFoIsPApyCImc/YAvxTl+XBhWyMaDA8LTi4bVA9/Za57j
-DG4avXhm4q+E07dxcrQkUBc/D9WP3hICCEDjrCfjOFCM5rcN7mb2QWgKywKcXrUv+F6wqMeGCT67gpXy
-iRlT1hAQxzekb5lHugLN8GJCR2PceQUkzYr2nSU5v/eWo06gj98iD9hcBvf7DUEo2DEcWEbK78+X4Fpb
-LQipBxkSEJ0JnkepJqsgM9AJt89T8+kRGkMGh15HMmP0WbxN+UsMdQcTzLAganoCEZWc7TIwuqaRaHk2
-8GGhAzi2SXKspCNzut8VK9wXWq/mlcy3UE3T7tppBw8T/JPi5nrQHcfN8bl2Oemr1iwSVnERtRm74Gri
-3j7Cy8+RfSvXuInwCxj5w23Fi1hHgnYYdjD3vLv3AGB31QmQ5TSNx3Heu7goY9v8duqj1uM0922ihoWr
-wFOKSBxqB3jv/iw/fbt5LfXyHFaSYM5tudfsPeKaxK61F6kd2KScTDE7WoRXViRutjx7KPdv9fWjtIDK
-hmgUmvDv/bjfId19/njv4IWiEgd4h7avNPGL8e+XYYWrmjnilsnnWFL0jdSbNkOJCnSmiZ5YYJfCGPfU
-LtWcrT/HK5AFp6psOb+4ujzac/e/tzmsUm+tlNBEv76b8a8QSiA8x/azJeG2OdgL/HAGalS/nfuqnFU2
-OvywoIin72dqiY9LNM1IdoLw76DKHjNwtDpY+6GdWYzaDUJzNUH4GcX4U5QYp0cvb3eD1oD/WM2x/TAK
-SpmFCh4kL4ggO+/sjyxW9PNuJUAsOc5PRvHCkuXZbQTtvY5P/DTin4I4cZEqo94zIOWtfkwhEU5vV+ud
-UU7IxiIoksXMEpOXK8VSkuaKgFJXw2+FneQNESjEPXOLfdCj5ib874fqYS3j1cfu95digdy8i33wfCtc
-SscWExkO9YrHPMYjCDp2q12x9/NpHJiqcV8+2LqrRByPvwDojXOeZOYIKQAiQUxtr8Vkgw+XTCW6KK3W
-Sis/zZVt6cC72airdKirHkzdORGLr9/qm11Vlgze0f39B1vcMwhtTyeFRNP+7//+f//nf/3/AAAA//9Y
-UjmD2msAAA==
-`,
-	},
-
-	"/static/include/playback.js": {
-		local:   "static/include/playback.js",
-		size:    3204,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/5xWbU/juBN/308x/+ivTUpLCyudhFrY0x4PujvBgo5l36xWkZtMmxzB5myHByG++804
-T24JoL2+aCx7Hn6e+c2Mp1sD2AKpvn05nMHvX89OfwFaQlLkKC0fHarbR52vMgvR4RA+7ux+hD8VFnAm
-tM0lS5zmCUqDKZQyRQ1nF6fwcbIDkUGE0z8Oj79cHk/sgx2S7HQwCEqDYKzOExvMB9Otv02RSwsLre4N
-6hlYXeIY7rPc4gyWoiBpUpturQq1EAVc2bwYM8R4qcUNxqmwYgzLXOYmc/bvhAa9XIzhRqVkyKKxsbHC
-0rrSyNOHZlmgXNlsPAD6kT8tbK7kuFsaWpOutrHNb3A8cILTKVxo3E4xKUQtBkulobqIEzEo01hoLR7H
-IPHBxp7xf0osMXa7tyK5RjuGVLVLlGJRYOxQ8wXmgwH5O79DrfMUPcODbgkHsCxlwuYhop0hPDVAL225
-AFVaX/GZbG66WTPR6FMUJ7FRyfWkkenCjjJRaS5X8w1J9kOCnbtNgaRQ5oW7502pZVFSOt+QSjJMro/v
-iKPmDbFESYmJXZPIlKE43yrN/8KYe6VTXtmsuTf/bJabSUw2YpYnA/yZ9xyzITrmT+9x7YFEonb9v4MD
-VyvEWkyH8GuLA2YQBP1mbFaZoG+POu32qbpYUmnYKFzkUujHcAzhvQmHm4LlLdURXnKZROGFVlYlqviG
-2lDISCe4tK7aV643ZEKmJhPXGNR2KOJMqnWmv0Yp2pd4D3+d/BY9hWR2hTacwf+PopDZlQh5JwjguAXo
-/UIlrzqgpOXBfq6xbDI7ov2qvpcQeeAohjt+wv2GsMZ0bjCTat+LWttVSDbUpZQUnLCORufPE+OktYLk
-GDTaUst5K95CGx3A7rwH8ievL60hd70v8nJam/bRtK2P8O7U1rvOxtTipBxx/ofDCeXkK203Nr1SikK+
-E1Fih1ixUKvSBE2AXzQ2F3omxouTXm5w43YwqTur5dJwO7TNIsWi6SU/GVhn8kVGv7cB+VFZpYFTIERR
-F6j9NU4M4cMHqE4nSSb0ZxsRf5hGwXMw9PMxnfKMmhzholxFwSU3xApDAKMuD166Opdd6n8Cuce3WoMj
-cnx+EvqofEwnjjGYcqCpkQDJBh6e9Tp+nVhrXh3+TwcbMXvPv1Q0pjVWWua/gGhRVCPKkQFFwaxeu39N
-KagRTmhYJxjt1i8BapIpPpwvo/CJuL079Btkq9hfIrDtV1Kn5xjL7mr97cZSJ8KwK7F98umB9fU9QtS3
-5Z+p3NNoj7zXg9NpmjIgv52e3lHZbcRdpXINLh6puuJbrRI0/KpzDWPQqvTW7jrnLypdnhcuurNe6rf1
-/hbFuzngYtymbyNfZL6O0yb6UaNRt/btylDXSl6+aSoW1QPTTwsjLvfqAXZFj729z/zEifoc+PVNj8OI
-dXMXS/rs92OCfDTapEG59z3/0V6bO88hEZ26TxWPEeRDnyBtkXRvN43JXUzVZcQKaeRygEN6LZR7z68x
-5X1lv4aq67bGNsZN1dJeHxDMun8DAAD//xyTh+CEDAAA
-`,
-	},
-
-	"/static/include/rfb.js": {
-		local:   "static/include/rfb.js",
-		size:    85419,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+x9/XfbxrHo7/krNjrnVpRFSqJkO4ocp89W5NS3/ookN+nR1eMBSVBEDAG8AGiJaf2/
-v5nZXWC/AdJu2ntfcdqYAnZnP2Z2dmZ2Znb/wVfsAcvyv7w5PWF/unz96hGDn2ySJnFW4afTfLEqkpt5
-xXqnO+zwYHjI/jOPU/Y6Kqokc5Y4YhfR7ZLKZFk8j6dslhfsNM6mSc6ePcc6r5JJnJXwZZlN44K9fveK
-He4dsF4Zx+zVy9OzNxdne9V9tQNlsfgFvD4/e/bD67O9Ww5tWUY3MYuyKUuyKr4poirJM/hdVsVygr/L
-PVH38uWPf7pk03iSY0uLvMCvJ/ilNxEDep1M5hH09zLJbtJ81scRsudRGv3WZ6/ikr1L4smc9V7HxWRZ
-JHuTCPu1/9VX+w9+LVNon93Nkyo+YbMoLeM+Gxf5XRkXJwz6EmPB/QcAdhyl7C7Jpvldn72vkrTPfkjK
-RRqt+uzP8WqcR8W0z17nS4Twczwu88mH+scog/F9hA/PozJ+/BCqnl1QDz5GBTt/8fzJV1/1ZsuMBs56
-O+xvXzF4tgAWgwlJJtXWE3oDRdlT1pScxrNomValrIFPMmO9r10f8JHvAcrfPj2pP336qv5ZzZNyb1TM
-xqN5XlZQbnv7ieMj4gE+Pvr24MD5OSrLu7yY+utH1Vx8dHwtq6iK8fM0KSc5EOGkiqdOOB/josSpeMqc
-/biN7pUiR3vHrkLRspqPysk8vo39XapwhXzMJjj/SCYuQPcfF9ga70z9fX+fvczYoohncRFnk5jBvMSF
-UR0+5FMgX8TMlYayq+3Tt+/+en52erndV98f3B8M2XXfKEurRS/Iy37jKTt69+ZHrfzg8PGBXfZPZ79c
-vnx1ZkAGuI/ssufnZjlR9tBR9tnP7rKOPvwQlx+qfHGR/BYrdQaHh0d22dNlUeaFCXpwePQtltUKA37e
-lct4mg8kElgZVxUiwyh3tf2f785+HP33MkqTajVKcw5/cOQYmFbyFqi3L3rw2CxrwZ0nAq49MCw7yW+B
-mMqybp+KPnIgoi4pIYqyDx3EkEZlNSpgoRnEcPjQLgt07kDa4OjgW7vs2X0FG0c81ZEHZY/ZdV302lpy
-gIo/we6Qwto1eFX9/U10G3s/XgAHkR+Nr8iQ4Uu2TNMn+giAEgTDZvn4V5gLo+aUM3xHZagpdgN3zQ9i
-h7CrQk25fcD2t1hWbM7H7QZ0ixuMs/cAiHafLlBKQMllckusSocEUC7gI/tpGcPeBzwR5qLCkvZUIGN2
-AAEIDddGvuuqflveuNvH6v+NbU/FCLCoAKFy1BcFYJ+NlzNgqmy5mOJ+QbuG0dCL5++RCjSiRCovT9iB
-Tqrlclx/MOYVGJpWFGSG2Conij77WSsKgoKvqGCpWvHxqortrt1bb1bWm7tkWs2tt/MYty3rtWR0rklo
-vg2G+sdxNPlwU+Qg8p0Q1vSvv6XJ+IRdXTN7pFyEwwIozsTRbcNY7eUJ++jzxQKQ9tBc1vBlGi9Ibjhy
-fKMZcAkCKMvQPHg+ZhFt/FtbVl+mcVk9BxqTZGp8XkQpbBSxLBHfsfcgTR4/K4po1RseHD7ceULjP3z0
-GMTVh6wH4ggTlVgJnBDe4ivC+mARF4NFch+nO5b8UcSjyXyZfRiVv0FDw4MDq6ewQnDjMkmduPpsvLQQ
-De9GVV5Fqf/LaJLZlDODaRgFKsvPsq4FuqhQuisckPlHf6fgo6tHNGW4ZgI0VS4XKLCWF3Gl7EM+Ua6c
-FHGcjZKpi17Ex1kakaxmCHqcA7sYETHu0XhZVXn2Oio/uGDzMlGBbPHq2vz6MYnvcBQ/FNHNDUe2s/tq
-uXe5tQlCL0G8gbKx1AbYxygFZg+sGpovBrNogtAXRQ4UWSVxs1hR7dmDyiOpRvSwxX6tVfQN6tsGRN/E
-1fYJ28bl45AZ6i6h0iq31wIFhgL7cBplH6PS3MMI9CyfLMvTPKsiYMYFNDGFF7eg9PZN0D+8fc3iNMZv
-MOqoYpNoUS1BLmIftI1Xhw9ssFgtsO9CK/R2/T1g/PLVxf7Fxav9u7JkoiZsfcZkgDY5muRpjr3FP9ww
-cROJYQsE5Yv0T6rBqZzBNhfpQNN8giuVS7v+vipAqUYqZxn2WV5ZB1vOI/gU7KcBltdgt6Cl66CQHEd5
-lq7CU8klqGicxsJ4wWg17Esc6UBR05I6JkgzCySx/2PTF0kzi6iIKphCNDv88pd3rNZNZ0mcTnUZX1E4
-UT7Jl0gAR3aPcVOD76wHGnaVs7soqQi+JvnokO/Kd0Ve5YDOEmBebY+TLCpW29d9C3JdDkFziY6WK8im
-FyCbwuL1tVHEixhYT/HyB5yQ0Hq7ep9WRQSr7howKCthewI0/LTRKLlKmNBe5x9j6q+sgpyFy61TqFxa
-2tcp0CPKFrVhw8BJnr0nAQ9l+hjbVi0l7FO/gaSV7IFK3ue8uM9ywDT/hf8sy9flzc4J/yjEx/0JCJw3
-sdn0O0EtSOcJXxN2+9S0WRLbhzaQs9Ukl5Sw7vhns6HTNFkQpftHKBqqS/IRVvF9Be2gbWgivyAaQe+q
-sLlJnHy0m3seA0MOtNQ0hyXFWLAN/JOB5kUGPB90ELvP+adAGwS9KclHAzu9aAhFdwkfJP2KZXnFVkD9
-sDNNoH1nq6eg8oJ81UYmSslgs2igDDR3HqMUF5xG0RwvydsiQbUvZHNodabqMgUVtNoSYgvqvC1DU0ry
-5lC8hVam/D396cXbLx8XL7OkChMGNSNK9oR1DRpA3hqjto9/lyyaoMmTuCJKCS6e9WlHl0tuYS9H4WS5
-0EWOH+Lx8qa39f33iKA9AMStxHmxZQBY5ItliktaNR/cJaAWjFFtYaKzDX95S5LFHmwyZQ9hS+VHVt7Z
-g/6fRZO5Yp4VpgfTtGrZLa5EwWuQwFyw6+97sBVMSZTaUUyyyltjlKcF8mvYyPMPywWrcM8sQTlDczz0
-rzZiZcvbsaJ4IyJ6aG9OSPSEf74zbY97aZzdVHP4trvbPjyj8lVyfTW8xsF2KnpwrQi4WgNk1+kAXYXl
-AUVWID+oK1DL1bqftGl+8/wEWc4c55LkxiyOpyDe3S/SZAL7fRUDo83vgGLn+TIFFgX7W57FKohxDLMe
-c/pf5AkKp2WCJmC+IiLc14v8DtgMgJ3EutBYFSsnDhQrFGicwuzU+xuXtU+kPkh/fVIJCtqr8BgEWjKR
-S6vsrCjyorcl7Vh1h07YFtvFP3fMWcauw/vAOYJq94LOSkuX2VtdmNf1u8CTZwDwHVo4JSBuM5JvlSWk
-sRrDlAYdI73NPYcdegMaHdZ/Tsqd3hXlg9Kb7jBRmHJAxNfrwQNKTmarEwMve+UqmzSAmg+uCZOGU5gv
-YSbt7VjqMbzdy7PetpAQQAZVOz8Sr3Xm5gEB+mcG9e2DMfngUZd1aPT0KdsWe822WaFpZKnIidtS4P4L
-3x6gza2Lis5Hb0h8w76DfvMh3jKWwCdQLIGGfI3MoiTtbf0IYssyA7ZBp1hOKd6C69sGPDM1SfMy1qbK
-2p5oif8cFVlvq+lBng2oKos/grBo9gI3C7S+ctOYOfHxHp7IumZY1GE9LCC4BxV+YhXlgGA/K0GEcICS
-4HYBXp/xchIi/8uG+cnZIYSws+WbZtkXJzU1Sl1XgtKOLftsW1g3mncwAOiUm5q8/TDJ1N8ZIrztF/Bf
-IDhNqQPxqgApaO0OgGD2t+0ZAQThcNg3jmbh1SdXd9SN5VwK1XnGie5uDuC0edkKdSu8yi5oXF2gObZU
-vpBmM7mS/HKYqx4swBiHuNkCpKr2AnTw3wTk7REsyrKnfsNVWqDZpZaNhICwB5vXiFt5RvhdZdaIYt03
-wdndl9ks7229L0mcpGIN+ypNduFYB2keTfkKIA8VXMBT2IMsUFAAcEWj0CQWB955K5M0jrLlglfvAWFW
-UbrtlE9wtxIE2Ey7sAer6ri0tVBHNUR85VRGvvvOpYxQBYEc/LzAJVutFrFmmUcbz3IMUiTo0dU8V2xQ
-gnZV9QvdMPrk99Kv7Qj4q5q7RXTFdQP/ceFIceDAf7xFGieOXv37a+BE6PQzA0ltusP+2Ng2TqxtwnL3
-6NG/DhDw9kQ/gJFU+rUxqr//nX2tj8LFdwpQIotMYxCvl2ilBOQTGFLsobK987bRdL0VKCSinHM0DOgk
-ILkE9wxcLz80hsTsxqZsnWlx7tNSSApkLcVI6PKMDY+NpZVLHR2RwNRPjgoh8aI+Mqn9fp4tqzkwxGRC
-fmHbenlAojDO9hTGCJxelYjZMDCI06pIn6XVD3EaQpJrH0TaBWZW3AK/QVpsjlzIxo22EkF7/GzG2G4U
-rorH7HTGAX0ZQGcG0BvNnoEP8hCBuBKtFGe4TfQanPXZL38eoeJU5OnolT7ozvWh8Y3rQqdjtKp+VuWD
-z+j1RnWVGTswp1xZELN0Wc57Hjq6/7h4u1DJB8SPPssXYSqSLmLfoS1qA2pBIxuey3F/SZRw8gX8Z4tJ
-Qxy9wxZ2Ud71L3ZcBiN0LsxgM/uv+xfP/uv+4ADrXtC7vVmR357Oo+IUJQfqq/sTDNhoRPLeYhl7Z+5i
-vqzQdhJafioPp8nugdh56MfGeTzO8yDXdUI8CkEs4/UBPvQAlK41EZ47MnLM2mMvZyBJw0RsoyyCBu4S
-1MRklqBAAhyQuBXUIDsTVFOBzfI0ze/QNr5CA9JygQX2ND7353ildh91sD7B6srqtjir29qA1RFIkHzy
-Ge89cc5623cqLw56x6nCbrMe0maPIP2RbeG/WygyLBdbO0TqXCuc6BKkfNqZQjM1AH4IkE2+4lVCgp0m
-gLuAmy/TP5PLrlPXGtBmbK8+YgJBoIpfACtQKYzOotbcRhtCMklIGxo/FT5dVpfQhjY+atTL6IKjKTWH
-EHUk+iHNmstFH5KnqtspBZuycIw6nnALOXw0vCZ3OTwvKW8GuMSs8lB2bwHDPu4d7EjfOjweiaZIn97i
-w8d82MJtiX6HCovJeYKF+W+7601XhmpX+OHEIJ8NuENNue4YrPLo10KgsGa08sI7OuwZbj47cj6TaWi0
-TTf0Vu8Hi7xMrMP4jrVXnWrXaDFqd0eRUVGgq+skkbsTRzT9tKffEC16AMlv1IQVqCnDRfIRl5DUhutv
-ow6qlPuEEGtZ0jQuo2WR2LZMsU+RoYObCF6+7bJhATDUVuZVtdjuuF3wKvWRFHoKwY6zfVeW27DrwL8m
-IH0IWH0XmjzZ30eTiaEc78IH/TWp+fDaKI0a9xOfrNkonmgUwW0LWg2Iyagy9qCINPUrni5mLbcFRSKr
-HrPCoRurV4gIgIgKFNYM17umoy+ev98j9175WnO/08tJT+C6nHQCJquBy223qUuuwWobbq/gujz5B1vl
-w22gI61S5+r6ieFkS0edoA446ofdEM1yuOeo7XjU9jo2hhcUXokmgk7TOCrIMQj0F+XwozmxRuSV1oo1
-1iudY292ht30uuVs+Org2p4YYyWa/XjY0mSNOWhDnKIlGTDTKi9w3aHbQi/IMeXv0QK0MPKirYJrQlnR
-Z9oMu3wyTlyHP7Ciyy86+aXK+kIIcJ8UlYiXXVZCCfY9CLSeAyNl4PjnVs35DKcB1Bu4WC4Ab/HQAHMq
-dFTwvzrOND/tX6CrFNrCrVn+583nJpM0DEySh1Y1Q71KrcSo/XJ1HaHiGjYCLV5mVVx8jFKrgj1Wo4Tl
-ze9AadMTGarStSN1eV8/zNiXbt2QXh9/+IPjlIe8/UAr2vGzn/rEf5ndFNHYZDVK95DzB0rRQmyUOGmz
-xo4pr8XJj2+J6kMQ/uM8Zs/Vpn2ki70gEsbhpzk5xAMHpLanKF+QdVY5PG3pEBpn5nhcROdUADBHXyqs
-RJv+Hdpi1LPFPltmsHOXLLHldgGPumE7RLungCipfeh+pZ0OLn1qLkaJy+cBe4fsiKamPFHfM8YnSbwY
-NHyrz/CUDGicfOhzbSZ0COoXgJBMUzMcgorJA2nRUCm9LZSz6h78Nk68d3QgXNmue9vaI6up5pNehZ9z
-14CjsWjJX76q+wHlZ/W5O9EPzmKfoQM6FaPDGqW+Cgr9TxdizAwF3yRKk9+4rdeJMGOC9I8XMYa7Vyv9
-rX60on+rD2r4QNCtHacKd6nm6BGtlIsI3bpn2GF3m+dxCSta/3ZKFpyX+rAGBK8qkpsbikYYr4SLgvQx
-NuHjNwMGkgpHkUoh+80upBy0WXuQ6hFuMAeUgaTjeL3T1hYfx2Flw29kNRe/Ad7wLKWjcFDDC+hAcgO9
-t7mEqizJCokgBLZNWzL9hD15WwABbLWcaKqsgE/q5Rw0J9D8dVcMQW977Bmaqj8msCvUS/PWNK88YBE6
-ks2LPINy6YpNIjxLj1Qpv8yh3gfgBkto6Y43SExrumcA2/fMK/mgmG4n/Zq18z/kfoR/Ofkuf9TjVl5R
-c27hDgU+rxaXDwLHdutm3pATbkrCbyHsOLP9ouEbfXQaxQWDO36SLa3j3E+2xWPC/bG4jaOnELth4GB/
-RCM7fBHiXlPQPtlHuBjrJ1y93oAy4yHLu6jkL+uR4/s9MoZDbdsWo00PocTYyTtN2mQNN6LGM8dVqx2H
-vJO1/GP4jHmEDhQQ1B2I/OXzrE+RDgCIlrrYSWyjBj6OY3PRl7Xcp1QAgq+1jL+WXJoI8FoqNUz8La5z
-Kocj8wDuNsqcUNy3Sx0jcUl3AlD74xVuXWHrPnXB8gkTMQoKD5vm2XbFQASlI7olBq+RI1doBsu7hNyw
-vdvDJAJsycOREycPs6lQ9y6EBeMkUp/0W+NCeEq9zEDWS0CEKaKMG6cZHvqazYBYUJM/bMGyzy6E8Ylw
-vR3DxvbhiW1Q5tNQ8+iADK35xfmLCVC+Qjz5A+fme7CPLeJMGT/F4lkemBsMSN13wnqBSqiKy4vf4GND
-4Z5PP+gLCoB4MaR50Gg9aSIiYbcfHhxYfhN6y4qNqucracw4dwo1p1yjuA3mW27rm6yjL7xccDiiO14E
-NB64er+as9JOPaJ/hINt3YO9tVvFlbVRm7Xugv4ifn7gI4vXKpNVZtWreirPhkvF77/daa088q4HH3kK
-o4ebMDH8KBfyBI9LFfIBTkEFs/O7yCh8ZvSgWnxnRdWu57DdAaCqlHWyM+IIVdXu1tbmHHgW89J3uIlr
-ri3uNvWQlm6ehCRVFD+lcQaFEAtOK7biK643wjB2n8f5YczY7aJayVhOnjbHtdaEJ0IXocQQ4vziia6G
-bcJ7VdaBITKY28AVEuBZfmJZuRtuFZ74OHkxNMgCMmoRVkNR4JgBH1inlKThFlTBEpkVxvRJ3UxGDrtc
-LhQAPyLy8moeiyiAEvVmQDuGCeasWLoNjPpIGpvyUy7VhjqNjyp5v8YYRZp+aE/0GRRNjDIlQ5lPCFcf
-y7S9Nhv2QnIL6e5q+mrxiXry0Vn40PSQ0or6WJr6tE9qfJ+U3SbVLSwHRWjzdXCH0T2nfTPlgOxhwHrk
-pcoMMbh6ddvmd+jwK3wCs46pYeALwy+wKXxIFnXWlr5YLhrAdm84rTubeY3ZsZ3qeO/7bMXBgzJ/G5Uf
-XIN2TUbTBeuU/u9POai19lkLyv91Q/Fp+GrKD1df5TiQdX5tV8EjmfD5jyOjke41HK4ikhtt32+fMJj0
-bcwws/rkVzYukHpK4aPJo38dFCQf165J0xXiBN5xGXJEDaw7JrotDm1YHmhdfBbVmtqaojD2uLDXlX6e
-FY3LX3r3O47Xf+2t6tcmhXZYbzwgWl9tYa7SRo9oy5zGaRX9Utv4DSrbu2cDdm9jr675V2/NFdRc+UxM
-GxGzPqESxCkpCQCkx4fSFx1zaSVrLYUOwuO/6bSZ2NdCTpepLxQizuKbHJTiKh7JIz6ZmngD1eE7Njzs
-GPj2MpuIVDfN2aJouOWsCOm7bNIna90o58msuqiKHvQDPeZK+Pmwz77Z8boVighdw4YmDhtkGhmHE1I5
-kmmtbJ+s2pgq6/sUlq2Dg4M9+P/WCc/ZJrJfMQnarSloTQ/XV0Sg1SNo9WjLoyGJ74+NXgUKHx9/Kwo/
-WwBO2Xl8mwNihQN5YE/Sk2Uf7R1tPJpvHKPxt/PNxu0cB2btoYJL9L9J2bPXl+zPf3kdrDEUNc7jKEXk
-P9x7vMZIjtcaSVD4dq5SbkMUh99qNJdncTi4sEKwvp2uSRHXnGjXr+xhcIW81xQRLmnAgA4feVVjpRHM
-u/BfB1tdfHo80WkNMK9twyE2evcoFa3fK6hWUsX7BXOdJpyVw6fvIgaMW6FKFiek90d4gFTgTpfP2FDm
-M0Y32UcHtyU6j5hQ7uZQAgmlxBiwgldG39osru7y4gMZIrjY5FJxFFc4UNRNV7paAVIzw+Bbp6GTzpib
-SdniYYSLqCjjl/Xeq0wcadpsN6RJb+1xID0Hzh5Q9f+w1XUP+WyhUw0dN0sIu0CR1tbnsA5KXxaMx75A
-zydr69pWwPpk12bbLwW87jHHNZ0SJ/UcI4t9FcdcyswG8ZSlCeYNELk0p/Ekmca2dIe4y5a3I3QOKJ1b
-/LHPB1Arip5CvS05QPI12Oo3kDFyzRUv6ASs9MdnH5VdBxQDO3L2++jQZ9PgXBBzx3hlGg7XU9/BvCWl
-0IH9shBJb0RaGxfrC7AX/TaKA7fW4cfXc8wl3cyho3XVSlXTjoK4kvfeV92ZRw7Ltjkr4yODbcgJ/nvP
-qP/wB6XQdyB4PabwU/kGiOLw0Onp2jaZEkTX42kPgXrAh4jVQTPvs2atrjP/XuJpYq+unD287npK0/AU
-H9toW/+8wS2MhW4LE27678BXh1XdnrDDyCXR17NLgP67pPw2vFXO050d8oS5m3ZUnL5fl7cLtPtGbl9L
-Q2f0lFJ2DswagKVCOwcuSSxXxot66sw0ybZ2JSFr0oyssgfKMOBVQN2xfbVk7UYoPOqYIUs2gejAnAaT
-Ip7iHACJNCl5epiPHPEhxxUUGsQjk46r1Vjd3g4bDBhMK9kimBkUJ8r47DWOHL90duAVSx1WP4eKLWcR
-JRbAgyvLgixydXAt5rpFgsInCGi4BiCl9TUKD53xYpZ8po7eKZMZGWRqmivTZBL3Dnf2fs2TzEOkPs5y
-GFjLzbLTF6b3NECR8Krp6GM2aV2r+i5SLzexirw7CXkFYBpH9jGJ2DiHshORO1uGGiXVdsk+gNQOmoSr
-PmgFKA/rngWUYuL9S7qKrZhFCKYslz6PQ9863pKLg8nV4Trf+oetJM+eRMwNBpri5OKWNHzcLopiOOXb
-H972pgnGOt3fRtnwcOeE+/7dxBne3oZOuIJR4aQ+s2LOSS2S7QLJUYkmIRgn3z3EX88nxWFXbZZdxOUi
-zyh1KVo8eX8c5NRvmg9rSj0JsVUl2tLd+038tmZ+UZYKxYyOqmWW0e0dzVoB0fWSv3XtcFyfuZCSEy95
-KaRhe8UcnACqP8IY8wJ29kt+N1qZ3GRRRWL69pu3l+/fvDl7tW0exdjzzi0zvqaNChSQzP0y+Bjx1olo
-nMB+mhgClUugbibBK07TbESL0URN/deuAclqfFK8GtDDQNV6/ry1jx21/dN3JYeBcbENuppeaijTe/Cp
-zdwymefkVoimkVygwsqZQX7n/v4dXPuOP4OV9sQcf/00QLZKub//3bm3httopqK1mbroGloKj9mBPUWZ
-OjaPKDsR7BeTvEAOKXCGDsF1I+1RtPhYqgum5Ob/uxYe13hQJJdpeHsgwxhexbGETTtFd5x6Dc7Rn3DM
-+G6QL3kYm3TU6aYWOfV+UpPuogy1OMHMuAv/NI9LdA6XqSYtAuwYQGtyzC6ihZIgUYbm45ZH2TuSoqwo
-mAtdFKhXok+yIVcmEs/eClxKDrqzricsToK7rcG4hGlIVvyeHVguW7xXDn5L2z7ek1vX93U3oJsqN4Dy
-Ha5DF8P+D57dUN0CgyYo0euOZO8jeHyCfgh0YxRUnep0A4AHBNhHOT4rgbYOOxEObb/LMertp7R4u5FN
-awccJKI204lIXFKJVx7Zvrj84S9v3j57f/mn0cgdo0ZF/vLmlMpAkUNTLmkVTETrV9dPHBkrDEFDHW6b
-qPEGlvvagkY9waED5HZpgQyMmJmop8IM24Lq8SKyEQKGVLlQ1XV3p06AbhXfv531JFCMo2ODYXiluzY4
-V0+uJNTra58/uTzybtr3G0K5N6xBc5R+i9umgrp84ESEi/8+z3j5eM/m3F1UaF5cCNexi63qva9jnVwg
-8Qke5jqh2jZeYLwrznakpRGtvDUWu9uk9b8cGhtvG+MHGgOz5NVkVf7am2zJZ/sISRy277hqNPU5ZBxw
-QlQChLm3uP/YwKPb81OWzvIHPv9KR0fIfT3HRu6JG7av4MAh4vGakTDdV3zranfjwtGmnieglGZ7pGiB
-cb95Se2JtbzdE3p4yGcUzdDeKfVYCKUxMgD9hKnszJV5ob0Z1a7ob2r4+ETcTAjCJDZYH0teupJEBtpr
-VA1na+v6tqhs0OKAXc5agj7n9bn6qCAiXcubjhjJtsROY1vb7sJPdM5nspA21vf2z5+5IIyTLQDoW5+d
-NzyVw3Tix+sxGOSf0si9FuPVG9U2AZ1DNdsBb6fPjjtvC7KHLRxeHJu0c8JmBXg9AfBpDS3x7BoK5sX+
-sV7gtd+yjo9gXl2X+GWO6T2khBNVFcamdU0SprrIoMRNJLr+Mt4SvnN64hwghcMOatz+A3bB08fyi7n3
-XYKVvGTcSRvDxyb11rXq68e711KvHtcvFjf68sBq5gGlIDdH9+7lL2evRi/enr9+dmkOD6l+vFjUf3dy
-BOLe73gf+5qVxsnNCF3Po2yNSs3tyYFKjrOSKfrmeftnzz7WuqGct7xe91rjFHooGuteC3tIn9eZQd5B
-Xq37tGP/RFOdKulFPiQLfip1JOyyzkzMdG+ieW5WZ8pAQynZoaI0ZcgtmuNLTDGFOQNk2qeSW7NM4Pbz
-IJpVcfEAGtkuMNViyW2t5P+Pedt4EPmEX9EITVUF/MdaHaeNHoIXpO5XSZVaXIBsl/B1tNYe5pE5FGZF
-QLf7Kmwv07IRBAuf7nR9yj27pjEepby/fHHskEtw/1Ja2THXjL6xK8bjjibh7Vr2pJtgp3Ht0EzjnMfR
-NMZ7so5xfCD8aX3psE2Tu3cj4N5SZvsmJ1h9L3FS1h3wWaB5rdeS2rqtWKU+F8o2ry8zha5T1bMiD0Mr
-UjZZ5VWUyu6+kgTcs2di1zG6Xa3HO+iE+7irW2hXmnB0sM+ODjtQiYtMap4TTaolXXg/zZHlVHSfK90J
-jGyitiZivqUZRhjxLAt43yvPqEx+tbaZFo3iObbyK90rhTFNCA8ZWz7jiHL2SwJVU9z5z4YDSJcHGjr2
-PPFevFV5sf4XaFWnj2CrdXrnz2mvob22A197+6maBHcYrUpbQplzHNdkUizpkv1bdgtaRIKhNRiiXbbv
-P0D0AKTZWdRvavQTCZeqyltLcLts6157LeQ4n6sVSLQgrHFQKLXt4huSxPg7LpSFatcCmADSCGSBWo0E
-JkbRSGSBWkICk6YtLo4FyteyF6/RiGKh8QjJS4xGymEtvSI+2/SLS0atPVNqqVJYW++Uas3frt1XwcXX
-HdKDiBNocaOiqCiuEoKVhkIMf9kS6odNN7OALQ8fr9U0VB7wyqL14eMOTSoy6fqjxcp6mwdtyS/31xMT
-+8gnyKWujGZxLbniQnUIYvrd+/iur4tofmlLWUv1oXYt12HwKwW59YodGee21TJTWlAcd3aV982w433g
-quOk4oyi3GN4iXg0TikvBWYzoY74nfeUzjoD252HEjKytowrBYA1fLcuLOoWMermhgLcN/mmE0SevXh+
-3lTvs02A1Dm0XbmxeRGePVt+b8V24Arf8ej5Aj25H/rQACU4p3/Kjjp69hugHRGtFuhhELd6yHVyH6cv
-SIZyRFzzVvtGC32LpoxpddwBVcsCjlbq7PXyRZrDkh1NKLX4eo3NxjxNFXqoxmXlak3JwY7pK7MfkoIM
-kXHV2+lCYg4CAqmDnLbHy1FBCfTomtYeXfVPV0TsYGsYvOcmQFGfcFH6bvfQU5x4qFTcTtPRoV+kXOqz
-7dM6hXBPgMBbXqtcDa1oGKJGXGG6bW9vma3botsgKU3k6x17tqTMMpNHdrCt+lMG+E+BmrDFtRqQhygB
-wEYgzVrgbVf6lt6LA8cOjbhPgQJNOI9TQjHtVmRVOY8wPkXcFHjNg35cYLue8OhpzZsjT+uEp+3M0+2Y
-JqfW0ciaRFKb5zveVVMjpyKGuyxAMl+0LS09QrE6RU79OlqcZVWBnlptZko0ZPJ7xVz2EEpdjV6QokNr
-2GoxqpJX6mq08VhE7EFt9zXoD9jjPusQqFC7OE24S9eE+45LOPDC79GFFPzUjNM2h8P22eGjx57kaLUp
-+gvAQWH+M8HYgiafBpjl3hXCFzocqX/XfZ0KdtnES9EmTdZgVXVev49FtmsGcCgWgfP4JikruvRgixvY
-avTjLTs1DFDgarrXYLWHW9Srj5btZAmid3zf+TY9zi/EjZvmqvOexip1hG0PdOBvfBHgNv685w3vHLdX
-rn2g3KXbrYfH9uEUDnat82KplZzK61SlWsLvMt0Mz+iN0sJaPeNH3xd5jI/hYEIIhpk42ghzFgs2Z0ze
-g9359EqMrePZnxTORK02VwwY/ujFs5d2eEEzuKWRslYR+WCffltfyf2CvCY2yVo6bPry8s3Ly0Bf1IvE
-n8qpdDeo8BvEMVngS0quHWeg8qPQrKaaseG7rhHXe5Nnv3xcoFRhX3O+3ix0yFdpJjSPpycsSdP4Jkql
-TR2HKS3cWyII2E7vG+jKp3VXX5NGti0+G4pQWgavztVcU+kJHpAQHNcT+pQnpUqHteZJDCyBtK2kFwUo
-WePlbBYXXHt2zjoXPxqWOTNr+fyAuJnSqZPK5/fW4X39cGnarnJB5xz4p8UP1BYl19CYTKG81Y3xeZym
-fj4j5AYs1MYysIwv5Fftb0CfOTpRUlWIrXuNsWsiUWDgj5o9ojN0wXPWd57szOUkh6OFjWyuXqCBfUDg
-R65/ip8/gC3+YEcVZB2fuyBJ/eBL0m4u8zZ+KXiEBZ+UsfyuAxv1xtJ7BCGo2UitnWSfBm1d5Z+mhtLP
-NX0AsOYYxWN3jiDNFjiBBT4bL90lFau0w/IYCGGD+e9omJSPIou8oDBEnGy89zabrDgB9hDowG8JdRFi
-MCBCpK/ruLl2Tz3aksLKTVhbfRN5nYhLJ2uB9FCyIU8PKFC3pu3hYWe/2P0HDC/4srZ2dI/ybu/zqVu4
-F8f6rpgvfJqB3stXQGQADHOPfPcdKGRAJvinmV3EBrAyABzqAI5aAQhfzxrAQx3Ao1YA4hy/BvBYB/BN
-K4DadUIxjBCkY4J0+BBB0Ytv6QWe2XbJkWM+BGJozvDwWhhagjs59POch5tyFdbbPM9trCBYZDlWMBa4
-sW+bsKEVFyLZNp9m7RN/FQInp1arJl92qYiHvHVleIl/y+uXVVjXn3wzqIRqh+qvf80TygtLJeaiJqOt
-btRhd6Ld293jRo5Pp8RqGs9Po7ISW5Zvg3EaEbn4Vai3YstEzK6Zdeybxcp7QbCqsLQA9u2AHITopfO2
-XxA6Sd+K73eYpyeA5DJP4700B1nuNilLYUB0sI367m7RZLBbG4/Mgc31hAOntLL71CkOSNJwkUCLdlgP
-UL0pXRsdbDK7uyGmHKw8bKmsH83uPrW2mgcWB+s02ZYIJ1r4/ilrDRbw5lFEoD2zgyh2GBBrdxVlw9OK
-iXZ82U2s6ekqf9oVl2mKlDEiR9JmfnW6alPYTWCTrPJhFR9Fpr2kmuj9iZVRuO0zaJR7y3Vgu+5VQJ53
-OKCNwJhzgtBgRJ8HCwBwSNHHm66QeqFu7fsb8qmenrvjNtZm8KGTQV5+mvDIm7A60pWWeJWaLJU21oPQ
-nRYlBbIiX2bTQVUkCyLGruhSp+FzSVAb/WcRYD0Ln0t+apdM4qsb8ZGer1/SV8ehZOPTOb3s13wLU02A
-IBdQzHcMW/2tvFgqpPcqwvmpuPwhIJ1vLpl/Qal8I4n8C0jjqhFL3EnKZkmWlHNM2gAAcB2p9iz6RwCh
-JYdpAdFBZxEX6NreO3/xvM+u6ipXMiMpTMpdjv+d5rfboFsZj4gSF/Zo6FcGmjruKKdR9jEqWT7+FWR7
-BewsnyzL0zyroiSjCAoneAD7w9vXLE7jW3T+J6/3SbTAFF1lfZcWS7LFUoUt/KgQXHGH/x3neWp1Gu+s
-KGN2+epi/+Li1f5dicdKVFH1fYEJqB3uQgDpZgay0ytuqIyEGZ3gr7ZVrz4/SAUgVUjlrAJmeV0FJPfp
-aRmwClI4AWEMkgKmvgInCAmRQj63cR2UgY6j+xIfCkAzh28Dt6yKGiwZwhdREVUwZegVgsdgddbUWRKn
-01IBal3q20BNskpCpVwCtzHrlehNx3NMIeymto7mu1L6uJUNvKgoLDTXxRAs5ndLKPiiuQ6YOeE3N0G4
-J6GGfyVvUrkGbNVXUfAEWPwe5NxAmbyGKUhMePcU9VPWwHs4+U1GU6hbalmdT0VoY23iVudfuwK1aROL
-6ktXvyu1mI2DV6WeKMld+YH1Ps/xqjVtpl51tU9NWzlaoX1oA7lUTVpJk69Za6R2bPCOTjTSeEDQ6MgB
-go9jIr9Q3jK6GVMkNtObwrOkQCtNU3ToxMeA8PHP+iDFCbmxbfnhE2TFCEajQAWVN0JCmIBNiQExTmEF
-FC7uhLRblBt2mCzUnT3UJHpz+JriDvGhqRNNCc95akfs70KtO2F0qMO4XZhxD329HSUoIjwkNXqCmiKH
-3RM25e/pTzeehANCkAioCempIO/sOGGGVwTesgyLHLkc7f0GH6qzcuEps5LLN640R3OMi6gPtvgrVfEg
-KU+U/PvfZQlkgn/bPqCscHiFrfhBhjX8/cnS1bl4YzZsG+L0g+4p33heYbVTqsWz1r+lIJE5qDz8jHFQ
-JtPY3CedXgaN1qU5wVHN0bJI3LlQnL13nCX6/BqUaJfnRX5X4n0FecwDgWTWTYIuxuAPaAnOjO9gU5MD
-dXLA0Usx7ql+xKkfF4tCT2QaWxtMLaAF4chSAUB8mwpCoSJP6jGRSzOwefY6rua5EB9U7wotw6G8a1U9
-0nVcuOo45B3zLBd0SDQqf7JPevPZrCQLpSySxpkhxCOMK17ummJmqPu35c3AyvWrFAU1cojFsWd+gFDq
-8NrW6/QSR6JEoMhDLCJuw2Xff4+nNyGIj4ziw8fB4o+N4mYKZr30N1iaFzY955o5RlvFsdvnSb1d0cY4
-vwHXdfXtPwjfj55o6G7DN78Dtw3f9zSNrUi/b0f6qhUSIXsVRsVjNypCueppC1tmCeUIx1sgoyoBBvfH
-ujJXPoTzjI1IEsZ+HxQ+Xg+FB0/UhActmOxa+MgubI2M8kLBtMjbjlqRn/G13or9jC/y1jWetRITLW5z
-up1Z5r0ZX3WAx/B/vHiJ8ZFPxGUiz6pe0uI9aDATdHP3MJQmdtAmwzHGDcpwQW/s5D+IMA+MveRfkjId
-dIeZAR4AoWh4hfrjpCoHCxDvaMrbqJKHgRpwAAq9byPVA73xujIPTB/wwPQ2QlaijUW8FUcIvh/Q+9BU
-HDf9oCsZpoPb6D5Q/lssf/jo0ROtfAjnB3ULUIHiS1qaGA7rNrQaoSqHaiMU997SxpHaRl0hVIOIBvhP
-PXBy1wnVIAI51kbeWuexNRBeJVTnG7VO+1oZHq9Z/ltX+RAfOzxwMzEjPNlmZEp0shGX/HtztcMn6wjI
-Ac6DanXN0sja0DAtyrnSpH/BHOP2hpr02a/QRMO86IDIlrdpA1M2r3ou2+4dRO20LnyV4CE/z6nAVbwt
-PML+WkWH74xQi436kCwWZJznmuuijJfTfCDbcV5+wRVZb3coWw7vTThBgJh2lPpOYKXecqskSHp3c6DP
-O7yfA9+gfWY3y7OBwim9wxL5NeSoqDKLSpZQbg01f0Q8FdmTgpkigkktEe0xXaSgTYTPpY8I8tdrXtwl
-TekFJdGKwq5EVVrhQ6Ww745nWfZIlPV4dP2K3MHTOc/hbdB91SEx4NropJdAwbA+8SsbiFXn5mZm7ITN
-ziZ1vASaH2WIhOLzYbMy3uDLbFLw4yhbaaZo1jEOoIa+Rz+f5/c257vrs7kd4TcZ793JG0Lg99x38o9O
-Ssj/8hmjKrQOl9k0niVZPN0CgaP2czmhEjb+5hqIuRuE8IshGHMbhnKolMiZiVLieDRyNs7vrUqhOBeB
-n2EfG7yn/wKKcK463pig8FkFDVP8L6Ch/a5Xyg1qILGp7byCFYOH8mLCU6j1kG8pc7Ejzp0AgnMyFERO
-WxE5bUPktB2RUyciO+DkoI917+m/PpzUv/yr0V6MymxJCwwB/wfJE/Im33zWu98xJwtNi/echD75aq2c
-tVayVkh4OVrPvKfMTLvVp8fNPjvsD+zg/sWLdvNPXbDV9LfqDJmbg7pA5ma/u86QSZa+6wKZpOjevDNk
-kqLnbsjGzjNU5Wf6pdrRxQWLqqnadaWhQtdIslQEzeRXCm9x8TPjtk0nC+PA+H0zdXnN7LFjjgAfYU3n
-bq9nF7zqdGdnTzhF9MwLGdVhx/eYcqwaoacDHppoExAVmiQoGtp6Mo7K+PHDPrr4Pqef3NElpgqONoS4
-JT18NQv++bOfu0WOoz9NCis2EIZiFWzxbHW6L6kxTj5XWZ5VivODqGKw28AmGjFs1dN7LSYGxrxBVA6J
-KMtitNI6tsJYDMsXdmDOhSO2Hb1Emxzhr6NqvnebZOZsB4IS9IcAzNK8Tq8mhgyURxk0LLdefTJ3TMc3
-/ZBsnCbVy1vY4XqawxjNR9/yA+vUZ2X8fTVkCM+tip96O92gWBUTK/uGFafHo5Hs+VAxYsyOC2JD6IOn
-at1ggCKv4JFMN1sBz+oVkOXVPC7sddCSYqsOkRsMNog8XDdy/vTtu7+en51ehtiO3ehDW953rGwJu9PF
-QDqFT/LFSqVwMyaz74nVXIdMxapRPSw3Wzsmw3FnlfPitRWnrUg8Pz9rC+MlK4EPa9h2uRy3hOs6yEBN
-7YYrwGn4cfH88zMkCqP6GnG+eo8dxOC+x0RmzLTLq1xIchkebh9NPtyQC7enK5JkZ0mankN/et2py/bL
-Za6UiG0BtfVEuC7k5DuOFofynBIWH7tvx1lrhpxK572zuj8R+2rN8iIOZq06a93+0Q23mNekb8geK8M3
-y43PBr6KPZMteO9WN1HuvS5yvsw+2MJMUcQj+jQqf+s7+tEp3t0mJtyxEWpHz6F/xl73p7NfLl++amWV
-xU86nUgRyBYaQb5xFU0CGT1xOBWs3058lgqO7iUOJ3GSWoLSvu2V4gKy8gARC6MdCgZqjETPHV18YLfY
-2ikdUNPAetyPg+oswzkS7no2KUEueHFjfaawWfoAcXeuEkpe/HQFdHItElTE8Qdnp9Q632MSEHGD9Hl0
-5xRMxKIMpC6Zg4qL86dCRs1V+TucaMQTY+yIoxGDllu0IySn1ruoSx5i0LQ3B3lIQPiJlkoD8T9sOg3U
-rVcIV9saMPs2mNadT+4Qolfuuzr0zU/uIaIz/ip3Kk8fPu4zY1cyOMQOzKCvw/MAqJUGSh4nDNjKc9PE
-i+QGr8DPlxVDB9bb5WSOB2F0/ni/QAdeRw4Wk8rRdHUw7EbnzareRXvaA2YpZDaZho7EnH05lH0JyIGB
-boW7Q13q3peHsi8v8iL+J/flWPblWba6EKu8a2d2dznfu0iySSxohO7pR3EMW0gqNH97wXn4tcLbONOp
-E61swrPlo7AwzrS1ZcEnd8CGIVYemshhzdTlLJ5SOjDPrY+eGUX01h19YElnvkwv+LRefdjeXOCqZ/e8
-do7D9GO5I1ZdjOrnmH3AqGKMIqpxgV4TdGYfgYSR09UzqZ04T5OWHdu5VQHeuk6cLWG+gdUtqxAZr6Ky
-cvJOLzZo8EmB8sBNhjGs4zTKPpTEo8+f/eytp3pd0IuXWB3bJACMrn9DCN7bL9uIzKNuNWdoGrprVtw5
-n5/i+OGe93U2HtsMq/az+An/b3plyocYyFMXB9lgtwoMZZ19qwElr6UAMTlER81U6OgIrYROg5IPXn3L
-u5KU7OEm/bgSHek3XHuo/nGo/nF0Heqw94uGy8321QAG19ntPxODs7qFL4lBrUNr4FHrzb8YHjtwBcpB
-cAm7x6bcq4Uu1pK8LOklMEFir/J+r09yuToH/3xXw4e/3F4o6uOxQ683/g0FJh1wvUo6LBP5SMto6zzK
-p9M6kQ+Mp/NSMXv05dZI3fXWEp3XTA2y62zoFmidIbQ00Up996uuCPQIbibA8p78VcitxIwlc5ZfifK0
-lGe+tHRqlbv5l+4z5QW7m/M+I7F0qDMXdUS/ea0ODL3missx8cQSmGIJXLEEtljO/fZx+XRVIOwWeQIR
-arQ1ZWlTW9wzw824bjHOL4E/dSsIG5i29UJkdVrrfKCzibnL0UOrYZ3yyt3Mq1N+ZeyJ32sGH34eg6o5
-mmP7lFfEngF6CxwGAKB7NtLdNzP7AFP5fOwcKm/JtS5kCwRjKJvYwQSc37hVNqWgu7Fwg+aweDbUocNZ
-2fRIVv8S2Lji7fDpu3bjRSwFfom0ipWkJHy9e/Oj38WouYDOt0uq5maCx+QVWXUWSPJaTzBHwi0lj1CS
-ymDSlpa7Il3We9oqMY6yiEvMFzDAxBQFNIlFPEPRDAjiHl4bwiYmBX7OFk8+lMtbzVsMseI7mONlHSve
-5SuHgNpcffFBoEBXWNzp2ytnBMt9zx4/enT0eIcqDZ7yv7rwSDEXUM3Am/Pef2CjF1URR7cOBkfzQB9f
-opg/GNqf8RZ1jiRrZvvCpIx3zNnT4ZrGh8HJo0yLWodhb0zQ5TIoHjbk8luajNGneq/gtxb4NzSZOnRK
-5pTt87OLs8uvtwMVtOuK0M8T2xJzR2c3PlNDJyvb/j5OFEyumGq6kMocl0TU9V5TUODBd5dUZ4hJNsP8
-4wIc8gcFuTbs/QeIKxX6Hs+947+8tp7Es6LIC7yX9WOUJlPOipNMnU9nGI0rxTalosWloPUEITpsgHZJ
-Y/XsjZNsKq9isNYBfIvv4+llfv7j818OT+Wlr8aCWERpXGEqIj05jGM+MKlEnn2Mi0qCRu9eqs3QmRXW
-VIJWLT5BVc6gXRcQGfw0XU5ivDprjPx+htfzTpYp3cRTQgOUwSTN84WTTKYYcCHJA/94vnRlZNSOvfj5
-XE9e3P0N+lOaqRbqSkO9ljwzP3YeYj2oOYfwgV8B5xBuhGzlZx/k0E8e/9MFiLUeTYjg1m79eMJHvQkU
-HieVXt5f9p4XhBVEie5w+3IXpiGKwIB7GN4dbKn3Yd39vulE7Wp+TwHJfs5Y94KJPUkZDNQOaDLUQYxb
-+eYJG6PjEnR0PBi0qenTBWlWjZc9G7AxeqUchbWbkuop/QXOP+aMv7UuEuzVdIFe92IBXZWLFiVN1JGB
-EU09etO17qFV93Bt05fHmrUmtjfEtIXlY8AXX53/gQHNLRjfBNubYnpdLG+K4U2w69jjXdvVP5SvbcBR
-PmuN15xTEqaKf0/kp3w4DXAyRTBEof8bl7xet05F8GVYxBdZvJui8bNQ+K+8lvV6YZR1ku2F4IkwNxc4
-//mi5ppyIk8p/ZTJEI06hMRBU7qmyLMgCIWRwOBPDCeHL2EuSURJNPnrtZ8CCbtJJ+LjRdeiu7pKd5Kr
-q/ip7QvSVchd1i5rOmuTW4ar6AQtSdwO5viYjqShDH7yMrY1kqxV7wRhPg34B8saoO6QIqa5BcG8O43p
-PDaSgF8kv2EDTXXFY42sbe224t2nKjC3odJr9pKLD+0GYt42cqMhyWBBnLgZy3dAQjsiQ5BDWiS05ndi
-ClRVzvRpfkCwW1S7IkKN0OOPSteQiLbsKz1geQ8PfcuZw7WTYMqnpii3DU8UEUZtfx/cNff3J/VdylfA
-kPz1HSs6eMSGuX7imyQDRowRaabNnm5tAZxT5pG7fJBU8S0aqaNViQeS42hKzHIRFzPMEEaOe5iC5MfT
-HS9ThGmodQlx+Aj/DxKvOcGeo6lmglswsEvLs+6I+xxBPkQ0Vmm/pV/rbOjkTemN1gBn7+FzB1/nmrrh
-DnbupNFRoy3/WYV81jmpA2KMs6mHFLtsQQ6m2JDNbsPqzXpB5vi5TBF9EtCFlU2qFK+2T9ErLpn2kd3z
-Q4+yFp7kjfJ98jyUi97l9apHLKlXsnuMuYt6H/MFcSlL0Hu+qda6zMWkiYoofPXZOlDqbtdY8l4eFd35
-yFksed+oBNLXzYQkoDanDY6QU72BEFvudjfXF5OLi5uxI6YB51GRUGhb9mx2UJ89dZmYpUHexruxW9tR
-jMF7JVXnyXNo3RHHrsQgdIqZxEFQVhdamuvi3zUF/6sG/5VXgndk+g5L8FxQPs0XVlLt9UU085RIyIW2
-LGhJb+0ys3lGRLB/N6HPbPl3ldS4lPY5Qppf5vq3WNbauf+vxDLzjcNfgwtj/1KCWXdhS7ksWR3H/2jR
-xWRO3QQW5/b1BXYv4UTQ7F5fbKsCVJsTvojjD8dWbDYQx5/jeCHybZL/BiVo/krvhebMgsApy5YNSwmD
-5BqAVoD3Cv+Lzpf6gBR3GAn/yBFHTiDQ14FcwQVYXBxQbwsjZ7Z0sHXgi1Lx2x2lzq+L+KZDnWdqnUUW
-rCIDGIyuwWyEan3nqIWJYFx1tFszuAvaSxHjzBGpOHjVF9b0cWL5bbzwwxWk3zjGYSKNnujG06b7eFmM
-8pa650yk4eggB7GPdZrLgfDaLdlkB5e4EjZ0vJyY+uAxuSMR0JVetQvfOJ5E+OLy3ctfzl6VbFrkC4p/
-E/mEbec5QgJoIoKkTrjHB1UPyLfueFOPkIbPGEj+g/2Jt0yEyVu+je4l3+7avMcGH2wRyfp3bVBQ1YnE
-G7cYYI7+2mSAdClUkK498QRkBntClHzStZ7X+fgL7954SV8Mk3KLAkldb70VoZKxT+xBAwVxXedCwMcT
-ofhZu9+VEpXhi9cYXl/7dbumY5ahxReN0E7/wdX4b43l3xrL/1iNBZ/uOgr3NK9SesUJGW/Wgz9xKQys
-+3/l8w9VZcSgfxYZ5uOPcbECQNlNX9yrypJqHTZhKDacGZKuBBiDL4gyurVognkNvbNKZ+i3GAGDiVe5
-YuALb7y92SsLzDy/hVN4wi2d+/XEBC6TduVobVV5Ohnk+Nz9NKJ0s/41sI25jrdP2DYMYtufHJA+48B8
-dyZjGfO+Z39J4x5o9/LwzzadN6fpZ0gnXpTzAi+n6im4zz0Bl0VTvi3yku5n1M7le53TdrSGGpKaRrIW
-aJ5kzZzky3QKmxjjruHzuIAFileF8nsYQWLOKUMJMRu6lSHK0E09TSaJU9FzN3fYZz8W0ZRuG4adjzcm
-byRdclUImQt+jDO8CNEf1eq5dOxuvpL5VO5i9uuyxKum0xT33m3SQ7ZpdF6w1bzI72gVC3f591lz6wRX
-rdSQt0a14pPAtSuJ586RCfhsKp4qpIK47E4nbrn2K302WgL1HJF0huGgJXaOtoWT0J2QMNcyWfSeFtHV
-E2KhvBG9BjcCZXJDkNhRDrEGiVGOIxxdtxyxnoRfrRPBMQggRvwG2/bW6vsErFMDF46aqwPaMmHrXmZi
-T3ufZNXxMxRae0brD6wGHlixwOZuQ3f56nD6JhgnCOUqYHzRZ5sAqRLQp272ZuPlqKhGlLCAPHkwZ3pE
-nHaPRKnb2LKWrbsgMLxsuCYlnOFdwNN4Ki4jvuhEDIEcfx6BzNHMJiGAAjLnkeVFXCnwnGdKwn9sHBcg
-FY/KCd7aZSWxddsq7aFiDt2eDe2Bnc/xd5kFS7wUIiXv4SCfDUQPu1Q+EpWdl145L1M050H4bzolDTwU
-iMRl8gm/pHuWRjclxjDhu1lSwN7JATnN/Uk4WZMYFNUfJdOA9f/hjrwcL3Fv9575OdxxXe4nQN0PFnmZ
-aLf3fy7I1ZcHSUzri0HjLK8dGxzPYYSgPQ6LWdCC0qVvGbiSqwavndp/oJd/wC6BJhukor9CMomQCSCx
-giRTwkt+Z3qMV3RkN7EhLlkQ2QEbCAFX3hrPEDJw7fwus0oPRSpOccceVKFrcFAspB4gAKvSIVSSmfC7
-19u3rICg8GYxVsmFoMBiFE+bC9dcUPc8DJBnyiQdpMmXzXNeeqNIkdXclqhMbW25PK8IPysffkSMKl0C
-PCvyW/6Spt6CJQ2aSre8Zs2hWyQWHeWCAioT0fQW02GA6ox366crjNSfJ+ME5ssxGnxC0vhhsNW3SzQe
-IAryZTGJyw0aOAo2IAN4+WoG+XSVL6v1WpnGs2iZVsFm3meYJjATa2s9+LbCoYRxX/AlN4UhoN4XTSbx
-olLIVtIy16WgNw7m4T8Wde3LqrxvytmmnaZVPFtLLPuiLfOLHkONqpkKv/+eYSIXfqGkeazmSNfLhY15
-XpWLvBrcW8WNVL168ZVV/K5NLZEJeG1lxCpG9wSXtWd4e7Jb4lZR+aGuokVON671CKhVxtRa31XgdhIw
-+fyzNVNoB7QnvrlxWvBa/9Q+d7wVhPndP5ohd4el3P/2mTqUZVRQqfy77wJU3rqi/vPd2Y+j/14CO61W
-ozRvXVrCJiQ4WInbOdqrJIjQHazaLb3icH7DJuu8IGkMm1mwUfr16clXn3aQ2/y/AAAA///4nFwaq00B
-AA==
-`,
-	},
-
-	"/static/include/ui.js": {
-		local:   "static/include/ui.js",
-		size:    47190,
-		modtime: 1457622009,
-		compressed: `
-H4sIAAAJbogA/+x9f3PbtrLo382nQDV3KilV5CS3fXOeU3cmsZ1XvzpNnh2nPdM546FEyGJNkTokZdmn
-9Xd/uwuAxE+Sstuezp2jfxJL2MVisVjsLhaLvadP2FOW5Z9+ONxn3318d/o1g/+yeZrwrMKfDvP1XZFc
-LSs2Ohyzl89fvGT/N+cpexcVVZJ5W3zNzqPVhtpkGV/ymC3ygh3yLE5y9voNwpwmc56V8Msmi3nB3n04
-ZS+nz9mo5Jydnhwe/3B+PK1uqzG0xebn8PXZ8eujd8fTlcC2KaMrzqIsZklW8asiqpI8g/+XVbGZ4//L
-KQDuPXmy95T9UqbQiG2XScX32SJKSz5hsyLflrzYZwDAsSU0vErzWZSybZLF+XbC/utowi6qJJ2wH/lM
-/Ofs7ZsJO0rKdRrdEfqbqGAXJ6+ePBktNhl1zEZj9usTBp/BpuQMCErm1QBa4Fd7e+w0j2JWbtbrHBl4
-xcp5kayrkn4WPU+BevFlim0PmIEaupvi96PxK/zvPAcez6vR+P4VoUA66fdLiWP082DLZxv8+pdyMGGD
-WVTy//WV/AN+KvP5tfwr5iX9jzB5PoNrflferWK+kADw9yyPilj+mWTrTaVwCS614ysWswYTYK7xLNKo
-ygv88x9jybuLE2DFr09qXAB7WVYRzukQB8zjYdNRwcvkX/xjsuL5ptpn2SZNmx9LXiHry/drnimBqH9E
-hp63NVjn6836HDrelAH8sHrWxBYvvOLZp6RMZil3fl8mMf9etgl0kEZlpZoQz+0GMEPRJjXbnCItL54/
-b1rx26qIoE0ZImVeFel7dwRRWnm+TcqP+Wa+POI3sLidXwu+4qsZL3h8CNyRDFZk161gfcBPmzVOLstn
-v4BkTxitAjVnbFHkK7V6YXXlBSiCCauWPGPzKE11VLA8kiypWJUjOGCFVvDl3opnm7JhJqDf19YYYplF
-82u1jNVHKgFCqQRkBF2ADBZAZQ32qoa6N0d2xknZyblBeRb6K6mSKAVpbcaIBDbSivgbAoP04Wj1KYDV
-MsyzCr8gFEPoisX5fAPYq6n6z3HK8Z9XTwxcOA/VXcrLJecV0JVywZy4yNegoTKjMepA0fCgZpIAaXCM
-NLYYMKUGdMU1iNIHkphf4U4wSgDD81csYd9IjNOUZ1fVEr/68oC9sPkkeRXFsDyJof91NBrSDgjqRPU+
-HE8Esp+Tf0yrpEpBxqwvLPLuHQ6e5ldXpOG72Zem/IanyIufh7wo8mI4YcNtVGT4L2jDHP+NQY1fDf/R
-zgKJ6eE8SAXVwACJCgY8Yc3/O4et1gbsZtWSJasVjxPQ0owvFsCG0pHZZjmNhqpzNXqrM30JKkJxCaLg
-2CjGY0um7b60ySbm0qoM9ejIMyouqy2MHdcmq7a4+kDpAFksKnKwcRjss9egiWAC+6G3BqULpj0u6DZZ
-MDQn2MEB+xtYUSASX33132OhFKHXbZ4NoW/O1rAtwmInvVMu800aw7c2MtBCbBVlGxjKnSOoohtlqKT5
-nAyvKX5tMgNIGn2OX/tED390UBR5lc9z4MVmhkYTDPv55Osxjmm4rKp1OfRhwo+kCYb8yvn93vkGpJj3
-J+CrhoCu/v/2vKt7e608ZR/AlkhxceDOBJZHVeSgBYA6KY4lyA9OW34DRgHuFWILOztF41PHZQv3Mi9R
-rO0x4tdZtLJ1lw2NIwJomr6OllFZbvMixiVkrx27Lc/mxd0aEQd5D8w+YAOa7v3BuAMfWu6XAETaEv/o
-aD/fFCW1/dzeKjsAhTGJQ8wXi65RlkuYsrgfRTcJ317mWXoHzcle6uR2tSTdKKz2ZHHXRU3B1xzkqzg5
-6jNDVX7NM9XQWfvRBueI3A1z0z7Ms0Vy9SkqRkOtTWBMuPQMTLi+kFdD9ttvZh/wwwvvqjMpQWB36em+
-kbVlCR3QhZaIb93soIvNOgb2gv0M6hJ9Aj6yOdfsrLQmx9MFWF6l0ww3zmW+ZaucvEbaD0CPg+NWgTvI
-wHggSw7dRQ5aPSa5LR3OOpLtGWbT1SxJuerCadYQLhpeyoYwBNqOptLDA1aBwwYuNh94ZwH2k3c4qDcE
-bk+GJOiMr/IboQbBKIEFV7JZVDgtAZd0iWp/m3xiqU+AOXmafsxBcYPN8woMcHR4PD0CWWA4zXmjG8Ap
-8a/ZoLQ46kWg8Mm8KzcwkE+w+NEXsjkifn0TFR/yMhEjtC0ZXHfApuMb2MxHKlzRqCk3FGHhzzPR1jcZ
-7cTJFkrs+fYI/K8wGmsUJl99OiYpz6NFVKBBO8qim+SKwgAgPcXrK3RbYKz89v1iNBTNQDt8fsCevWBf
-fBGKMdCnFdXhElxKPqSd/tkLz8J8Dxoa7aUtyadYBrjaFmADyvWYlLhLr3mBLUVwh8c2nqdMDi7OeYkm
-mWwJDvV6CRbXihcJWJCM3HWMV8HqhC2/6ca1sGp+eRgwCjl704L/c8PL6m1D/2+/ufwLgq/yf501GM4f
-gEGYw2e7kTHL47vpqnSgxj4pR+3VME7ont00l3+RNTjnMGVXuNzq9fDW6s+3LPxYgaN/DGLB6D+I6PIR
-eG2V6O8CYzMC6bUeyjoDN6bqqRVnHDQ932QSVbtulFsoRp+++IKJ/4kgo7BTsrxYRWnQEyh4tSkyNvh7
-viHDHSzOAogBnSDNCh5PPaJ236EY1YYdcxHTRfJnd3UgaYtuHhoX4CFEFUUErqMsuo6mV+CBb2ZgB60c
-zWG4BMLsNoGSfBAwHjCqSZpQpwd8fPC8W4wIrbFvHc6AoOtBz61XMUR6TWSwraOMp2gnLZICRkRBQ5h1
-tCQ0uw52au+k2xaptB71QHBo0qFplV9dpfxQwH9AQnxbYrszuMdex7E0/ziKMIaR59d7ZC7uzdJNIb+G
-lRanvCgxwiACmrb5AIuALK7vZEvHgMARV3drni/qsKUQAbU6vFOvmsoV4izo+n9a2BMtpLO3b/Zb1l1V
-3PmtFFyFByzjWzx2Gf06rKICnI3hviZU8yi7icAiDZ4waJ9hnl2QZiI7HdDUqoq+6Inip5v1CYxJB4ev
-NAegJ55DdUYgMKHxeMbnPLnpi+Dtm4vDfLVOuRqL9kVvFGfCZNx3bbqeKI54eV3l6x+ilYHlSO7ZHzFQ
-eu9ZC1JTuq7bPYhZBX7OiN/OA9arNmkUhsNdOapALU+Y/PMii2YpheBgA0LlDQIkzzTZs2dsyL5kiD5I
-ltf1q/+nibe90rQwvU18fbSBB5bFIppzbSUvQW+DLIsonTBJbCdyICSeQgYxTJB0xwZjsOZJU7CDRhWp
-WXwVwEJqRmJ4bqBwzhot943cqj5oX+yE9mVftC93QvtVX7Rf7YT2uR8tugjqyM2eF/03N0IwMMwbghVO
-wIG0fU7wL7dHF4w2CgPqDXzTB7LczFZJ5YzeWBI4au+wj9VR4huvVBpNXGLk/lkVqR+8+T0E+zqt2kDh
-Z8908Sz+GM0CFIsf/VDH5TwMBT/6mAS/4ACAkiMeGKbZxu369mZ9vtxUeILkR6A18EKf8VmeBxhV/xyA
-RG8+DAi/hpZZSUfl/nn50Jylh8DpuP3hSIC6NsGAvZvsNRewPsRvFUnVKIBkHYFV7kdAP9XwLqjtvPoJ
-aLwsn6QK47UNXhm4ISYIk7aVBZrV6yIAC78VR/O7B7bxGPoTEJCiejYvK34r9B0Z1jUV6If4NWUQQaNq
-JfxbxLgDAuE0CxRzyorIPIJgg89THhWB3R9bHeLvQUYoobjEHAMCp20wv+FFb170xdHKj2i9Tu9cDSgw
-v8Yfw5MpZjzEAyVPAWgR+nQmgJPNeJSU+I8Kf/pzOVT4tE/WCEXppMMk91JP2BOxCTLwOIWcmtMEdmOv
-50aN28ITzY+I7lLOxsgbJ8PeGzfcB+VayUQxDJh/BB+yJts+LvMAEvUNIJJc8FVe8WBEBaxmdIhFK5HV
-hS58qO2PSxmawPCGyuQDO7sEuxp+EZTFE7aNwNLZZFWSUmviKPQRJZ5zEIma2oGbQ/GV59OvWclB0mLE
-jAEmhps3ZntgOxlfpaYkY76wg4YX6MrTDQkSuOTUEy9L+NMLRApAHYPgjOm5bh6u48duBrPmPUvxzwJ+
-VD4fgF2uotsfk7hakihOt4E+PVDfcUwUFWDLFjCK5R1hvsto+Lqq+GotciV5JR3Oczy9AD+uh5fKBJHg
-8+HJJvwjese/xz4h1VkGa8HqUyCbsNYh3E/Y195zp/sm/cBaB/QnHYFaP6AxJ37sWCNC5LFpcIXQYlf4
-6FTjINydf2j12j/D8CHAqxnGCBr9YLFoYvbYIp9S8dBeMhqTyEiEdX8haE1czgUDMECKk+1BfFUjtjMM
-aIp6uP2mkm4LbiG7MK8DNAttjlroSn3rnLXDfH6XxOIwVJxozqKitNVVUmJAbk7pI+ZJU41ZRllxX16k
-oAcxtSKJY54NPFvQOidjSB7p16d2NTJPNh5iBLDpFnWB+/NS/rykRW912UbkjUhHtanEVZOUP0Q/jLZj
-XCji/0vvttYSyAkFlSXIr9t9BsK73GfL+z4hIDq8jigrfaVSeODf1RrkFYNQ0qQReavzPL9OuC5HdSZs
-rYMpP8cnRuAZGhKE4u1J5sG2N1GqpWgUQJ/anT0AWjQY4T5HNYB5+QuYnpiMCfU1htfwbyRlWtU6Y77k
-8+tZfuvVUIgd4MFYPxdJVWP472m+5cVhhMsRTzh/HT4f7r+YoAEj/qV5g//eh3SeGKBnelunuIH054yE
-1j5+pHgAuN8oxHxjCkzKSaYkO0MopCRM2QkxekNrOMsxv7YSGcw6MhHlLJXcCBQY0RRnSqoXhbQGldFR
-r1hNRL9jPY1ednce3agsNMKLpku1BAJ9logpMTAQS2Z8s6aEcVskNXkGUV15pZK7wjqSKaaSL5b8I0W2
-VSrl/sGrCoe8i9hTW2rB8QoHEWV1rxkD6jAGgXLyeMs+PKXkX0qbaBKAdRw9soCNsUkwTHGWXDw4aETG
-B1oPVWQs8fgEsyqAmiRsWM1AH10HDKeuJRk8EXz6I0+KmJ0c/29GCdQMjOSYzsiGqLWGDPxNMJsL7wEg
-+Azgk8NUwnrDO0Soz2E6hsOplWapuNVwh/KAw6qGJHHQeeaLH2KjApHy4oew1A4tXUvJkKYwN5sSmvXf
-bYCAyR+2OIQO1peId49+xMrQelBC7YqplPKe9oGGsnV28NOu7Jy8cc1+HcjWNF0xGw4kx+GfwQH+gWTA
-/4cDC0uP/elEXDVha7w7RwfkaBns1TdQIuWBKv1aA2uJbs6WAtPwKXLWAHR3iFPL/t+GF3dMJFSDwkrB
-xoNxze5s8RQstk0XI7s0IHkE0bIU242hegBt89mcOnbMZY9ZeIuJhyzSV+oM/57zAi1isfRrAD1N0beb
-e24BPY5SQ7OoJAsKwjMRhK8bONF3jT7Upz61si7XhkLRo/vepON5mpeiD+1kig6mAJWbRpLlmMzlHFNp
-+f4kUav1BpQA3bcYAZ5xg0KE0TK/t60pJByg9NxJtMO3A4DMJANHB2/XouUJgDuarDYK/cKSYFzzc5+d
-xss5XwKO2TjlC+3uB/VIUaC9l+yZn25wW/lJnYwVYD15j2NE8t/PUbOtb23HDz+uNKLxE7FVdJusNivU
-Wi+aoJwDjgcuzr3NUBAMhKsRu5HMI3YDOsE5swN0bs+euIPRY8+NXzHkp08fRP6TtTbV6VZ7WsIh9uwk
-dJn48NM7l0ssQn83tekOaylfe1JatVMASjTDNUZp2f5kEOPEIJCITKxVJ13veLYJc9igVeWMATf6kG0n
-jHWR3p01FqJLnQXJpLc2mvSryD0JMs40O0n6SEAeEdSouL1Zd/bfSBe07ilVCq4+5AW4eRqVJeYjCZhG
-S6rjIq+I1BTuFDLqpDmkVHcl+lLZrV3UexKqOrWHJU6WDjHF4T+a5H+UJqlXbRs1fVavk1DRV2/U4sU+
-hLRHfx2mRdfr9MrdNImV9fFgfWLR/ECt0jqKNt2y+zA6NIw9nh30jJxo7bLMKo+5pWWaPJYWDYPyUN8G
-afDJSyV4JADdRWnFiwxc2RssvhJlMcrWilfLPHYGViNb5f9qrrKY+Joc/m1eXKPDJpC5NmaNTVy6eOsh
-sIWC0mkf8kBqGH6r9xJyQALNvRnqTdxFZ81hlM152jCosycPTN/uBO+OdxuZD6j3+ModO7MBeqT6h1a6
-QUjnJa1Oyjox9OVJnwtfO1PjQ/JQggL3x3amKYBnpH5/fXr6/sfL74///ub967Ojy5MfPlx87Ely8LZa
-F40hwD5XSvS/QjlNnvum/htbo0ApG3/r/+jtuv0O9xEfui23Gg8P6MdGb0z8Z5+ZyaotU40hOxEa85kx
-lPoI1Hji53oI/Ntv9YzIfYZhb4SUlTmwLMfzMYaKptMphsE/++wz8yRswlJhpSBQcwb2DX4N//nySyQb
-oD6rc3yy+Ht+R1HL6XwZFYdgobyuRsl4jM3ukQVB78lj2pNJvWdUcXJN9L+QK7Wje/Kne1VqoEYq8WOk
-2hz9Xymc8uf7ZSHHrJGJsGe2i59qpj5hoZtdfbPHzb5kh0tzKI0Epbc5M5U1dQKlDvR2VD2nRztRbcVb
-D8NBqcrs9A1B92N2qwu5I7e7HEiX7f4cnHC9FGPgHd6mkSWCinhfb8TY+4yW1aRphPe1C74osMxWLLJu
-xBGsOKcspw4CUlsWBsxqT7R8PM8ti3+/3veo8T80JtZSQcU8q6xLRLVVHfHVfwrkmpNRIWtFnotaG+Uh
-VYG6ODsp/Qnx/r5k7aiw7b8jou4iVOqjrWRJA84uGvRxzzy2ACFYrqYXn8P5/G5bWf2qV9um+lWv5lT8
-qifBddGrfkRr1fX6tK9LDHrOKaE1KpZ+MWJLd5FeNKxGG9W/X3f8la2m/0TZ7wPzrNjR1whASPP64CMs
-AMdjsLSWtQoEz3AZYM4GuOybirbVO3kDhBCNawhn+2lZI/0Y4pH6B/Gjkw2W3elJ69ujgTfSTKVehrQb
-D+tCVCXV9Sw1q8PYtlt1huV2G4B2hpltlAY2a8fMDW7TD92i7R78m7On6JoO1OxqvtXou89mX5DBQFNr
-0/C1nYtA/bmexeOMwXv2cbuNf1e2WwX3Y9cbcXdil72hPdghLrj72i1D+25L0eERLOZndfElMPDpusxY
-FiM2sDywFq8PRd/6xPZgW4sCttfcM5fyN9+ElzIl+emq4oN0LlsUhRYnU621ktGNdzr1pfLv7VF9gLpS
-k9Bb04BmNi/3KpSoYuXO61HNAUj/tWCTNLpppRkOtE9P7cF3b/3+C0e6SjerO/RjdtM+FIzXSj50o9Qa
-t+ATZSB6YRNNW3HBzPdEVfIgJrP+ip4Hu1n5Thtkkib8KjRxe7I46A95RiLrb4VKohI+9uxFm9HVlJAL
-KH7fPUeq7SpPXXBIrTsAhrlnaYJl29jPWOl08nLyla8sPDUUN0Nm7BsBU4fDZyIKbhNIMMKwODBKwDa1
-cvASAOH6eWZXg1cMUD/LvGxnjtRHqgLHBPNdmGjzs0N4AtHEPnc8tSpTmrzB5E0oD5pPWJ7G8n+r8sov
-2OpyvgB55UzjNZqQ5tcl7GBY/4og/GXYgA3DRZSkIM77wZ+xIJb7K36oU8dmpVszHl7hJ3BjR3Qliw7s
-0pcAeUhnTb0Q7+DxYzxlkO8S9jShtQu6vQULP3tPcRdIsapXkW+ulnap9mYw8tWaP4dz9Sbt7y64yXVz
-KbBd49kdU9ZCH263bN6aldKFSDNG/JW22xmMz07sxl5ZhnOXWdytk/tQ8cYR6Z0et6Fq7jyTpwHPZhGJ
-Na9eV1WRANM5VjwCWgcTQXPgBC507QCGCMR0OS7eaul1c9P9lTvynshhaB5HiMGxEJU2YIvSIz6Iz9Le
-Wk8dZ9aNi6CKoLRWWHEcEMuPdrq3femGl7UXrYd1a3JCULpT3QPwoZ52e/A50NkO8f6douG7RsJd+euV
-D9N0o7z5nWZG+do7AWmu905wwhPfCUR3zAOAjujUv4WPiToKxrtVJL3T6+at9i/YTTE6rcrizsByPWKZ
-wkt8js6HINSvp7pf7/7b1kvZ+XhCT7a1nKt3Ma0FtA/L2nruybYAigt/Bd6Rc1HL87gZFWoQ5b/kIigZ
-rkKqx4/1Va+MqgoS7qTCcKtQIzFsw2QxwarAatxJgcENYbTkmfZUQEcgR8X8zL6e1o6Avgm1+QN+g7+X
-t9DTqrZTAvqsDgVrFwLc0ajeKXFDAvSryo2fVsO51Ur/N7PlISZpL5Jb+uxL9g4GLr6kqn/hBDNb7al2
-wxEP36x16FMamn14wwtfTAmrG34bqKJh3+eymTEUin/YU/F3oqMbyi5/e544qhH1PHXET+fJI42nR1gF
-U3SwrIywrbDoNZXAmYEahjHJIldbzq6zfCuycFCRipr+bws8bHuzWSxgKkQ5GB0vDCkh/Vw/wMLyArFP
-zd656hzL73COD6tVOVtF1xwgC5U7VNzQW51U+a2uuCUkJ5al93W087wArOtcuCbUXpbyUwV7jJKEdJBX
-8OZVAkQZGQWA+G1Syqp3VIGnGYVW6d0OTS1mG08kKvTeT1Pi7tIoKG/ehb5vXWskkjLngHhF1SrkS3Uq
-TZrRi5nWAjSKxNsD8dUlqZOuCRusA1UYgz0TT1gP/IRqxfXtbnx1CvypyDWCrmxkHVVXDrS3HoCl+8z+
-Q4pPSlJ7qL0zVatfFIiKu9GzIW62nie1W3s3Ui/8ULQ1l0EcE8Q6ZnLBKKPSgJE5luF+qiVVnMvmeziv
-jmflq9u0tweaRnSVYL0ggUU8tnmV5VKD4LsVohF0lOBW5EZx8GefxpVkNceJv9BcgPo7WbxLQBuA74xt
-JmxAOAYT0VW73Ytdjj7HeaLyefJ5Tl//GLnc0giOMSo8GrzblFSmTEw7VjZDWFvaPf2RXy/eAMEIg7cA
-rqaFZDDEPjOtYyRh/dUERGxgPVQShiftLAMLNgYVbghDC6ffOSmWoYAwXO3326BaQCAM3bjybspC4+Tb
-q1ciUI8j4oyKpz4n9bKbkAS6x+zCuBBP/Zi+kVZH48J++c33CN/eXneYPpCH0yM677jZuqZsbNfHK0tk
-ZIPP96jjGcc3ymVpPfXWDjj0VBtQPf4tDZJ+e/OF8dSL3eXDTj92O/Wwx3hE7wvHmi3gy8RTpt0Q06Tn
-Fb0vzHTPMzRbqhx5xw2z9irb2sGrevpCnr3SKQEs3sADrL7j2hCIh3Cqgf4HU+5/abaNcAvCNpaohHyv
-PLqgWWO7f0rrNHmdZcXfgn0/GgRNmvoC2F/rulm/YZFl2ceww05b3NlzLjbcOZVBQ/+kDlMh9FovaKjF
-YTWmYTMf46SEek4KgukLu1SKbwtrepPfiE7Xq1SLwsDtKRS2KWjmWVOS+krusMisljfQJIPoHG2W5yD7
-WShN5KJs/Dmp2NyokSDCtiE8aXoee4mAv/iCfa6GEyDkIyYbqflnnkLxgYT/tiduSRUpAnbrf7HoT4BP
-z+JHLxVfz1qosZ6yE90CLPiF6CR7bvf66tb7arP7S9W3tVRUHlIc+dzjYHtTg1yxCDD5ZFGzeCJ95obn
-pV2jsZWPctadxl2PT9jt1fsTwVvsO78S0PFCgBdGVUhf5xWsxITK3td10tVjaqy85qpenDBEg+HMkOnT
-XTldowgDS7d1XyCWxXUp3qLAunnw51VSP+8g3i30v7EHqIrmNWwJR5GwWQ5uYQkDp7q215yvMeA15/ig
-HHdvyeMH5xf8uEscgnwH4RkbvWRP3Urv7quK4+ltrwSQFq4FSrnbk+9ZSUR1yzsLPYON4mU+Vq75HOUE
-Q/qURl9oSwm8eNqQYlCke6DLMGyIRrLGU+/JcVfhf2HVY1UAwz7x36d6fBYCrs2mS62Cs5Wz7m8TTlbX
-dG3DsgUGafHGo3pwwoayjoVDitw02zqP9L0FXHpVzpErVCZA4JyfHJNhJcdhPLRNJYqmPhzP2I+cosa4
-BElqGqZ4XuwG+c3m3IeI6TpLPQiOpSrcbi88L52NBlhHFnutu0eaRX86ahib9tS4KL0LIzeotK1a2Smo
-Ib6aoW45JDtV1KvtZdp4JMBjePw+AhAqmKLWjjGK+h2DgIwofx01kjDlYJ63uKWAqt1G9XM/2hTD8j85
-7hp9iJ07cqTREOLyiZEzs8Pp0u+AHz/SojEzd8JHSD3Eoada1x4DME7t92jj9J73qQN3zTNCiIc92HWz
-mhk63X6W1XdHRdTDBvwGxXR/Ky9pmwx4Yv4nvrwr1nbJLB+oN5hSKkf622G+9UJ1UFCNyuNWOvXBYXly
-H4h5q9kOaTnYWpNOed7fT8ylwofZoeRBdNmSsnnGi6zeyDWfAQy2/xKYnHJ8AiOSJ1mCWpcHzTQZvD7y
-iJZOGfpPaGqYskDHgkk1DL5CFs1FgSTS8on2aDCFFNGs8ZuBq9lDymXgx4pWq7EFN/R7r5goAwJYu1Rv
-G7WJSZfwO7op+AbDTvKmILpv2Lc+MePrs9dVBPz0iHdosQuhwnBTG9Q5wAPfMnfb5zM8VvI+9U77a4sE
-95GntoIg+AkJVmhj6OL4nybf/r/0Dcp8hPtR0d++m42NNaCSAo9APox9u7Au/KzGI0VpBzEKF14AHY+q
-hMWkS0pwO1V5r/fnTEXdmzCrljDZEZy+niVy6owHt33OX3mtZtlMyHRb4nMWgFh4b+pClyUBKACqy0/C
-CScdKubGMw+I0FtqBz9Vccd+pSYlGv6pONs5Q2d9lE5YijXykZHvVNhiDm5HJdNkwDRwgxPqlf8Cc60I
-1sFMCTdKp6HD8n/ynCpTLYt85e5zvgEHtDew+rGV98IcDqVRIffwyWAff/sQ5Jd9d8i+UsNewccAUg8x
-tl8zwP1bgfmfM9idN54FEhZGT87yHzaZweXiozg0uw8guLUeYzOBWv9dN2130Ea1bsEUK3CDXxdFRBkK
-Mq/0ex34lGfj6S95ko0Gl57TMRin2V7XXcFDL3rHV1h79SgZvwEbXpTZopAqjBTv11UTev4UFY3oQLbD
-kKmOExsYw4a5F/U61ZNf6MZc8YwXyrU0k/oAWOK2EgrxKU6MvVBa4z83CXj4Kg4DikqFnEoMFLzO4iJP
-YhlxAr+kTErhmhi5f+oxYegSS1GWKp8uwBJyGzalvBQlwxKqkUYtfHWCI9fEhDA4zl0/uwRk45MUE0Iz
-raICDA5v0pTC6MhD4EqJK9vtZytIT57Gn5rH/5ye/CM4tZ/Op73O603CD/isGrnxYoOro/1iurfLpAJ5
-ifB9pyq49QGqbZKmKu+VhCDGsBCG5vQ9HWfdkCAHlaAfxvsuqpbTVXQ7MiaiVPvpOXwB60RNmMTvmGjG
-puyj20S44wZdE2uR0WNaBZya3xrOaYrZNcT/0rWaSAZK0T3ieyYRu7azbPkNe+5jQ9MH1gIQbXvaugbo
-81YXD+NF+IisTB0CUtUbalThpMkwZNEc1GBGj43bGDAH4Rkpsbl4OQ/c7xxAJTPEUdkqj5PFXZNujEAu
-80xiqbyA9uikED/YAwRLJ5LH47rYrs0JZHMtBlhxl6rtgl/aTHL9bfDZSmdCE7+LZ7BdSlO4sf9Khe32
-2aym285yi5FquZVjmqSGeHRhFif+6fvLNwB0jkCtqrDuquGLEFLqWKq8np0ac9RURe4WXfleLTCE8nNU
-BK5RbMHt2FmOchjfMjq2DFshD95ImjMF2dU3/lseIqKI6WvpNror2ZLezKXn+0hN00h9Q/NhUsYHLWfY
-OEDx1jJBx9cJHutG88p7HtQ9qppgvBAB1FVgquOT4Rs8ANUpxBFgvIveR5Wvqoln1Hz4wDyVtyxqU0c8
-FUwPHpNtoyPGKw5XGYZaUdf4EBLTlnSwApyP4ri+XsEWCU9dIowdLmRyq5EjYjFDBbAUXBXcatUpvBC7
-JZ0Qo0oE9RhjzDenczjXSQu8FUfT0bhS8sG43q/FBYxktfJ6OgJk13VkST7eD9nbE8/szjZXyK/k/Tku
-m7t8o+5SWj6UBUyLB6QHjzvJ/KnzQPP5fFOAnMKwb/G2yMoGpT7obmUtXskcq98JbJnv7NTvrLLwq3/i
-iMqcSDN32ShKBKw8xquvANCW4unKh8db5gpRb+9TXtSoil3vG5sIXqctd/jaL1vDHvExmj0C+ricP+Ki
-d83736tGtTsHbSfQwVlrC3L0mbSHT9dDJ+qhU/S7Tk7vOekd4GqY/djVia8+v8/a16Rrrkkr6PLUm3Vp
-zqshEo+ttS6p7ZJebVQtFWfDg2rL6XnAqDoGs+O0w0J57KxHabX7pEO/vSZcX8iPnG9JZ9d0N8PpP9ty
-NN0zvctw2kfRe56lWnv4LLvDBXTBCoSkC3/PzgBdtA5dw9DskK4+Ow4AzNDqdzxdCxsXTG2mXtRHF6DI
-15gn2JhQ0OA9/a4RIARxlt+Kq7MTEa/yxZoBNc5mnaU1L/BGmkzQGw3ef/h48v4HO2CMQFN5ocO9GEu/
-qsC051JHTZx6838KQxghVJjJ2j21Phde7AJatCPireeD+oFxfF3/7+8XC0COt0aG61vrpr5eVXGWpKqs
-YlM8RT60rSP8yUbo8Psmm9NL2sZJsQgFN5m5hISSsgMkecen8NZd2KOSAYD7V0/uxyj3/z8AAP//PRl7
-Dla4AAA=
-`,
-	},
-
-	"/static/include/util.js": {
-		local:   "static/include/util.js",
-		size:    20063,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/+w8e3PbtpP/51MgnpsfqUSmbF+SSey6HcexW/ecx8VOm4zr8VAkJLGmSJWgJKupv/vt
-Lh4E+JDttHM3v5njHwlFLBb7wu5iAXjw5BF7wrL8l3eHu+yn87enzxm8sihNeFZi02E+WxXJeFIy/7DH
-dra2d9jPOU/Z27AokwwhTpOIZ4LHbJ7FvGBvP5yynWCL+YJzdnpyePTu7Cgob8oewCL4GXz+eHTw5u1R
-MI3ZKC/YXIRjzsIsZklW8nERlkmewbsoi3mE7yKAjoNHjwZP2O9iAkBsOUlKvstGYSp4H8gHWOgfFvEu
-g04coQdPxmk+DFO2TLI4X/ZZBFB5CuBxHs2nwB50DBfJOCzzos8OYKQF//x++DuPoOXk3eHppzdHV58+
-nsihB+xHQidYzEdJBuxOeMEfLcKCfSqTlO2zr7d7j5BGZPJteA0cFUW4EuyPeRhdSwYQOozj43kWQYcR
-/Ees+lGKtEyBNvzWY18fMXiSEfMfR2kwK/IyL1czfoEwl7oZH0luIEn6UOQzXpQr3+6jEX9lPAOui3CY
-VoJbhOkcfyE9t709Qnv7CPlQVPoHyEOfebO5mLz0+hbNgE1TsjEXnIG2kqjckEjKSSIC7INg7F9s6+b4
-GPDjGK2ot198C25C/v337GVPDdE3gpHP/Qb/z52/M/jOs67RNcT2i7sgHsIBWOLJEdgwF2D3JRPz2Swv
-SjYNZ8znC44TBwBe9QDwHIhlxhKY/LFIYjDe4QpY4ext/meSpiFMQ5i8ct7BPIKuABsnyPlwXpqpTT1O
-zlkqZ3wAcJOynO0OBsvlMkiGyTBN8iAvxoPZfDg4TbL5zUB5gLPBNCkD1bGhBSDeUQG8scGTPkl79mTQ
-rQ6cUCnwvC8VA6/jcrJnZg+ynY8QMXu8zzb0ABv2FConRb5kGV+yc4A+Koq88M1UMIMUIO59giKafRip
-V5FAdEJ7WIzJs4iL7UvZiv7NR5AEmrf24L/vkGB4efrUpgKpTVB1iMpuwAcGv0gupccIojBNfRpQygda
-+iyR74okSbvFQcHLeZEhHseIvnvVtCNwlvzm/ejfyXoUyY4F8bQkCxoV+fTBBgRT8ntQVqV9xAIg7+bT
-IS98W8s99tdfCEq6llA+/f8d2+qxHyAQlJMg4klKX3tsV34ZpTmYGX2qrLXqV6mfvj3dJ5uxNUp2tcdU
-F7IofG8aFUEou2L/+lfNvUiHdoFAYF/7+wzk1rQ+Mh6EaZqXBbC5XRnXMY6K2hWg3hjcUoqhKZhKgyEl
-82zz09kAYrEY/MqHg5/DRXgWFcmsHHzkIwitWcQHMuReySgnBvL/wTVfiUcUHFX4ww+aausT6cJYhM2W
-V9mBV/GEmp6E4v0y05EUMCh8Zh4ELoTrr6HtTZ6VR+C1X8/H0Pux/7XMz2CgbLwLvjxNb3uIirqeiCMT
-kX1Pg3k9F2Ws8CE3Fw3tVd3qgYPaTnPwFrwbguI/zpxmk8tmG0QiPmihtKNoY7QNDhMzSvXywnNaLztE
-cSpn6X71xXh+00FbrdF/Pvy9btlWiIBWCBH7zMtJ3x5MlWabRubhpMevOGNQq706ZnzaAotnmyd6cvCO
-QBwksJtqYMuF43P7yPmpQtEcvBuYAzh+lDG4/z0XjLwDNuHMb2FcM+8qWYYWAJdoW5mSokUCZBZEgHsN
-sNt1TKiRrZnSNhLxYEXNmvYbEfTevBlEEDk7eazz6XRq8tvkuU0KLnIdkmEMy68qL9/DHOSWfOmHSZiV
-+fTnM7Yd3FDAzrwqXg8h+vURTOQQBsIFl54e9D7PcF1S9cZFGbQUHMBgufbvEOGP1YSDII98OhE+P7cy
-JWsmE/s4XdvTPZBUlOaCixIhszGb5UIAYSC3nBg4Onx7IAMRe273wrVpkcF68kQcgi2hMzPUrE0mNzQX
-VhBBbtgm22BPO60Pn43lJCxRL2WxQlqBxCFnQ1QGfsW8LVK0bDRz1vCgGGPYoDzJGlygmOWEMKlMn23X
-As/oPH+NVMrMqNb27v0HZwELEr6tgbwmKmtADW61m5aDBeFslq58ZcG4po8of8fhwCGTyiGpwva1crvn
-sysxNkNS60PiDCBYRWHp30emvV7dmSs3jfxUfXXuaT6obJIE6IChXWFf9A125iVBTfaF3qDgf8zBxg+y
-ZErT9rgIp5DyTJIpWyYQOwUvz5Mpz+cl1gLSIZYooOcjWSwJrO6yZ2sq1UynFUGsicaiQj5//eXIRvVY
-8uF1Un5s7dfeA3LKdvDOMfJO+K4eU9HdpdbDKuooubaZvcJbacFAw0Tc2tpiA/Ziq2k9MiyoGtPmNz3Y
-8x3QLmZhxLHiRuWrv4VvoCk6zcdjcFOQ8A8h+y2ArSTjQkLgKMFVmo+vUlwOgEF5y7DIIP2mliRLSmzF
-/o7TIOhug7Mcv8KLiRqGIyrSebbw9cA1UpTfhKUPIK+gmwTb0PXBlUJVjZGo2DBUbNQXZW4nzFBCGbLa
-u1gWYwZoMSoPiPXQq9l4A4hvJQWilsybNPCQDhy/e10d6uZqXuuyfShDlTlMm5HG4eRuSM1CDbKTdjuq
-kk28IetWZnSSjXL9/ivQoN9JHo4hTwWmuQpzVcne/HXr5QucIfhZgG+OJjWjxyeCjI15NK+8XYdUh6T6
-aLrmHYAg6dOezZpEmgAHbTiJs2/ESdpowUkSWocTO3YhVYprYu2StcFKPbvQwtqL17AOCx5eV3AwJUNI
-0l2YKtNTWV6SwWo6iZl2YhSzPcjwlN95yja8KklzjeCpNoJb5Q7HvN0baptQcbbhyaA/lvbAmSZAzJ/c
-UEPtTVdL4YS+TsNrfjWr6h7VmJSB6G2EaR7Dv8hbu082ySdwAPmy66Slcw6LwnHLEtIZMolv1qzVoVWu
-x9vdvH6UjKjA5V15oADaPrmA7pe1yNrmn9agqC/RzVtHKGnhsKPI1j3SbSO9J42JJmrQV8SFuAJzrMed
-jiaLgAoNwPRZiyK+TRn4tGoCSLeowlHbygldCmpDez+MD4pV/4fCgZH+vjzakdwR8qzJLDon8zDPU4cn
-Y5CWefqO4Npm9mP4jkU1bMec9Ku3BSF6u4/uWb3QliW+37YWbbSKEOqBs7uY13tUoug5U9rlHTz3w1nX
-o87CQvCTrJSmtL2FoWndYKM0D+8xHKE9Rth1yMBd341Klo3X0gS8/UPKX6dSp8rwMN02DP+eqsUYhfZY
-fYnzqf2FeUW4vJv3XkdEqGcP3qfsOsuXGTMRWKYPMIcp1u5RCUrkuIYPIX6vWDTh0TXox44KCMJLqmLJ
-kFNfpVAov/Awu7DcQ7eDaod3YntzaCUIGDbjPOZxBxHivkRgV0w7JEyxbLjQdoSO06opukK3zO+L7sHO
-v+GH7x0JXNvAzpg9Qr6asTxLV1gDRGHnWWTKfu027tpmtXVMJz8kD40ag4ulUu+UjrQwMeMR5JZayyUW
-KJecaMNEEHw3kqZSZlmlUwK9ghlz9TCp3otu3GBMecm1wCm/dcaRs6fg03wBFJYsHCHpQK4OdLdtWXBC
-W/5WPafapurj2Z7uokTbpj900JtU9Z2LZv5tj2Uf5QEkF8nlxdaled2uXncunRM8hBXFoHXhMEM7ITDK
-qG90pUlCwvW3K7WPam1b+Qa+2kFHRG2g+N0Cw29XtG9mHDlJyZKaveFj01CTnUF14UCBGNCotveU9dZR
-Gjo70RmIOipNf41D3VFXZOsjtgxm9F5lVgCPg7tTRFOx53jCx7KH6zzM4tr3TtQy1AQSC5Uz2YHTMsns
-1MfapsORVCec0qTHmruy56axpgtNdO+OjLrRW+uxFYNl1rLS+IZH6MLpcMOn8+PNl1RkJDHE1AQfXzoG
-Py9HL4XMaO6qXisMH08O8+kszzgkaVxE4YzbSHrSa2iCDotciM0heG4B6nRLn7gkf7MCT5QguytYk4cx
-E7TFJKgaj1X4ucBluj6aGCyLpOQ+HuJi5jjELlO7afMsuZmFszCI8ungdzGIAXkwKaepKv7/FGZxCt4L
-ozEVOZZ4VpHGvdLj0g7LIr/msZRiqCgij45IklLwdIRw2A/AFknooAjYeyCKAUuGmVCNAtAwNmLRhcJM
-gajWCVFYIHa1C+6DyYFR9IKqAJJkUTqP+dW8SNYVQXS8tY9sNoqpPzjNu2xDIR9s7JmiyxUSB1owMsIt
-dt0241lXmyNXm9BRAlpYf/ZowsOYDjIovQPfRymn7aPXq/NwjKV630Mor0d+X45T1TVTYVW0HfL7bFa1
-1ci31nbYq3EglduzfTkBPpifVsej2Badj0gFkBQUQNzqrAxLlVJJFXv1jZHWpx0DmPUMI7rXWOnh0SLw
-sCdHEPVmyJPMOJXl5rCWS8ZjsCt+w6O5szOr2UWRACdikoxKv5b9DAZ2bXND2arEvoubtQw6FlGtFyon
-kNQcgqRiv3kMDx9y3rTRZ7Fbk5Ev65nZGKwW4j4XwBIIut7LFnOvjqMVuJJoy/prJgJ1hM6Xpw+/33cP
-oTmoIQPNQ70tDL9oKIFDRTCrx7RPOU9b6g016WqSXAET0qaM8QEyxQy3WRsE4+Z17QSMMhbcqW/1QSoH
-TENRavPBz81tZSUgZfkoTLL9NpxdCX0bbN308Ok6LuLWY0xyOZJZxgiyDHI0Js0YuWmGYs9yMhHorOTK
-z/ieBLBPHskvgdp29kp+Uw5+DxehgmwAgsK0p6k5bnDWTyV5F6NLO2dvzDQMf85UM6ibhBkbNL9brFA7
-NntUdpJt5gWeVVFSMY4CXUd0LZzpas9GaI5BWp0OiS1DWFnIMzfW7IMAZxzikIPueL279BzIfUL3GqIJ
-GAxY7XpXBh310ZrkOgnwnMhyTEdr8PdApRtX8iDL1ZHGcfUemXcwpeo8uxTIXXmb4ldu09OdjVFSAF83
-tOwLxSqL9qkGR23KR2f5so34e50NxTspA2WqA0mjm0hKC6CRMYQ1639N/9zgtCp4tAlDJp4mL/mQi4Qs
-pgqY9uHCZpwHdt+99gVmiL1d51iV4R5MRiV5IEc+FRjLwGtAwkjr2rDUiGB8OuwB9nJId3Q+wlKEqQkn
-2FAaN8p+FsYI1ceDYTIRhJWBMMWacpXieZB8nsbs3ftzjYJxyEdBGyY9AM4shuFX0EqC9mcqJ/vq3Xi7
-dl9wTqMSZrXyhbNwzD+/H43AaPrMW9Vgy3zmgn5RoI5evWUSl5NaV/oGKCcc7yzVGuVHtd6nKz1gs9Mc
-FYY3J0o8C5aoy0fszfu3MAHI7ozqjxCqRf+QMPUZLWMEHrhdn/LxRUl3kD7Tv1/6OKo2FEoSoD/AAHol
-AaJNQsjvPod8llf79gTQcyEW4ObJE8bn+TyacDw31fwI2Regwe+lA1XWmq1T6thM6sNqqP7xxfaLyBvQ
-YAD37JYvVsuXRgWWKCSjMujlz64BNPDTKroN83gVwATO0/SUjM6xGgOmX5RvsXq00qvI6BjoHC32IeNA
-B3srGEzACqDawnxZWNCSV+scMrF1J0wUANu2R5Anh8P0RiYCn9kmDhrc7DmtK9n6RbWuqlbsR1cXpuGN
-L1+SzCeMZMFy5kG/bUjHtqyiz6qz40p2lLOy1tPxIzdsIJlSvmJl/SYK4Jvkzf2+Ut8NvDX1aSpD6xhP
-sdKRroC9xnOxLM/MQhuPrYooL/FyYcGXtN6G0Ac5ywCvP0aQ1gyiodikCSikmwC3K3E3ym18cU5VvFG2
-/jwTOtkQxowmhKheMlLVIhvE38gzzJmsESoj1ieNG5ONkChqT0EMPHPrSm3tfjVEXwbb5kjVhtqd+x4b
-P6lCQESBCA+0DiF1IM7QqhsFTVnE/QcEHPM7BWyBfLuALYK7ZNwC8r8iZjlum5Rh3TlrynhNXCPfTd3w
-wH04pgmFhdXGR7+nK6pEc/PBPhEe/E1fz4dDcmTEqS5PyqFgcYz0vZFVw57s5n6810hSlr/gPRiTPdqS
-uIrmhcgLXMqIK3XmnsdmjSuBVNnvTDaLQ+ry6eOJaC1bmZVFF259qcRWaLGqJeJ07TAsIFqsWdnJnK9+
-p0R2CygFDCQNuMqbF6m/EYdluJtMITgPbjYTWKTtDcEpvnjWPzg4ODw4OIJ/xvB6cgD/PTv474ODY/x5
-iP/gR4SQ/9IP87vteTP4/+eff9plvb8PGcIO2zF1dm+veSenxS7aShrmdKC/8QashWFBVYC7nsIaQxqT
-seW23cn106rlMEbXFn+16dFFh3Wv9VtoaTtLYq2OYW2GZzX5TVSXUnUqsZO0Ei+gQFc+Q+cgKx6Iaa+J
-6L70td34XYNApkKQCZ2BC9EbF5yKHWzBC0F/d8GkRLBMyss8T4Wqzx+Dn5nLS9Ffb2aQ1e2yx49944c4
-3iwETwQZXZgU1KavBGCJZIoNf8x5sXK70aczWHXhxmcP6bvXrQNgwpPVUkj43B5mk+CxfWQZ9wNklWJX
-Bxe/p+6/yO3PEkj4IAuwXcf2FObHDuY9p1aHhKnC0d2UOX+BwqbQ17L7/Pb0J0hM1V0EBPE7ZHeA4eMH
-9gJ6P8dLx8+shFwydy7J6uSOVqLtlLnG3n7wqnXWIs4OVprTW4vnbgbv6S8UwmedM7qhOlnlamqOorEh
-0PwRkaAME7wliNexYleBzpwJaMJI9bkNxCI2PN95jlrb2SLlbb/CVMY4HMt4dMLSar/qGPCvslbXpehm
-aoFq6mKqU6wPOXhnMqC6RNrr51rQd8mqpaK+7pyktoftVw8oxdtKuM80cGyqUg3MqHmrZhKps6uFu0yQ
-63OAxLvy/5WUvw38i63NV78Fl096bLDnwIFrxO5YBaq0CD6zOBjjNJoiA7D+pr9ccOF5fWaNedkzf7nC
-Yqo6VOgr5PLEYmPCjHl0vc4H29ucr/Mb6VCwnlztqEzzP08yWAGdQSrLs8/MJMP2dGrfLj1MQyFwwxSB
-t1/Z79Br+yX905O+vqaPH5Hwzini3NZ4tq1va+BDbvLvsPXNzrThH9fJglhvK3g7dxAq1rRSrR0Q54YO
-KPsXmSMoAeIbXZvA0ul7utC0vR282IKZgnmQwBTCXIykYx9cXpe0a989C39LQPdr93jrkugM8b7fMTQC
-vQIi72lTr54j6Kud572enTHgU5Fr5w6+DVElAk4EdmBMxGnx4A6gnmmW+ep2rbv6DpbEXZPh0U1ZhFHJ
-QnJIel9H5X+IIlyECV0wNr2aOHEF2nBrbWObQkOvunhyDBKe3Pd+Kc7YRV/TJ7+5AWyBa3Pj9mbpHMYX
-F97ZJI+ul3g9nsbzLoOYy00eg6fy7UWxfcfiW/8tIDsx8qtBaIzA/en1gh95+UtYJPIPfvyHYsJzttwq
-EnbqvgEH9bZYseU1c35qV0rbZwvl5Ae/xU8H41pFVYHtWifQ5Rcs9uMfs4GViBfgqTH9Hc8Ygrunxj4b
-zpM0bum9Y0FhndXHI1L/EwAA//8A068SX04AAA==
-`,
-	},
-
-	"/static/include/websock.js": {
-		local:   "static/include/websock.js",
-		size:    15453,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/7xb63LbOLL+76eA9SOiHJmyPak5KV9SFStOxjOJL7EzOeckWRVFQRISitASoGVlJ+++
-3QAvIAnIkie7rN2JLAIfuht9B9Tb2SI75CMdCh5+OyRTNpnuzmky5sksiENKhiwOkiUOuIEBVAoc3ufz
-ZQIjJfH6HXKwt39Afuc0Iu+CRLIYR7xlIY0FHZE0HtGEvLt6Sw78PeIJSsnb8/7Zxc2ZL+9lB8Ya6xMm
-iGAzFgUJkZzIKSVCBvEoSEYlBYQPv9JQkmEq83kIQeNgGFFBQj6bpTELA8l4TBZMTkkSLMht/4oIzQHx
-mE99hZ5xJ2RCgxkSQ+5YQBYalY2XPrmdAk3wvyAE4HnExBSYGi7JMBD012ewashHLJ4otFEgA8TQcGRI
-5YLSuGAOGDGha6xPAwEssUjuspgkNKTsjpJ/pjQFKtPxmCawypFaZkaFCCaU0DsaS4QYceA75hJ4j2UA
-04NQpkGk6FFi0mKdR0sS4Dg2zsUjp4FCANiE4rCYLvS04C6AbQCJ+uQGFkoALrnegbXllI9AHDC8GAJ7
-hSDA9CiXqMLg4zH8X9FcYQhZ721t9Xa+iojFsJMJXwiaHBKZpLQLEHLBBNV/4sjeziTiQ6Dgg2QRztzq
-9chbDqu9jgIxNVSDztIokDwhbAys0BEdqbG3nIBCgzZbRqIUyWIK/4lBJnfmmIJBwFiweMQX/sez08HN
-Zf+Ps9vB68v3/bPB67cvb34jJ4rWo2wtrYq2xUZ0mE4OrXCvzk4/vDnZP9raAtq9/H0B8eQJ2V5JQ4f8
-a4vAk+nTIOMmp+wHoZGgxMB+x7//PHh81aD5hFiWKkjReL0d0Eit+Cw2RCY4iXCHwWAG2m79r0KpjZWK
-cQCQAL21NU7jUOl2NsbLKW+lAt1JwkLZOtIwEozbH2Q2qQiO0yg6Aqp6DX9jzkiuGYzdOyLlAzPeV4wW
-OKf3lTkR6FhlFsy5oPeSLBImKZlzwRThLG6aTAVI+4Mb9h053987eAbGl/3z7KhJiR4OHgDGe8/IO3ba
-qcDNgnvcxyZ4jzzXOwuQYRBF4PkYksfkYTEcZQY+4wPY8fOXSRIsvSZQ56iyXiHmOqWmiIWDS705+3vk
-D3a6mjbhpE24aBPFHlW+rOjFDQU33iB3xkdIZlu7v/ZRRlk/TRJw0oYy4cDDYlwXPqlA0i6xYDtOFXl0
-9HLG0xjV8mBvr6KyyvX/BgEloomA91rD8Wln0aF9SApLQBP40S2H8DmNV70PIy5WAtAk4UljgHr/Qxmh
-V3ljMb9MPsiNinxmysGESCGgwXYKPqMEgo8AJEH604TPWDrrYiTNETD2xW1JJirugTeiM9ggnkKI0sKD
-AFQBj0OIU+gJY/KaJXTM73OoQOrwCovG0ifkHHYrgEgPJARRmgU6ItME9AxwC0Mdp/AdBjV47iBzObt4
-efr2bNC/vPq/j+e3v51flP6pGPPu5f8O3l8P3ry//Di4Of//MxjybK80Y/xHqxt8CwZbTpTLOegFKvMt
-v5GYFMBUi7gznvL0hccQ/EEI5GoaxJLPfr/pQtBj4bQQYMS+QUifQ5IACcjExFA51C2uS9TCongpIdKX
-y+UkBknStLxP+11y0CW/fMkMLn80D/4YtrY/DZI+mIevqPDQ5LoIVpuRULUFJcsB8Jx/+yBc5whVNMf6
-Ab5DghA8et+pcWJdpjLCGOVetjEDH02LEow/T7jkuKs+JEMh9dGZwVo1pk2atZ11vM5RJRiWSBWHABv4
-hkqJfgIT0Bv9uXg9oRI8XM2UbYLInaFBSHerApOsD5OshGEb4DA7kGgCgRHXscxoDq/tUEaUui5CsaIE
-o8UGpGJs2X2I8OR6Tum35xvAfioQv7ggxZSN5eMwnz51o35j85WgBsgqjNOlpMLEidOZe6eeYjCeObfq
-9vLVpTcCxx7Ke/D4+wcdyAeokJUgoDwauG4IA3dgFuQVVCt/Mrqoi2z/1zVk5lmFRo6PyfMOeWqz/82k
-jIT8cvC3CDl45qDEPWX/142n/Dx2wZ2a/ILh1FnGOgZdHR/rtycnkHxhr2HMYjpqY0TQqZxaU9meh65/
-vWCVE+rrPLFbql8XYWu+uaKb8Noarppx26uGNosYGobxnxLEWhysLSNwcoBRl5SFv1tuMieDBNy/nmZh
-UnEBzBW8rcda5hIOIZWD/Eblm19TcAcqG4V8XLmCO7B9oZsAqcCUKtDBWefhmPUJqN6pLsuuq6JTVPsA
-5W2oRGtrUVVyiymP6Bre4CFq9rpmYHJvFCYl5nICOIZdgiTbtkuWrx+lQk9JuQ7ok/qjnhKZbYSfs5gj
-UrsIcMWg/pSG35TWUNVxWUBRgTq3CKBuwCS8DRGsTYZo3sQDdQ7SSOL416cffPVtx4SDF0Oz22brD/iQ
-o2g/gz0zvSY2wFRrroRSFHiYB865EGwIRcE8wRZcoFtTeW+x0yUce4LYh9O1i2+qYIBVdqkSMzHpYlDu
-kgkfBuG3ugagn83bH65kSJXWkAyQzI5LeEyIG3qmAY6JJVXIh9hpMUeUG3zsoNx8JJSfC6VVZ1j+ei0t
-CKAvxt4rTk/npAXapLHgQ0vvcaumOE0Fqq5TqN1JBtWc3pyalPuvhKkUYUaDWGhFmKED0x1qJ05e8+hi
-tRhT1W7VALkuGyD4jKNUTFc5pVLcRbctM8Siy7ENzn3PtgHY9fVfoXp6reqcQyXu1bgNo92UrmNHW8al
-d2Yr6YWdI3zqqwoQazZXHSrQQWaLXj1SVBFqPasVvOJjasl63lRJ/zwec6/1ikbBEsMj0tollr1YYTwb
-7JFBaE0VNVfFp0rBF49MBcTkylpDiGsVrOF915SgNRpr2UI8htE+fJzIqT25w9HKBjxHIEXyBkLlfdVg
-aqdSKQO89MU8YtJrtzugf3OjzRNOGxMNeuCtH2Z9iJfS26vbQMdBJVj3GXYWSd5aLN7w8dikm9411N/S
-mPwEw75g86vSJrQvzeMafpdMNcwGC2Uz7CsMII7wMJB0oJVPrFO6btDerk7dqPtcJ1W3sUvqVGcn5JHo
-koUYiHBKZ41ulAav8+g5s8yGx3AchmzV02md3WAaM4b3urBBAwmWRKTzOU9kI/gPZdkCNd+hu/TapYTa
-mNvoM6MOefLE6k28NpivGljOK1tfHZtVqOUtLu8h1rKzTNVSy1gj5iGVqM8/j8WcJeqA+LD+birlXBz2
-ehMoONKhH/JZ7x2YZxKz74nxSTe/e7/8z94jAYYRH/ZmgZA06Y1pgK3p3RGVNJSiV2yu6Gne/K+isVkL
-4dquZrs338ShOkH0smMN7BW3K5IyepN//eUMEvBsb6syqpjoFfoOgb4N3PvgCstVOtb9xqcStpB7mp9N
-3zq305WnZRJpahA+P2phtGgohzbSYB9/x1KATWLMx8aQ02OSjg0qNNxoyoU6xo+pMv0H9PVVVjoU/kGd
-e0MyKuY0ZGNGR65eQTFDdwxaRVXdslFd4htHXA+lVdoymdC+z1gQNKX4y1fHpJfjXHXaHciZdvd/GhHb
-uHc2tEY2H/M/L/p4Fh3xeILHpVpDRH7To1QTn6xMdfKndRWpgybsNAT5HYfs0KUEr1+v8etKaGGqlMR2
-KYqfz2RG/1q8IpPmhZp0mBMJJga+KBg9xFiWuhS8OdKEebXlniasSwzdahaepQM5ITAa8iiwT6/3D+9T
-sPv9y9PO4efe516v82n/S5U8U91UaMSgbI/EtRhpCaQVl1aj+WjFBtvMw1ElNTLs0tmBkqj4rHOC1UZT
-h+Fxft2oqN1peJfXJriKrlqseYYJgzvnPqrMH6PWa794kQstm962+GdbAZeLb3XplR3ZuybbQ4ERVm5o
-cgcmFE45x15iOtzNp9rL0oIqSwxxFV8Nau2Or0KdNvV2Rp6gEV6WA5M3Kdw+JO0NSWzWkqXoFXEP+CLN
-yn/JH+Gzrk9ah1VLzeGjRtbT62ITsnbF8XFNhRtOsLOBCal7GVUbog81TCpGpABsWY6NPzXYq1c3Kzm0
-4m/EorpZ8ndYVADaAG2k2xhVUzZjdMUqDnbNIKbEtGG3zNVtajg/vIW5vJFQA6rMrkzBL6/OLjqr0u/1
-ofqXFxdn/dvzizfr5N99YBe7RuUlqDLBdaXcdVK0Mq7nl1aFsKpaZUlH5UqIBi0+Vbsj84TdoTiya7Bl
-b6Haslu1t4BylarCI8g9kxGc60Px2nGiLxhn96Ehs++SJU+x76zaSuZR1Z/6ICs73tavNa22XMvRnmge
-EdU7ZJW2Cr2fgyUN8Hp0ENZvgcxYPBizhvaqEwGKNxIv1C1d2JlsJCio8xjmhf2S4kGzqWBiuyxn20Gb
-IX11Ujjiqbr6hfLEBSuHK/CWZJzXy7X8sdC8c1InWkl1VRYA1BQnMl19mCmgtEdSRCCZWJJcXvpEKxfn
-Malf6VyXyJPygKS+EQV8h+zkd0QrnDxQtwLRI46XzhZBrA6+JpgXpPGQYx06ipYOb1ih70XjEp07La4x
-Vp+4Kq2sTN116uYxeUCdjNznPqRzVBevVblYlF/UpfBeGQWGl8ZFweJUSV2DVGqJoLmLC3kajVQPAGix
-edeVe7OO7aDt8mhUCMY41Ms8hyvsrnPV2DbrEU1Yc7rtcN5kwDgKbly7c5kkCqpxz3N1vQGEhHy+/AjO
-mcXenrnqoyoCO2PuY27bMdJKZTBV3X1wu057uRIvRtQVKvF00hIu56mYmjFSXceleKc1i4k0HjWiS/q8
-qTUKvxks0ufZ2Y49wFRs3u1iGmGwxHXeG2hsZvrcdSujviFPT0iB7xC0WagfkhUJtb2vq1er7pZHfYsQ
-c0Ga93AePvqsJuHFYac9H8TmvJas47cRTnpyBT4xLGHlKb+p9JZD1fpAy0lK/hi/uTFhX5ju8GFamoq1
-9nWClV7ELG7OsR2NWSadzeUyDyZrhI8H2t1oiTQPd3j+CcJqtezaA+P8OJhZyz18qjig/63PMX6Pc7JS
-LEPAGPk5tixjh8FvCzPJcfJ46oJy9UNUe10hjKgIE6aWUncbqrfyNqDNACrpM9EfT6OQeFHlsdQVCGsv
-XYEova7TUxgNrZbpzrqgdyn+/rQALCRT4m8aVh+x2AaNslLoSkXXlPmKfoWh7A21fVxG4Vhmjbyh+gl/
-A6R+nvDvAAAA///lzr0wXTwAAA==
-`,
-	},
-
-	"/static/include/webutil.js": {
-		local:   "static/include/webutil.js",
-		size:    7830,
-		modtime: 1456768784,
-		compressed: `
-H4sIAAAJbogA/9RZbXPbuBH+rl+BsDcW6ciUnGs7U6u6jM92L+7ESS52ctM6bgaiIAo2RTIAJEW903/v
-LkCQgN6cpPflNBOHFHYXi2cXzy6g7mGLHJK8eP/q7IS8uLl6+RcCjyTJOMsVDp0V5VLwdKJIeBaRZ73j
-Z+SfBcvIFRWK51slvievbm5IUogyxvGXPGG5ZCMyy0dMkKs3L8mzuEdCyRh5eXl28er6IlafVQSyKH4N
-X7+9OD2/uoinIzIuBJlJmjJC8xHhuWKpoIoXOTxLJWYJPkucp9tqdQ/vZQYyZMjVgkt2QsY0k6xDFhOu
-mrehKBaSiRMC+vA6YnOWmRe00j1Ms2JIM/JO8QxUeT4qFiBVJLMpYGIm6pKftJAE7THPYXETJlhrTgX5
-hQ1RkwzIr6sO+e68j37phfFpmTFy/vqKSJaxRMHShktyea6d52MSPjGTxd+dR+TXFoFP/QWYG89yvVoS
-8pEdxw9qWu/ilKmLjOHjj8tLTww/gqmZyMkOabTbr+VXhAFavnWaZY+ZBJFbPrrbbyejSybkY6aM1Jq1
-1po0JpWOgJFZ9VurlkX86Js+qPmKTpksacIw52xI/y+TJmt4zhXJipRkmHSwBjrieUrUhOG3KT6/uLl5
-Q0oq6LRVzRuj1kc77iaCtmJhDGYAM+wJnqjAgIGYq2XJinE135PBAMQsYoEbAD0RTvLRiA6MSoWqiWEj
-jYmufQS5Jl5FordmPBFsHE+pSiZht3J7EN6eHv2bHv23d/S3D/HHD0d3h1HXybbN6UNj/7ffyG273VkX
-uItuj6u0MCmxAVQI1iEbWjWKIy5eD+89/IrhPe7/Uk06uBxYxH4wnxhhEDIPYOxZv3LAjNdWqieQCAIU
-0TKQAW8EEhQGvBRFyYBEmSQQIP0NlcCTR5Bx4BgQRMtCPZWpMaS/QU4M8WtOjOg6G4TGuR/IcUQODuoc
-AMlbfkcGmARmgiBa34Pg4VuWzASsnipY/nCmwD81oYrAeiq/pKeCzj0dEB/m0ExWoUuOyLFFmDwlQRzA
-X75JNuu+zClmQs4W5Bp8gZgaq1EsWJnB7gyDD3nQIQEJHFsWNKNsQXPxMXhYYwaQ7buisadtNUK+0a3u
-N3pmolgV1Soe93+14TNYijOWpzqu3/d2O4mCcjaE3A17HS2JgMfxusvbYrgeIXw6IfiItuEF3F3nYvO3
-YmOwY3adziM6IpR8mjGx1FsJyAviwukwY/WmhAr0Mwq8h4C5OzMHAsbkGb+newgOwwxZaVLkLUsvPpdh
-Oz68fX5w1wZ30Qj81wby+c/Bn4Bx2lGn9l7z02P0JVi0QaXGKZ017Toh2oYTzAjJZ1nm8oI25obMljqW
-FCP27u3lWTEtixy8MKLAbdEO5q01cSpLgD7iEyonZCxoqvuVbZC/AInfF3GAdwPxLwcc3PkjA24hBtCL
-qWbyGn2ganx3NwEq/sOOK/oA/FoKBq0GyxMvSmdFPubpt8fJ8MFm2LUJB+Z5BS6CGHlFfk3f7lTfh/4m
-DcwRqVXd+Z4VxQNnkJf5CNrzNCbnTMw59FaI1wmZKFWedLuLxSL+NOPiQU4hRnEh0u697CZaV8YTNc3q
-NupVQUZ0KcmU0RyqZ54tdVFUEy5tdw89tpQAWA1oAs2WYpUrm4CCy/owAGb3ozoCKx3CPpdcMNmA6Cri
-B8WqbXIOj6FD8DgUS6Zu+JSF+iWtXpCrtSUA7dmf4c9fe/bPca/XixwjlQNYlfr2ZYBMrQ2q4qerG1tt
-diS2YyGwMaxXKbEJYM7yNjYttC+qSAqTOgHGUJ54tdOYMA6a52CHJ41k4CZTPWdig1bRSzCoStIM3+w6
-nuJEJVWTQReH7QowDW0OYL+9MwMgneksU+/R7P4UQPmLnz1/GppLqMtxxvVYlhlXYbvfhmCs93AD0uvD
-f38HzarC4ytU4+NoreNOQDah0Eo0aQAnW+CdMImTCRWnKuyZdqZNND9qhaobwFSAJiyp5oj6TguAEU6g
-ex6xz6/HoVmeMYSNht3VriUjU9naYtWjA4fIa4T1WcSj8+f++IkhdS9+TFC5awvvDtk2BqhiHkD3dXQc
-uWR1zZTCVqVmK8M67lmsEpGeDwmcfIc0eSDdww6Bfsu+nwoQPOzuzyhPeEBOhaBLs8MQulhmPGHaYkhF
-qjNLdsixw+HVRUEyAUZl2PR7X8RSFVBxmJtQWwViucwTpKPQWdg4Xe82LRiyAQKk/AYzKXJZZAwIIw3X
-5aPNntwisK2xtWMxLctsGSLNdzzIdnbPq13kp4vIUVG2vsSHr5l/5XcI31KpFoIrZtNwR6Xaf079+mzo
-dt14Ba4LJ7BH3Jn7Hmbrob1F0Tu9uz1Hd6VOJV9J+3Hck6Kgvi+rVtujjsUru7Z2mLpUbBpurm21UTR2
-R+PLq4azwG+LkSl4gx0Q7rypMUre0lNn6V7/5nTdleLus7G1bGjat9Eo4yiublcJqG1v7dYb4f3duYPt
-arNi7Ire772J9uSrYNNizlzA8TNiGYM28asC6gXSmN2MZVPOTjPFRI7NqFTLjMkJY6q6f0bOwdrmdPjX
-tYxf3PZnNpTJB+l2Pc29svxxeUNTvE4NAxSzFx26x7Tz3N7t6Yq08T2NEYZKy+grFq4yjJVHI/WoYBl0
-xu/KkokzSIswqlue4PrmXy8vrl9cXNxAjv+APcEabxln43ImJ/V0W2jfyUmj0XcqgQHflAIF50H8SQMB
-NbtFHx5Nv9gx28bIz/C3E1WgjSRjAA4UHvhXhVXGrSZ9MKpNCL0I6m++6Kq40t04bbc8KMB4u9qf7c3T
-g43s9tSyR5Jt4a5w3t8IYwBAxch6/bDNhioTcBXeyu1He3XOhjModu8kkoOzPfTxQWv1NxIpHnGJZ/0R
-oou/Jz16ielOdf3Ay3LLbI3Lj0yJv1I9nnV+18xzvLR9g9fpl+MrLuU6F+KhqWPu89faC8g5CFKCl9VA
-VJCu+iZ4wdpwXBsxiv0xsJ6aEArzZ3AwnDN9BLPKIZUSKMGqkQXNFayD5nBqU4JiuBbm3ht6I0Z4HmlN
-NIHHQX2Qw5d+k1uQ5N6vDqarr+gmbNN2hSDK6as8Mlg3oVf6kX2CEZZvXAjp0cgc6xqy0jc4zV7R1iXs
-Rv+aydzzDIgzbJr38Diyh8CD9q6m1Grf3nk7Sv+yoMdiCYXFac3nzulsDgWHCiV/gXCEdoV4Hos2HDQs
-VqPwdBsKJg3cC4dmSRia5xgaY+2+4HkYHASR53XlllZD/LE+1VjgnI696g3vAXXq/i8AAP//rYbL3pYe
-AAA=
-`,
-	},
-
-	"/static/test.patch": {
-		local:   "static/test.patch",
-		size:    2137,
-		modtime: 1457455138,
-		compressed: `
-H4sIAAAJbogA/5xUf4vjNhD9e/0phCicjRPHaZ1s01yWa/colJaysFwplBJkW3Z0q5WMJWc3lHz3jmTF
-OcfetFz+iDXSzJvH/Hg5Kwo0nZZMIzJjIuNNTmcNiz4rlPZtj4mcvqI5uS2+j7+LIrpYxLf5HM3jeJkk
-3nQ6vUTwwjAcoHz4gKbz5eQWhfYfzD2p0adf1h4yP9woipSuWaYxXLWXsxn6TZIcqaaqZK2ZKJHKalZp
-5U3N+wtQky+RFO6WG+cNKhqRaSYF8gP0D6SIzL0frNFx7YVfEwfHTApBM+0HR0f4k2bcOmwdiP8XfqFp
-Y64/KzxBOCWKLhNnwJOS2ZOzcqrsqYUa+eEnelCH55wWLgLsVJI6dyYTVaNPYExVnBz+A7Au0jMUQHdA
-BSda1sb8O1jbNn07X02WKDSfYaNOv28++ljIP36/31ayaqqt0kQ3CgdQ1Yyz7AnqCWXTsiw5fTAej9Zh
-BOV1X/3UaC3FePCf++qBCMpHIsG3slW5Fn9/cnIo4SVKRZSm4wj2qQMYYVA0nEP/KRXXKPzceY1AKKrN
-ZKtrAI/O581CtNN5tQyti0MwbU5WiWlzslpM5os3+3w8m0cYLy+8uelX5Ydub8za9MtrEDV91UAEaL5r
-56Xr2tY8vQuiPeENvWiMXa+PsE+lj+/u7AZC2CMVkA+j0KJGqklBMvx4ksQB3OEoijDMMFC8KWSNfJOe
-Qe54gjgVcLBRcCz1bg0v7801HMLQMoewG0hkNkVBol/pwbcB2Y7U9zKnP2qfBYH1O3qh+UJFwnN9QK4e
-d/IF6R1FriNGTU4NRpWp/eyZiuYcM2zORT17zYAM91yCUpoUICJWeUwOC+31RH0vsminnzko8enopLxY
-JtntIo6iZTxf0UXal/LOuVXxzrQCHs/NyJjPCiamT+69FSWkDxXdYPZMSooR4XqDu1HBSNWZe1Ozbg6i
-SpR4qFws3ww2HGWcKLVxytNqzjZt34YImmlOe/lnd/0xe5Pzg5nxPt9Ogi3dcIzul1Jyleog2lF1aYHm
-/yvtWVn6XM+6dKW4A/H6qup+SQF4/xsAAP//VjolI1kIAAA=
-`,
-	},
-
-	"/static/vnc.html": {
-		local:   "static/vnc.html",
-		size:    10856,
-		modtime: 1457621891,
-		compressed: `
-H4sIAAAJbogA/6xabXPbNhL+nl+B8maSZhqJjttcbzKSbhxZvfrOTjyW+/bJA5ErETVFsAAoWf31twuQ
-FElRL7TjD4mIlwfPLp4FFiAH31x+Gd//cTthkVnGo1eD4j/g4ejVK4Z/g296Pfsjkb9+HjN44ss0ho9M
-C/q/eGaZFsmChTDnWWzYL1e2y1imGyUWkWHfjt+y87P35+y/EmJ2w5URSXuT79mULzPbKEkggpDNpWJj
-SEIh2cWnChWhWSwCSDS2yZIQFDMRsJvba3beP2PfagB2fTWefJ5O+ubJvLU97yPsNRdIuL33eW8c80wD
-+zS9LOp3ofqvcu7IMDAs5YovwYDSjCtgqZIrESKsSNhfGagN00ahdz7aTvQXGZN+9P3cd/1ALv1/R1Kb
-4c9fpvevU6nM8PbL3f1rSAK1Sc3w/WujMngIZCzV8L2FkY7vXPHFEhJzEPsfHbF7vZGbeiNMDCPr7YHv
-HnJRoLmcBRFXGszQy8y89y+vIhh2Ea/5RtPUBcBibkAbdjVhCsjTpBRIFiJB18IKEnKUSIziCZi37DUb
-R0ougf1EXi3tKv7uYClXgMbjBIo528iM0XyRM/qR4UEAWm8tsDzJJT34KxOrofd775eL3lguU27ELAaP
-BTIx6MChdzUZQriAd4Edffi+bk9KIhdfpqjOOVeCod0o4UVzqAQpD72VgDV5uoK+FqGJhiGsUFM9+/AO
-bRZG8LinAx7jgP2zd2zJn8QyW1aL0DplnznyHSbS2x2PE7veUs5Q2L01zHpY0At4yusWbkB7zD+xuzbc
-ZLo34zi42dRwZjEPHns0XzrOMETMFjX3FZsaDHF2hV0qcopF8ogSiIsRjcyCiAZSJkt7YskXOEqkYD70
-7IP2daAAkofvz8+efvjnWT9NFvWhfsIwoElxftU0K1YJggY20ioDRUIuZDMpH5dcPWKQIkGqj0AoFuFk
-u2GOESXQdn4ffnz68KNlN6qvmQeQeqkCDM5U4gJ0DLWwmQhWRDmledERgNFt3HVZXeInQZyF4M+4xpVB
-V9RQ4RnjQpZgxLID/UkADuD+6v56MvQ+Ucnz4TJooGVgwRreRMeIFGd4k8LwjYEn4//JV9yVvilXCq2C
-4Zt8GVyAmQsFs2xhV0KkBGi79mNhwH/fP/fz2h4V9Gg+FK4fEPb/1G9GA99BV5w/8POtcTCT4Sa3NhQr
-JsKhZxfKHoWJkjFFDsZMzLXOax5cSD0kUi15nEulMNBtaTc2BtmllTNqNN6wT5kxMtHF/JYDVpF7s7xR
-DHNTAbaNRZJmuc+8PMZwUraLFAtxD/Gs1woBUokV3s7qW5r5QN0fqOGDG7zd1rxuB8duJkPvBpdy/xJB
-2K/FktlgX/Ptg1ujclTdaHvQ2s+SLSWuAwXZqrm2AqclgXaj64a71g7n7KDVHehd48QdI0iT25Xg+69F
-8EaEISrzCMWlbdWV5PnXInlnE8kjHG222ZXiD1+L4v9gM5NchXVmj3npYVo6kutt/y7hRn8rjqtqlUAe
-g1NEZWWp32IL7TWfpaEkixv2HykXqIQ8ScN99CIJlRQhCyXo5I3BdV+nlBbzZMPknLZZDe9aKdlTB+MG
-02Nkjvv3OhJBRAWwpHVeslBoSmIYLfZMZwt0lhEY+vn+3Y6Km2u+o5d+Zddg3mjc7NOSuT2/UO6sXcqw
-pBQo3ANpNy97EikOPNUFufQU0cQjALfTVQxupYDTnxmJOZkwPBZ/o9/lfN4+UbahVJgeGNcqL6FhTd6R
-oYvjOIggeBx6cx5raMdayr95QB6LBGVuE8zglIcbW0G0xYb6iovtFH9AU9pWW9t+n9In1JPZnjWtk4ot
-KlXtFzz9FaK3UChR/en4VtOR5diouM4vwJLjtIxcYBRQ76/P6SI2dUpYeioj7Pv1Cd3zWZ2Q4bMTZg4P
-e9jz69OZ6KBOBxP40+hgz2fRGfgYFY3MpF6UP9ZztG0YuQEo8hrNytSvU55n97BTEz0S6Xeoi+8uoUXq
-2CSEPYIv3EYICID9T/DevlxvikCMkHoI1bNc/BMtmEaZCeU68e8AT3GN4EhxOVH7+T+t0peQro/c7/dP
-Zz2ORdqy1wdF8X7OZZMXMK+MfirhW64NdElMiGtKnV7AMx/0VI4/ZXHsNvc60XlZvp/qts0L+FYJnKzf
-/LKosRHmpYdiz7V4UdSVQ1MKM/Qwo0pjvvlIx50OWnZXnQ0lu8IDOnYNXqLictyXsL8UOmgzICzLd20g
-/tv6E0xopbjPrioj/8AuQqn3JbhrCMzi2C1PIK7lnu4mCJOkhM025QX8OsLsl659IcR02iaujzzhj7y/
-ECbKZnQbwnb2mu1+FW7HLI1u7DflJTxnMyXX9oqN0206FeMKhpn1NsemS3b7huDn+5vrD2zMkxXXNTiO
-u8NvMJvK4BGM7rM/ZMbWIo4ZIGHEToBMseA41ApL1lhR6VJD01lq7za+1RkdKDQb8PzSKb8YisVslQQO
-qa9lpgJAJy2gn4DxvVG1euDz0dsaOnpzU9CzvNxVY12M1QE1XUWVjvfdVPhrmGnkLuYbb7T9TcPVkBB8
-pkS4ADYDs6ZjDQ6uSqeT47Ze6bMpuNtwOz9dKdlO3uhucnF5M9lhQkM1HbkjqwKDLBIGdkBIi0up8MSV
-zOkmjDTWr/OcqT2hbYNyK80iKl3EF3GYH3THscRT0ZHYupVpZm+rMZAPxUNK7YosriUgWsHLLbgtbOv4
-wXa3zsHxTMwTTOhdrociSJv5Xu2w2YB5oEqPkUSGH5qJa/vh77DXm/hBDFyVS19tAnbWvHJCsMsxn/3+
-6y1rJF3HvYc5Xge/6ZQn9c4PuERlx25xClvzpLIg+amuuqJ4Z3M6hudsbaAV+W5nLMwcdqA07CINfPLG
-kTkpcojjE6HLbOO5s1Eg7J2SLN5zWozFaEev+UvOQqD2vmQmn7wRm7iaAW4EHfC2r0p3IJn9BeGI3WMj
-NqZGHdGDTOkW5BG7lgGP2dhWd8XEiG1BpKWJNpbfRIJq7YipI1w9whbUqa1gNzKEjpD2bQK972hBpVcD
-7AvWHcCMVIfBUm6iYpz8ci4PkuqOjGo30REzYj6DPXq0bTTEdBO6HVqBFn9jWpq/J06CO1ewH8PiSJf9
-5SzpAnD0GdPLge8qOvW2r5a9kdPUFP/BcHsWEC1zNbDLvOC5gAqWEs+Cozv7P7OuOQkLVzHr6FFhTyHB
-/dPTUZ8KUuAG1NVlu3BQLnd5E3Z1+Ryh1l/oMmcQOSdUMiVXt944l4SdqQNtlEwWIwv0kfzinjsotPqa
-tlSpxesm0vwk4o3yHx1m8YSJ2+/Da7lYkAJe5sAc5XkujF3niv9yuAMe7GJ8a80JK+BuruD40qcJm1LK
-F/Zp1D7UwG/uvydlEPkxfs8JtpEKu/fnXXKItpygZVJ/xvNwZUZ3gpzOy5QhFVOwxwW7wLd41DwE7L4F
-eg4wOmAtVXgQPG9TLEzb52cMeC8fITk0mqEG3knIO6mIE0H7oaE8JOy58GH2OqVluLoiq+pzv50AJ3hy
-lXO284VG9ZOaRirr7vgaFy3V64tjIuYiAdWUauC6VlraAo+5D8S8M49FQDqnn/uXi5xBIk1x1QFh4xDt
-O+RDvnE/869q3MVY/klOZkTc/9O+sKh+A9PeVjRavkJ493nMwHcflP4/AAD//13LLu5oKgAA
-`,
-	},
-
-	"/static/vnc_auto.html": {
-		local:   "static/vnc_auto.html",
-		size:    9768,
-		modtime: 1456955630,
-		compressed: `
-H4sIAAAJbogA/6Ra/3LbNhL+30+B8HqR1IqS7CZtxzZ9kzjJ1DdJk4njtjd3Nx6IhCTEEMACoGxd63e/
-XYCU+FNSU06nEYndbxeLxYcF4PMnr95ffvrXh9dkYZfi4ui8+IfR5OLoiMBz/iQM3Q+pfv7pkrAHukwF
-OyWG47/FO8kMl3OSsBnNhCU3V07lUqVrzecLS/qXA3IyOT4h/1RMkHdUWy7bRb4l13SZOSEp2YIlZKY0
-uWQy4Yq8eFlyhRsieMykAZlMJkwTu2Dk3Ye35GQ0IX3DGHl7dfn6p+vXI/tgB07z0wK0Zhwcbtc+CS8F
-zQwjL69fFe1NqNFR7jt4GFuSUk2XzDJtCNWMpFqteAKwXJLfMqbXxFgN0Tl1SvgsrE1Px+M8dqNYLcf/
-WChjox/fX396miptow/vP356ymSs16mNjp9anbHbWAmlo2MHo7y/M03nSybtTuy//UnsMLzwQ2+5FezC
-Rft87F/ypIDuUhIvqDbMRkFmZ+EPQSlhyAtxT9cGhy5mRFDLjCVXr4lmGGnMFCbnXEJo2YpJDBSXVlPJ
-7IA8JZcLrZaMvMGobvpVPB/ZUq0YdB4GkM/IWmUExwuDMVpYGsfMmG0PnJ8YkpD9lvFVFPwa3rwIL9Uy
-pZZPBQtIrKSFAEbB1euIJXM2jJ316LjanxSTnL+/huycUc0J9BtSeF43JcHlKFhxdo+RLqHf88QuooSt
-IKdC9zKEPnPLqQhNTAUYHE2GZEkf+DJblj9B77R7p+BvJFXQtEfRu3CpppDY4T2bhvAhjGlKqz1cMxOQ
-8YHqxlKbmXBKwbhdV3CmgsZ3IY6XERlMEbtFzWNFri1McXIFKqV0ElzeQQqIwqJVWbxAQ9pmaciXdA5W
-FprNosC9mLGJNWPy9tuTycOz7yajVM6rpt7ANMBB8XE1OCouEzgatsplBiQJhpBMlbpbUn0HkxQcxPYF
-45osYLC9mX2OImi7f8+/f3j+vfPuosqZO5DCVDOYnKkCAtqHWvQZHSwl5TWOi1kwZk2b72bTvMGXscgS
-Np5SA8xgIBvcpI6CVFAui4QvuQ+e8BRCuk5Z1LPswY4/0xX1X3ubqWl0HPVy3pkzO+OaTbO5ox5whIEx
-MxbcsvHx6GSct4b4IcQAaJiwLBl9Nr2L87GHvqjQUNkTNLXpRma5AL2gpHc+zpeu86lKkHjX2D0YdmCb
-UzJJH86KIUr4ivAkChy73fqQBxcVuqnLuBlxCzMC5oKgxrQ1VCzCcKcVqxV0i9MT0lIDJUYT4hkiOJ5M
-/g49srqp4bWSi1a/NqYho4BWFHQXgg8st2JnBDIdlthTQjOr2nwpnreKJsBq7ZbHYBZCDQ50ebbpA/ag
-6uQ0s1ZJs8P2OZdpluealyYrKjLo0TWsGuTSavFC2FdMBJ0Q+KBJAwpb+ZcObJdlk1JZ8vVhlf41fxeZ
-TdS93O8oWCqE93vZae8jA26zB1nzon/JFnDsgaZAcr+lMQa/u93LdGcefNXYhHOpNnudVvVTTCXQV2mo
-/YegSNzvnsFkDfLpEgUn+Na0eelRpAI+ylJc6IG/arY9cInAvDd1QtsKjL/+bIC7LblfADOekhkVsHh9
-PS4JzIWaUgG+SkiYIflqSG6AAYfk45uXw7JkgMse1pyxDc62JsdjN78Ln7EK806YjQjijQQI3eYt/X8H
-UBAURDskAS4d3z3LX6DJqPguf0uYcb92jWVwx9ZmvYR9Qq4E71NFdZK/urwr8LiBdWm9H5PLGRCd0rme
-nk234GAMX/47KAViRTUBobPqB2b4/9gnvmQqs2dHW+lZJmPkU9jUeJn+gPxe8QcqjP4vbOqCB+sfbAtm
-fP4z1f2eV+gN/WgO6oqFbQ77CP0LifKhHfl3TMmzbvkfa/I/uqRtV8DSTSsBKxRqffWq36uvXL3BSM1m
-MGN3waQ0weUBIJ43BTAKeUeeRJHbU82gxE/I06eFw5Xvg9YxxcEDL14xcweL5zWG24MOC5Cw0puwcGpQ
-9ejxqPlrM5JvXt7gFkDAnq0PBmF4pll9bLajXQXOHbxVsoxSQGNukMeSSov1FEqHe1j1P+KWRLMEXahb
-x2gvzbxqGj5A6HvnsKtaQgFrsumSA0tpZjMtsfb9kCODz0GvqfsNKJNahTJVQNBLV6QEFx06BSwpPD4l
-HZLldaPoJsEoRscTx7rFx1sn2FpHdbpx/p8x9rze3JHNEI4XFjgQyIv1A2cHKaEieU+1DAa74QDKJ96n
-d28h+pVBaRncyijUBtWnjkw2AmirGhGw5hbaetL5IXYsssd8ufLp9KAs8oWWSqVLq5lK+5fb8AVLl4Wi
-9a/gw4h1w7vGL0TP0oRaBjvhgmUwodiQKJHkvyCZ2ia+AVEQj2kyJIKtmKg6YFoovFdz0kw7eb4qCEZy
-ybaiuQF7z228IH3nf9tqFkN5QHozygVLeqf5R9cHsBIwrZUOzgiZakbvmktIoW2p2Cj/WW0JFFFS32j7
-76C+Uxtqjtif6jn//6Q2Vk5t/d6nnR+bnlY+brQdS0G327QfjxqViBsbEkVbu+0DlYygs1g2YwLUstlB
-E4YV6F5VPD5sdglmz5Xktj9prMsNh3G1ULO+mwxYIfQ2JUKvzXMzPYjbA/KND+Gg6ZzppnTvY4u3eaml
-pC8LMGbFRG/QB1TavyyYO2HK9cgCNgxTPGTy6jCz7ym3UA1B0ejkHKhmS8qlqYO5drpk7ih8MnoONA9Z
-miAifGGO9bEuQzkNazSetqJovKB4zDpvwwM/lMic/2rmLTBj4LVKD4JRnRfF/UqJ3GDFUhsEB4aoUNvW
-Rs3B7KqyHofk+aScO49nLRV5kWQrptt4FJrzbXwVfPu9QpKlbX+d9zBNwQi5iMhxW05uEUeuvBrlexcs
-17iEbR3rHTi5diFJ1cTZmar5Jg5paWe+YrDwjmBIcGM43FSo+AsPqq26Y/LsqFEntS8ZDccDH4KgWWh1
-ACgZCx7fuTQqCzQBGgcoFe1Sa6tq+TSkrujbOtQ2JxtNLWiqRarYGuJx/61Qc5yRHfvFvBU2jD1k/d6g
-loeJijO87hm5c1uCuylmYpqyDjwnhmguwxtwQAUv18XqM9zco2AqECoTlw3IDobplbsgo9b/Tvz9C16k
-VQCdZkTancFG8CXPTqFiitk4ws94D1HzzdnugsLGFij8PKhFH/oIs9ejReSHCekDMT579u0AuyoJcPA9
-DKMFKiV4FA3BdV03C5WJBL7WwfCKYUllRoVYNzjiifOgZVpjY8NbrayKlRjBRs7fEPYnw+cDdNOdppvW
-5a8UG+hFc217bHxxTHOwA8+2Duyz/8Nkn/naar/ZEnaOay6AKVvnYKSiHYp24WaNP5His3WvJRGuZoR6
-MkPK4+78HdIYrbrr2uHmAmlzpYt3k5TESt1xNqrjudtk+C9D9emaSLWiIfxPxhDah3VV3tvt6oBrhR7I
-TNQrFlcjYTMORyPgPr89OLhCBRSJyXqTy1zm/YHg3bMird29mG1g1ULM5WcohD9gKK5m77jBK/6+XxEC
-ZzDIl4Z6qPEpQGLwx7JLF8BtN92/Q1hMd6eLcyeAeI6xnMP3s2b92H+CFDIgf/xRTMC2vC3vxjDIw2Kj
-4WMOr+8yIC+Tshiyp8aBEMWbj2/rGYmP3w3urHGtXrf4AztC6Jtk93h82/+9Z6mGjNjsHbZliT9J7g12
-noHi08uv9AuM9kQrhPbCbZ5O3vCbDEdVp8HgAAc1SzEZ9NUr52PXmelGyLHAftjtHzDsgC0JDd2e5QBg
-7LG4jTNtHHQ7cN58MKhZUF3aJLaD5kIHg+JfHNwqKdYetx10K1QcSO8HVvJmO3EQvDSPDtH+1Zfo3q28
-Xj9E70PtkBQB6genh+CUDmkBovT2WN9xwL7WnW2wh/jL+ONGugXFKuI5Dyc2bKA40nAYkh4wGGJ3cghS
-eeJqETzg5jJjSB1uX6dIfiqxi2fw0CoX63dX89U9VfFze4N+5F/x+txdp7s/CPt/AAAA//9rg0R7KCYA
-AA==
-`,
-	},
-
-	"/": {
-		isDir: true,
-		local: "/",
-	},
-
-	"/static": {
-		isDir: true,
-		local: "/static",
-	},
-
-	"/static/images": {
-		isDir: true,
-		local: "/static/images",
-	},
-
-	"/static/include": {
-		isDir: true,
-		local: "/static/include",
-	},
-
-	"/static/include/chrome-app": {
-		isDir: true,
-		local: "/static/include/chrome-app",
-	},
-}
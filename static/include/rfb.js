@@ -198,14 +198,17 @@ var RFB;
                 }
                 msg += ")";
             }
+            // The console proxy closes with codes from 4000 and a reason
+            // meant for the user
+            var proxyReason = (e.code >= 4000 && e.code < 5000) ? e.reason : "";
             if (this._rfb_state === 'disconnect') {
                 this._updateState('disconnected', 'VNC disconnected' + msg);
             } else if (this._rfb_state === 'ProtocolVersion') {
-                this._fail('Failed to connect to server' + msg);
+                this._fail(proxyReason || 'Failed to connect to server' + msg);
             } else if (this._rfb_state in {'failed': 1, 'disconnected': 1}) {
                 Util.Error("Received onclose while disconnected" + msg);
             } else {
-                this._fail("Server disconnected" + msg);
+                this._fail(proxyReason || "Server disconnected" + msg);
             }
             this._sock.off('close');
         }.bind(this));
@@ -0,0 +1,60 @@
+/*
+ * Console proxy session status
+ *
+ * Polls the console proxy for the remaining session time and shows a
+ * warning in the status bar before the proxy closes the console.
+ */
+
+/* jslint white: false, browser: true */
+/* global window, $D, UI */
+
+var SessionStatus;
+
+(function () {
+    "use strict";
+
+    SessionStatus = {
+
+        interval: 10000,
+        savedClass: null,
+        savedStatus: null,
+
+        start: function () {
+            window.setInterval(SessionStatus.poll, SessionStatus.interval);
+        },
+
+        poll: function () {
+            if (UI.rfb_state !== 'normal') {
+                SessionStatus.savedStatus = null;
+                return;
+            }
+
+            var xhr = new XMLHttpRequest();
+            xhr.open('GET', '/session/' + encodeURIComponent($D('noVNC_path').value));
+            xhr.onload = function () {
+                if (xhr.status === 200) {
+                    SessionStatus.show(JSON.parse(xhr.responseText));
+                }
+            };
+            xhr.send();
+        },
+
+        show: function (status) {
+            var bar = $D('noVNC-control-bar');
+            var text = $D('noVNC_status');
+
+            if (status.warning) {
+                if (SessionStatus.savedStatus === null) {
+                    SessionStatus.savedClass = bar.getAttribute("class");
+                    SessionStatus.savedStatus = text.innerHTML;
+                }
+                bar.setAttribute("class", "noVNC_status_warn");
+                text.textContent = status.warning;
+            } else if (SessionStatus.savedStatus !== null) {
+                bar.setAttribute("class", SessionStatus.savedClass);
+                text.innerHTML = SessionStatus.savedStatus;
+                SessionStatus.savedStatus = null;
+            }
+        }
+    };
+})();
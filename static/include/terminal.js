@@ -0,0 +1,723 @@
+/*
+ * Console proxy text console
+ *
+ * A small VT100/xterm compatible terminal for the vt100 consoles of
+ * xenserver (serial consoles of Linux guests). The byte stream of the
+ * console is tunneled through the same websocket as the VNC consoles.
+ */
+
+/* jslint white: false, browser: true */
+/* global window, document, WebSocket, TextDecoder, TextEncoder, Uint8Array */
+
+var Terminal;
+
+(function () {
+    "use strict";
+
+    // xterm's 16 colors, the rest of the 256 color palette is computed
+    var palette = [
+        "#000000", "#cd0000", "#00cd00", "#cdcd00", "#0000ee", "#cd00cd", "#00cdcd", "#e5e5e5",
+        "#7f7f7f", "#ff0000", "#00ff00", "#ffff00", "#5c5cff", "#ff00ff", "#00ffff", "#ffffff"
+    ];
+
+    function color256(n) {
+        var levels = [0, 95, 135, 175, 215, 255], r, g, b, gray;
+        if (n < 16) {
+            return palette[n];
+        }
+        if (n < 232) {
+            n -= 16;
+            r = levels[Math.floor(n / 36)];
+            g = levels[Math.floor(n / 6) % 6];
+            b = levels[n % 6];
+        } else {
+            gray = 8 + (n - 232) * 10;
+            r = g = b = gray;
+        }
+        return "rgb(" + r + "," + g + "," + b + ")";
+    }
+
+    var defaultAttr = {fg: 7, bg: 0, bold: false, underline: false, inverse: false};
+
+    function blankCell(attr) {
+        return {ch: " ", attr: attr || defaultAttr};
+    }
+
+    function sameAttr(a, b) {
+        return a.fg === b.fg && a.bg === b.bg && a.bold === b.bold &&
+            a.underline === b.underline && a.inverse === b.inverse;
+    }
+
+    // Keys which do not produce text, normal and application cursor mode
+    var keys = {
+        ArrowUp: ["\x1b[A", "\x1bOA"],
+        ArrowDown: ["\x1b[B", "\x1bOB"],
+        ArrowRight: ["\x1b[C", "\x1bOC"],
+        ArrowLeft: ["\x1b[D", "\x1bOD"],
+        Home: ["\x1b[H", "\x1bOH"],
+        End: ["\x1b[F", "\x1bOF"],
+        Insert: ["\x1b[2~"],
+        Delete: ["\x1b[3~"],
+        PageUp: ["\x1b[5~"],
+        PageDown: ["\x1b[6~"],
+        Enter: ["\r"],
+        Backspace: ["\x7f"],
+        Tab: ["\t"],
+        Escape: ["\x1b"],
+        F1: ["\x1bOP"],
+        F2: ["\x1bOQ"],
+        F3: ["\x1bOR"],
+        F4: ["\x1bOS"],
+        F5: ["\x1b[15~"],
+        F6: ["\x1b[17~"],
+        F7: ["\x1b[18~"],
+        F8: ["\x1b[19~"],
+        F9: ["\x1b[20~"],
+        F10: ["\x1b[21~"],
+        F11: ["\x1b[23~"],
+        F12: ["\x1b[24~"]
+    };
+
+    Terminal = function (screen, send) {
+        this.screen = screen;
+        this.send = send;
+        this.cols = 80;
+        this.rows = 24;
+        this.reset();
+    };
+
+    Terminal.prototype = {
+
+        reset: function () {
+            this.lines = this.blankLines(this.rows);
+            this.savedLines = null;
+            this.x = 0;
+            this.y = 0;
+            this.wrapPending = false;
+            this.attr = defaultAttr;
+            this.saved = {x: 0, y: 0, attr: defaultAttr};
+            this.top = 0;
+            this.bottom = this.rows - 1;
+            this.autowrap = true;
+            this.appCursor = false;
+            this.cursorVisible = true;
+            this.state = "ground";
+            this.params = "";
+            this.dirty = true;
+        },
+
+        blankLines: function (count) {
+            var lines = [], i, j, line;
+            for (i = 0; i < count; i++) {
+                line = [];
+                for (j = 0; j < this.cols; j++) {
+                    line.push(blankCell(this.attr));
+                }
+                lines.push(line);
+            }
+            return lines;
+        },
+
+        // Fits the grid to the screen element, content is kept top left
+        fit: function (charWidth, charHeight) {
+            var cols = Math.max(20, Math.floor(this.screen.clientWidth / charWidth)),
+                rows = Math.max(5, Math.floor(this.screen.clientHeight / charHeight)),
+                y, x;
+
+            if (cols === this.cols && rows === this.rows) {
+                return false;
+            }
+
+            //keep the cursor line visible when the screen gets shorter
+            while (this.lines.length > rows && this.y > 0) {
+                this.lines.shift();
+                this.y--;
+            }
+            this.lines.length = Math.min(this.lines.length, rows);
+
+            this.cols = cols;
+            this.rows = rows;
+            for (y = 0; y < this.lines.length; y++) {
+                this.lines[y].length = Math.min(this.lines[y].length, cols);
+                for (x = this.lines[y].length; x < cols; x++) {
+                    this.lines[y].push(blankCell());
+                }
+            }
+            this.lines = this.lines.concat(this.blankLines(rows - this.lines.length));
+
+            this.top = 0;
+            this.bottom = rows - 1;
+            this.x = Math.min(this.x, cols - 1);
+            this.y = Math.min(this.y, rows - 1);
+            this.dirty = true;
+            return true;
+        },
+
+        write: function (text) {
+            var i, c;
+            for (i = 0; i < text.length; i++) {
+                c = text.charAt(i);
+                switch (this.state) {
+                case "escape":
+                    this.escape(c);
+                    break;
+                case "csi":
+                    this.csiChar(c);
+                    break;
+                case "osc":
+                    //titles are ignored, BEL or ST ends them
+                    if (c === "\x07") {
+                        this.state = "ground";
+                    } else if (c === "\x1b") {
+                        this.state = "escape";
+                    }
+                    break;
+                case "charset":
+                    this.state = "ground";
+                    break;
+                default:
+                    this.ground(c);
+                }
+            }
+            this.dirty = true;
+        },
+
+        ground: function (c) {
+            switch (c) {
+            case "\x1b":
+                this.state = "escape";
+                break;
+            case "\r":
+                this.x = 0;
+                this.wrapPending = false;
+                break;
+            case "\n":
+            case "\x0b":
+            case "\x0c":
+                this.lineFeed();
+                break;
+            case "\b":
+                if (this.x > 0) {
+                    this.x--;
+                }
+                this.wrapPending = false;
+                break;
+            case "\t":
+                this.x = Math.min(this.cols - 1, (Math.floor(this.x / 8) + 1) * 8);
+                break;
+            case "\x07":
+                break;
+            default:
+                if (c >= " ") {
+                    this.put(c);
+                }
+            }
+        },
+
+        put: function (c) {
+            if (this.wrapPending) {
+                this.x = 0;
+                this.lineFeed();
+                this.wrapPending = false;
+            }
+            this.lines[this.y][this.x] = {ch: c, attr: this.attr};
+            if (this.x === this.cols - 1) {
+                this.wrapPending = this.autowrap;
+            } else {
+                this.x++;
+            }
+        },
+
+        lineFeed: function () {
+            this.wrapPending = false;
+            if (this.y === this.bottom) {
+                this.scrollUp(1);
+            } else if (this.y < this.rows - 1) {
+                this.y++;
+            }
+        },
+
+        scrollUp: function (n) {
+            var i;
+            for (i = 0; i < n; i++) {
+                this.lines.splice(this.top, 1);
+                this.lines.splice(this.bottom, 0, this.blankLines(1)[0]);
+            }
+        },
+
+        scrollDown: function (n) {
+            var i;
+            for (i = 0; i < n; i++) {
+                this.lines.splice(this.bottom, 1);
+                this.lines.splice(this.top, 0, this.blankLines(1)[0]);
+            }
+        },
+
+        escape: function (c) {
+            this.state = "ground";
+            switch (c) {
+            case "[":
+                this.state = "csi";
+                this.params = "";
+                break;
+            case "]":
+                this.state = "osc";
+                break;
+            case "(":
+            case ")":
+                this.state = "charset";
+                break;
+            case "7":
+                this.saved = {x: this.x, y: this.y, attr: this.attr};
+                break;
+            case "8":
+                this.x = this.saved.x;
+                this.y = this.saved.y;
+                this.attr = this.saved.attr;
+                break;
+            case "D":
+                this.lineFeed();
+                break;
+            case "E":
+                this.x = 0;
+                this.lineFeed();
+                break;
+            case "M":
+                if (this.y === this.top) {
+                    this.scrollDown(1);
+                } else if (this.y > 0) {
+                    this.y--;
+                }
+                break;
+            case "c":
+                this.reset();
+                break;
+            }
+        },
+
+        csiChar: function (c) {
+            if ((c >= "0" && c <= "9") || c === ";" || c === "?" || c === ">") {
+                this.params += c;
+                return;
+            }
+            this.state = "ground";
+            this.csi(c, this.params);
+        },
+
+        csi: function (c, raw) {
+            var priv = raw.charAt(0) === "?",
+                params = (priv ? raw.slice(1) : raw).split(";").map(function (p) {
+                    return parseInt(p, 10) || 0;
+                }),
+                n = Math.max(1, params[0]),
+                i;
+
+            this.wrapPending = false;
+
+            switch (c) {
+            case "A":
+                this.y = Math.max(0, this.y - n);
+                break;
+            case "B":
+                this.y = Math.min(this.rows - 1, this.y + n);
+                break;
+            case "C":
+                this.x = Math.min(this.cols - 1, this.x + n);
+                break;
+            case "D":
+                this.x = Math.max(0, this.x - n);
+                break;
+            case "E":
+                this.x = 0;
+                this.y = Math.min(this.rows - 1, this.y + n);
+                break;
+            case "F":
+                this.x = 0;
+                this.y = Math.max(0, this.y - n);
+                break;
+            case "G":
+                this.x = Math.min(this.cols - 1, n - 1);
+                break;
+            case "d":
+                this.y = Math.min(this.rows - 1, n - 1);
+                break;
+            case "H":
+            case "f":
+                this.y = Math.min(this.rows - 1, Math.max(1, params[0]) - 1);
+                this.x = Math.min(this.cols - 1, Math.max(1, params[1] || 1) - 1);
+                break;
+            case "J":
+                this.eraseDisplay(params[0]);
+                break;
+            case "K":
+                this.eraseLine(params[0]);
+                break;
+            case "L":
+                if (this.y >= this.top && this.y <= this.bottom) {
+                    for (i = 0; i < n; i++) {
+                        this.lines.splice(this.bottom, 1);
+                        this.lines.splice(this.y, 0, this.blankLines(1)[0]);
+                    }
+                }
+                break;
+            case "M":
+                if (this.y >= this.top && this.y <= this.bottom) {
+                    for (i = 0; i < n; i++) {
+                        this.lines.splice(this.y, 1);
+                        this.lines.splice(this.bottom, 0, this.blankLines(1)[0]);
+                    }
+                }
+                break;
+            case "P":
+                this.lines[this.y].splice(this.x, n);
+                while (this.lines[this.y].length < this.cols) {
+                    this.lines[this.y].push(blankCell(this.attr));
+                }
+                break;
+            case "@":
+                for (i = 0; i < n; i++) {
+                    this.lines[this.y].splice(this.x, 0, blankCell(this.attr));
+                }
+                this.lines[this.y].length = this.cols;
+                break;
+            case "X":
+                for (i = this.x; i < Math.min(this.cols, this.x + n); i++) {
+                    this.lines[this.y][i] = blankCell(this.attr);
+                }
+                break;
+            case "S":
+                this.scrollUp(n);
+                break;
+            case "T":
+                this.scrollDown(n);
+                break;
+            case "r":
+                this.top = Math.max(1, params[0]) - 1;
+                this.bottom = Math.min(this.rows, params[1] || this.rows) - 1;
+                if (this.top >= this.bottom) {
+                    this.top = 0;
+                    this.bottom = this.rows - 1;
+                }
+                this.x = 0;
+                this.y = 0;
+                break;
+            case "s":
+                this.saved = {x: this.x, y: this.y, attr: this.attr};
+                break;
+            case "u":
+                this.x = this.saved.x;
+                this.y = this.saved.y;
+                break;
+            case "m":
+                this.sgr(params);
+                break;
+            case "h":
+            case "l":
+                if (priv) {
+                    this.mode(params, c === "h");
+                }
+                break;
+            case "n":
+                if (params[0] === 6) {
+                    this.send("\x1b[" + (this.y + 1) + ";" + (this.x + 1) + "R");
+                } else if (params[0] === 5) {
+                    this.send("\x1b[0n");
+                }
+                break;
+            case "c":
+                if (raw === "" || raw === "0") {
+                    this.send("\x1b[?1;2c");
+                }
+                break;
+            }
+        },
+
+        eraseDisplay: function (mode) {
+            var y;
+            if (mode === 0) {
+                this.eraseLine(0);
+                for (y = this.y + 1; y < this.rows; y++) {
+                    this.lines[y] = this.blankLines(1)[0];
+                }
+            } else if (mode === 1) {
+                this.eraseLine(1);
+                for (y = 0; y < this.y; y++) {
+                    this.lines[y] = this.blankLines(1)[0];
+                }
+            } else {
+                this.lines = this.blankLines(this.rows);
+            }
+        },
+
+        eraseLine: function (mode) {
+            var from = mode === 0 ? this.x : 0,
+                to = mode === 1 ? this.x + 1 : this.cols,
+                x;
+            for (x = from; x < Math.min(to, this.cols); x++) {
+                this.lines[this.y][x] = blankCell(this.attr);
+            }
+        },
+
+        mode: function (params, set) {
+            var i;
+            for (i = 0; i < params.length; i++) {
+                switch (params[i]) {
+                case 1:
+                    this.appCursor = set;
+                    break;
+                case 7:
+                    this.autowrap = set;
+                    break;
+                case 25:
+                    this.cursorVisible = set;
+                    break;
+                case 47:
+                case 1047:
+                case 1049:
+                    this.alternateScreen(set);
+                    break;
+                }
+            }
+        },
+
+        alternateScreen: function (on) {
+            if (on && this.savedLines === null) {
+                this.savedLines = this.lines;
+                this.saved = {x: this.x, y: this.y, attr: this.attr};
+                this.lines = this.blankLines(this.rows);
+            } else if (!on && this.savedLines !== null) {
+                this.lines = this.savedLines;
+                this.savedLines = null;
+                this.x = this.saved.x;
+                this.y = this.saved.y;
+            }
+        },
+
+        sgr: function (params) {
+            var attr = {
+                    fg: this.attr.fg,
+                    bg: this.attr.bg,
+                    bold: this.attr.bold,
+                    underline: this.attr.underline,
+                    inverse: this.attr.inverse
+                },
+                i, p;
+
+            for (i = 0; i < params.length; i++) {
+                p = params[i];
+                if (p === 0) {
+                    attr = {fg: 7, bg: 0, bold: false, underline: false, inverse: false};
+                } else if (p === 1) {
+                    attr.bold = true;
+                } else if (p === 4) {
+                    attr.underline = true;
+                } else if (p === 7) {
+                    attr.inverse = true;
+                } else if (p === 22) {
+                    attr.bold = false;
+                } else if (p === 24) {
+                    attr.underline = false;
+                } else if (p === 27) {
+                    attr.inverse = false;
+                } else if (p >= 30 && p <= 37) {
+                    attr.fg = p - 30;
+                } else if (p === 39) {
+                    attr.fg = 7;
+                } else if (p >= 40 && p <= 47) {
+                    attr.bg = p - 40;
+                } else if (p === 49) {
+                    attr.bg = 0;
+                } else if (p >= 90 && p <= 97) {
+                    attr.fg = p - 90 + 8;
+                } else if (p >= 100 && p <= 107) {
+                    attr.bg = p - 100 + 8;
+                } else if ((p === 38 || p === 48) && params[i + 1] === 5) {
+                    attr[p === 38 ? "fg" : "bg"] = params[i + 2] & 255;
+                    i += 2;
+                }
+            }
+            this.attr = attr;
+        },
+
+        // Turns a key press into the bytes the guest expects
+        key: function (e) {
+            var seq = keys[e.key], code;
+
+            if (seq) {
+                return seq[this.appCursor && seq.length > 1 ? 1 : 0];
+            }
+            if (e.key.length !== 1 || e.metaKey) {
+                return null;
+            }
+
+            if (e.ctrlKey && !e.altKey) {
+                code = e.key.toUpperCase().charCodeAt(0);
+                if (code >= 64 && code <= 95) {
+                    return String.fromCharCode(code - 64);
+                }
+                if (e.key === " ") {
+                    return "\x00";
+                }
+                return null;
+            }
+
+            return (e.altKey ? "\x1b" : "") + e.key;
+        },
+
+        render: function () {
+            var html = [], y, x, line, run, start, attr, cursor;
+
+            if (!this.dirty) {
+                return;
+            }
+            this.dirty = false;
+
+            function span(attr, text, isCursor) {
+                var fg = attr.fg, bg = attr.bg, tmp, style;
+                if (attr.bold && fg < 8) {
+                    fg += 8;
+                }
+                if (attr.inverse !== isCursor) {
+                    tmp = fg;
+                    fg = bg;
+                    bg = tmp;
+                }
+                style = "color:" + color256(fg) + ";background:" + color256(bg);
+                if (attr.bold) {
+                    style += ";font-weight:bold";
+                }
+                if (attr.underline) {
+                    style += ";text-decoration:underline";
+                }
+                text = text.replace(/&/g, "&amp;").replace(/</g, "&lt;").replace(/>/g, "&gt;");
+                return "<span style=\"" + style + "\">" + text + "</span>";
+            }
+
+            for (y = 0; y < this.rows; y++) {
+                line = this.lines[y];
+                start = 0;
+                run = "";
+                attr = line[0].attr;
+                for (x = 0; x < this.cols; x++) {
+                    cursor = this.cursorVisible && y === this.y && x === this.x;
+                    if (cursor || !sameAttr(line[x].attr, attr)) {
+                        if (run !== "") {
+                            html.push(span(attr, run, false));
+                        }
+                        run = "";
+                        attr = line[x].attr;
+                    }
+                    if (cursor) {
+                        html.push(span(attr, line[x].ch, true));
+                        continue;
+                    }
+                    run += line[x].ch;
+                }
+                if (run !== "") {
+                    html.push(span(attr, run, false));
+                }
+                html.push("\n");
+            }
+
+            this.screen.innerHTML = html.join("");
+        }
+    };
+
+    // Connects the terminal on the page to the console of the session the
+    // proxy rendered the page with, or the one in the path query parameter
+    Terminal.start = function () {
+        var path = window.ConsoleConfig ? encodeURIComponent(window.ConsoleConfig.sessionId) :
+                (/[?&]path=([^&]*)/.exec(window.location.search) || [])[1] || "",
+            screen = document.getElementById("terminal_screen"),
+            status = document.getElementById("terminal_status"),
+            bar = document.getElementById("terminal_status_bar"),
+            decoder = new TextDecoder("utf-8"),
+            encoder = new TextEncoder(),
+            scheme = window.location.protocol === "https:" ? "wss://" : "ws://",
+            ws = new WebSocket(scheme + window.location.host + "/vnc/" + path, "binary"),
+            term,
+            measure;
+
+        function setStatus(text, cls) {
+            status.textContent = text;
+            bar.setAttribute("class", "noVNC_status_bar " + cls);
+        }
+
+        function showSize() {
+            setStatus("Connected (" + term.cols + "x" + term.rows + ", run \"stty cols " +
+                term.cols + " rows " + term.rows + "\" in the guest to match)", "noVNC_status_normal");
+        }
+
+        term = new Terminal(screen, function (text) {
+            if (ws.readyState === WebSocket.OPEN) {
+                ws.send(encoder.encode(text));
+            }
+        });
+
+        //the size of one character cell decides how many fit on the screen
+        measure = document.createElement("span");
+        measure.textContent = "MMMMMMMMMM";
+        measure.style.visibility = "hidden";
+        measure.style.position = "absolute";
+        screen.parentNode.appendChild(measure);
+
+        function fit() {
+            if (term.fit(measure.offsetWidth / 10, measure.offsetHeight) && ws.readyState === WebSocket.OPEN) {
+                showSize();
+            }
+        }
+        fit();
+
+        ws.binaryType = "arraybuffer";
+        ws.onopen = function () {
+            showSize();
+        };
+        ws.onmessage = function (e) {
+            term.write(decoder.decode(new Uint8Array(e.data), {stream: true}));
+        };
+        ws.onclose = function (e) {
+            setStatus(e.reason || "Disconnected", "noVNC_status_error");
+        };
+
+        document.addEventListener("keydown", function (e) {
+            var seq = term.key(e);
+            if (seq !== null) {
+                e.preventDefault();
+                term.send(seq);
+            }
+        });
+        document.addEventListener("paste", function (e) {
+            e.preventDefault();
+            term.send(e.clipboardData.getData("text/plain").replace(/\r?\n/g, "\r"));
+        });
+        window.addEventListener("resize", fit);
+
+        (function draw() {
+            term.render();
+            window.requestAnimationFrame(draw);
+        }());
+
+        //warnings before the proxy closes the console
+        window.setInterval(function () {
+            var xhr;
+            if (ws.readyState !== WebSocket.OPEN) {
+                return;
+            }
+            xhr = new XMLHttpRequest();
+            xhr.open("GET", "/session/" + encodeURIComponent(decodeURIComponent(path)));
+            xhr.onload = function () {
+                var session;
+                if (xhr.status !== 200) {
+                    return;
+                }
+                session = JSON.parse(xhr.responseText);
+                if (session.warning) {
+                    setStatus(session.warning, "noVNC_status_warn");
+                } else {
+                    showSize();
+                }
+            };
+            xhr.send();
+        }, 10000);
+    };
+}());
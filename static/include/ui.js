@@ -16,10 +16,10 @@ var UI;
     "use strict";
 
     // Load supporting scripts
-    window.onscriptsload = function () { UI.load(); UI.connect()};
+    window.onscriptsload = function () { UI.load(); UI.connect(); SessionStatus.start()};
     Util.load_scripts(["webutil.js", "base64.js", "websock.js", "des.js",
                        "keysymdef.js", "keyboard.js", "input.js", "display.js",
-                       "rfb.js", "keysym.js", "inflator.js"]);
+                       "rfb.js", "keysym.js", "inflator.js", "session.js"]);
 
     UI = {
 
@@ -98,6 +98,12 @@ var UI;
             UI.initSetting('repeaterID', '');
             UI.initSetting('token', '');
 
+            // Pages rendered by the proxy carry the session they show
+            if (window.ConsoleConfig) {
+                UI.forceSetting('path', ConsoleConfig.sessionId);
+                UI.forceSetting('view_only', ConsoleConfig.viewOnly);
+            }
+
             var autoconnect = WebUtil.getConfigVar('autoconnect', false);
             if (autoconnect === 'true' || autoconnect == '1') {
                 autoconnect = true;
@@ -506,6 +512,11 @@ var UI;
 
         // Show the connection settings panel/menu
         toggleConnectPanel: function() {
+            // There is nothing to set up on pages rendered by the proxy
+            if (window.ConsoleConfig) {
+                return;
+            }
+
             // Close the description panel
             $D('noVNC_description').style.display = "none";
             // Close connection settings if open
@@ -806,6 +817,10 @@ var UI;
 
         // Display the desktop name in the document title
         updateDocumentTitle: function(rfb, name) {
+            // Rendered pages are titled with the name of the VM already
+            if (window.ConsoleConfig) {
+                return;
+            }
             document.title = name + " - noVNC";
         },
 
@@ -0,0 +1,88 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// Spans around the phases of opening a console. Until initTracing sets up
+// an exporter the global provider is a no-op.
+var tracer = otel.Tracer("go-xen-console-proxy")
+
+// Set when spans are exported, flushed on shutdown
+var tracerProvider *sdktrace.TracerProvider
+
+// Exports spans via OTLP/HTTP to the configured collector, an empty
+// endpoint keeps the no-op provider
+func initTracing(c configTracing) error {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if c.Endpoint == "" {
+		return nil
+	}
+
+	exporter, err := otlptracehttp.New(context.Background(),
+		otlptracehttp.WithEndpoint(c.Endpoint),
+		otlptracehttp.WithInsecure(),
+	)
+	if err != nil {
+		return err
+	}
+
+	tracerProvider = sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(c.SampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(
+			attribute.String("service.name", c.ServiceName),
+		)),
+	)
+	otel.SetTracerProvider(tracerProvider)
+
+	log.WithFields(logrus.Fields{
+		"endpoint": c.Endpoint,
+	}).Info("Exporting traces")
+
+	return nil
+}
+
+// Sends the spans which are still buffered
+func flushTracing() {
+	if tracerProvider == nil {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	tracerProvider.Shutdown(ctx)
+}
+
+// Starts the span of an HTTP request, continuing a trace passed in
+// traceparent by a caller
+func startRequestSpan(r *http.Request, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+
+	opts = append(opts, trace.WithSpanKind(trace.SpanKindServer), trace.WithAttributes(
+		attribute.String("http.method", r.Method),
+		attribute.String("request_id", requestID(r)),
+	))
+	return tracer.Start(ctx, name, opts...)
+}
+
+// Marks a span as failed with the message logged for the failure
+func failSpan(span trace.Span, mesg string, err error) {
+	if err != nil {
+		span.RecordError(err)
+	}
+	span.SetStatus(codes.Error, mesg)
+}
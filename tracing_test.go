@@ -0,0 +1,48 @@
+package main
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestConsoleOpenSpans(t *testing.T) {
+	initTracing(configTracing{})
+
+	//the global provider only takes effect once, the test records with its own
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	global := tracer
+	tracer = provider.Tracer("go-xen-console-proxy")
+	defer func() { tracer = global }()
+
+	r := httptest.NewRequest("GET", "/console?token=garbage", nil)
+	r.Header.Set("traceparent", "00-4bf92f3577b34da6a3ce929d0e0e4736-00f067aa0ba902b7-01")
+	w := httptest.NewRecorder()
+	handleNewConsoleConnection(w, r)
+
+	spans := map[string]sdktrace.ReadOnlySpan{}
+	for _, span := range recorder.Ended() {
+		spans[span.Name()] = span
+	}
+
+	decrypt, open := spans["token.decrypt"], spans["console.open"]
+	if decrypt == nil || open == nil {
+		t.Fatalf("Expected console.open and token.decrypt spans Got: %v", spans)
+	}
+
+	if decrypt.Parent().SpanID() != open.SpanContext().SpanID() {
+		t.Error("Expected token.decrypt to be a child of console.open")
+	}
+
+	if open.Parent().TraceID().String() != "4bf92f3577b34da6a3ce929d0e0e4736" {
+		t.Error("Expected the trace from traceparent to be continued Got:", open.Parent().TraceID())
+	}
+
+	if decrypt.Status().Code != codes.Error || open.Status().Code != codes.Error {
+		t.Error("Expected the failed decryption to be recorded")
+	}
+}